@@ -3,12 +3,14 @@ package api
 import (
 	"database/sql"
 	"errors"
+	"fmt"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
 	"github.com/lib/pq"
 	db "github.com/tgfukuda/be-master/db/sqlc"
 	"github.com/tgfukuda/be-master/token"
+	"github.com/tgfukuda/be-master/util"
 )
 
 type CreateAccountRequest struct {
@@ -56,28 +58,44 @@ func (server *Server) GetAccount(ctx *gin.Context) {
 		return
 	}
 
-	account, err := server.store.GetAccount(ctx, req.ID)
+	account, valid := server.getReadableAccount(ctx, req.ID)
+	if !valid {
+		return
+	}
+
+	ctx.JSON(http.StatusOK, account)
+}
+
+// getReadableAccount returns the account owned by the authenticated user. staff can read any account.
+// the error response is already written if it's not valid
+func (server *Server) getReadableAccount(ctx *gin.Context, id int64) (db.Account, bool) {
+	account, err := server.store.GetAccount(ctx, id)
 	if err == sql.ErrNoRows {
 		ctx.JSON(http.StatusNotFound, errorResponse(err))
-		return
+		return account, false
 	} else if err != nil {
 		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
-		return
+		return account, false
 	}
 
 	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
-	if account.Owner != authPayload.Username {
+	if account.Owner != authPayload.Username && authPayload.CheckRole(util.StaffRoles) != nil {
 		err := errors.New("account doesn't belong to the authenticated user")
 		ctx.JSON(http.StatusUnauthorized, errorResponse(err))
-		return
+		return account, false
 	}
 
-	ctx.JSON(http.StatusOK, account)
+	return account, true
 }
 
 type ListAccountRequest struct {
-	PageID   int32 `form:"page_id" binding:"required,min=1"`
-	PageSize int32 `form:"page_size" binding:"required,min=5,max=10"`
+	PageSize  int32  `form:"page_size" binding:"min=0"` // the default size if unset. capped to the max size
+	PageToken string `form:"page_token"`                // next_page_token of the previous page
+}
+
+type ListAccountResponse struct {
+	Accounts      []db.Account `json:"accounts"`
+	NextPageToken string       `json:"next_page_token"` // empty on the last page
 }
 
 func (server *Server) ListAccounts(ctx *gin.Context) {
@@ -88,10 +106,22 @@ func (server *Server) ListAccounts(ctx *gin.Context) {
 	}
 
 	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+
+	// the same token as the grpc api. the token of another user's list is rejected
+	query := "accounts:" + authPayload.Username
+	cursor, err := util.DecodePageToken(req.PageToken, query)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	pageSize := util.PageSize(req.PageSize, server.config.DefaultPageSize, server.config.MaxPageSize)
+	// one more row tells whether there is the next page
 	arg := db.ListAccountsParams{
-		Owner:  authPayload.Username,
-		Limit:  req.PageSize,
-		Offset: (req.PageID - 1) * req.PageSize,
+		Owner:          authPayload.Username,
+		AfterCreatedAt: cursor.CreatedAt,
+		AfterID:        cursor.ID,
+		LimitCount:     pageSize + 1,
 	}
 
 	accounts, err := server.store.ListAccounts(ctx, arg)
@@ -100,7 +130,137 @@ func (server *Server) ListAccounts(ctx *gin.Context) {
 		return
 	}
 
-	ctx.JSON(http.StatusOK, accounts)
+	var rsp ListAccountResponse
+	rsp.Accounts, rsp.NextPageToken = util.NextPage(accounts, pageSize, func(account db.Account) util.PageCursor {
+		return util.PageCursor{CreatedAt: account.CreatedAt, ID: account.ID}
+	}, query)
+
+	ctx.JSON(http.StatusOK, rsp)
+}
+
+type ListAccountEntriesRequest struct {
+	PageSize  int32  `form:"page_size" binding:"min=0"` // the default size if unset. capped to the max size
+	PageToken string `form:"page_token"`                // next_page_token of the previous page
+}
+
+type ListAccountEntriesResponse struct {
+	Entries       []db.Entry `json:"entries"`         // the oldest first
+	NextPageToken string     `json:"next_page_token"` // empty on the last page
+}
+
+func (server *Server) ListAccountEntries(ctx *gin.Context) {
+	var uri GetAccountRequest
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var req ListAccountEntriesRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	account, valid := server.getReadableAccount(ctx, uri.ID)
+	if !valid {
+		return
+	}
+
+	// the same token as the grpc api. the token of another account is rejected
+	query := fmt.Sprintf("entries:%d", account.ID)
+	cursor, err := util.DecodePageToken(req.PageToken, query)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	pageSize := util.PageSize(req.PageSize, server.config.DefaultPageSize, server.config.MaxPageSize)
+	// one more row tells whether there is the next page
+	entries, err := server.store.ListEntriesOf(ctx, db.ListEntriesOfParams{
+		AccountID:      account.ID,
+		AfterCreatedAt: cursor.CreatedAt,
+		AfterID:        cursor.ID,
+		LimitCount:     pageSize + 1,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	var rsp ListAccountEntriesResponse
+	rsp.Entries, rsp.NextPageToken = util.NextPage(entries, pageSize, func(entry db.Entry) util.PageCursor {
+		return util.PageCursor{CreatedAt: entry.CreatedAt, ID: entry.ID}
+	}, query)
+
+	ctx.JSON(http.StatusOK, rsp)
+}
+
+type ListAccountTransfersRequest struct {
+	Direction string `form:"direction" binding:"required,oneof=outgoing incoming"`
+	PageSize  int32  `form:"page_size" binding:"min=0"` // the default size if unset. capped to the max size
+	PageToken string `form:"page_token"`                // next_page_token of the previous page
+}
+
+type ListAccountTransfersResponse struct {
+	Transfers     []db.Transfer `json:"transfers"`       // the oldest first
+	NextPageToken string        `json:"next_page_token"` // empty on the last page
+}
+
+func (server *Server) ListAccountTransfers(ctx *gin.Context) {
+	var uri GetAccountRequest
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var req ListAccountTransfersRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	account, valid := server.getReadableAccount(ctx, uri.ID)
+	if !valid {
+		return
+	}
+
+	// the same token as the grpc api. the token of another account or direction is rejected
+	query := fmt.Sprintf("account_transfers:%d:%s", account.ID, req.Direction)
+	cursor, err := util.DecodePageToken(req.PageToken, query)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	pageSize := util.PageSize(req.PageSize, server.config.DefaultPageSize, server.config.MaxPageSize)
+	// one more row tells whether there is the next page
+	var transfers []db.Transfer
+	if req.Direction == "outgoing" {
+		transfers, err = server.store.ListTransfersFrom(ctx, db.ListTransfersFromParams{
+			FromAccountID:  account.ID,
+			AfterCreatedAt: cursor.CreatedAt,
+			AfterID:        cursor.ID,
+			LimitCount:     pageSize + 1,
+		})
+	} else {
+		transfers, err = server.store.ListTransfersTo(ctx, db.ListTransfersToParams{
+			ToAccountID:    account.ID,
+			AfterCreatedAt: cursor.CreatedAt,
+			AfterID:        cursor.ID,
+			LimitCount:     pageSize + 1,
+		})
+	}
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	var rsp ListAccountTransfersResponse
+	rsp.Transfers, rsp.NextPageToken = util.NextPage(transfers, pageSize, func(transfer db.Transfer) util.PageCursor {
+		return util.PageCursor{CreatedAt: transfer.CreatedAt, ID: transfer.ID}
+	}, query)
+
+	ctx.JSON(http.StatusOK, rsp)
 }
 
 type DeleteAccountRequest struct {
@@ -130,11 +290,25 @@ func (server *Server) DeleteAccount(ctx *gin.Context) {
 		return
 	}
 
-	err = server.store.DeleteAccount(ctx, req.ID)
+	// the account is closed instead of deleted to keep its history
+	result, err := server.store.UpdateAccountStatusTx(ctx, db.UpdateAccountStatusTxParams{
+		AccountID:  req.ID,
+		FromStatus: db.AccountActive,
+		Status:     db.AccountClosed,
+		Actor:      authPayload.Username,
+	})
 	if err != nil {
+		if errors.Is(err, db.ErrAccountBalanceNotZero) {
+			ctx.JSON(http.StatusUnprocessableEntity, errorResponse(err))
+			return
+		}
+		if errors.Is(err, db.ErrInvalidAccountStatusTransition) {
+			ctx.JSON(http.StatusConflict, errorResponse(err))
+			return
+		}
 		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
 		return
 	}
 
-	ctx.JSON(http.StatusOK, gin.H{"deleted": target})
+	ctx.JSON(http.StatusOK, gin.H{"deleted": result.Account})
 }
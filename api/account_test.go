@@ -30,7 +30,7 @@ func TestGetAccountAPI(t *testing.T) {
 			path:   fmt.Sprintf("/accounts/%d", account.ID),
 			method: http.MethodGet,
 			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
-				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, util.DepositorRole, time.Minute)
 			},
 			buildStubs: func(store *mocks.Store) {
 				// build stubs
@@ -49,7 +49,7 @@ func TestGetAccountAPI(t *testing.T) {
 			path:   fmt.Sprintf("/accounts/%d", account.ID),
 			method: http.MethodGet,
 			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
-				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, "unauthorized_user", time.Minute)
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, "unauthorized_user", util.DepositorRole, time.Minute)
 			},
 			buildStubs: func(store *mocks.Store) {
 				// build stubs
@@ -62,6 +62,24 @@ func TestGetAccountAPI(t *testing.T) {
 				assert.Equal(t, http.StatusUnauthorized, recorder.Code)
 			},
 		},
+		{
+			name:   "Banker",
+			path:   fmt.Sprintf("/accounts/%d", account.ID),
+			method: http.MethodGet,
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, "banker", util.BankerRole, time.Minute)
+			},
+			buildStubs: func(store *mocks.Store) {
+				store.EXPECT().
+					GetAccount(mock.Anything, account.ID).
+					Times(1).
+					Return(account, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder, tokenMaker token.Maker) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+				requireMatchAccount(t, recorder.Body, account)
+			},
+		},
 		{
 			name:   "NoAuthorization",
 			path:   fmt.Sprintf("/accounts/%d", account.ID),
@@ -78,7 +96,7 @@ func TestGetAccountAPI(t *testing.T) {
 			path:   fmt.Sprintf("/accounts/%d", account.ID),
 			method: http.MethodGet,
 			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
-				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, util.DepositorRole, time.Minute)
 			},
 			buildStubs: func(store *mocks.Store) {
 				// build stubs
@@ -96,7 +114,7 @@ func TestGetAccountAPI(t *testing.T) {
 			path:   fmt.Sprintf("/accounts/%d", account.ID),
 			method: http.MethodGet,
 			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
-				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, util.DepositorRole, time.Minute)
 			},
 			buildStubs: func(store *mocks.Store) {
 				// build stubs
@@ -114,7 +132,7 @@ func TestGetAccountAPI(t *testing.T) {
 			path:   fmt.Sprintf("/accounts/%d", 0),
 			method: http.MethodGet,
 			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
-				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, util.DepositorRole, time.Minute)
 			},
 			buildStubs: func(store *mocks.Store) {},
 			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder, tokenMaker token.Maker) {
@@ -136,7 +154,7 @@ func TestCreateAccountAPI(t *testing.T) {
 			method: http.MethodPost,
 			body:   gin.H{"currency": account.Currency},
 			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
-				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, util.DepositorRole, time.Minute)
 			},
 			buildStubs: func(store *mocks.Store) {
 				store.EXPECT().
@@ -167,7 +185,7 @@ func TestCreateAccountAPI(t *testing.T) {
 			method: http.MethodPost,
 			body:   gin.H{"currency": "NOT A CURRENCY"},
 			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
-				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, util.DepositorRole, time.Minute)
 			},
 			buildStubs: func(store *mocks.Store) {},
 			checkResponse: func(t *testing.T, recoder *httptest.ResponseRecorder, tokenMaker token.Maker) {
@@ -180,7 +198,7 @@ func TestCreateAccountAPI(t *testing.T) {
 			method: http.MethodPost,
 			body:   gin.H{"currency": account.Currency},
 			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
-				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, util.DepositorRole, time.Minute)
 			},
 			buildStubs: func(store *mocks.Store) {
 				store.EXPECT().
@@ -198,35 +216,87 @@ func TestCreateAccountAPI(t *testing.T) {
 
 func TestListAccountsAPI(t *testing.T) {
 	user, _ := randomUser(t)
-	n := 5
+	n := 6
 
 	accounts := make([]db.Account, n)
 	for i := 0; i < n; i++ {
 		accounts[i] = randomAccount(user.Username)
+		accounts[i].CreatedAt = time.Date(2023, 6, 1, 0, 0, i, 0, time.UTC)
 	}
 
+	query := "accounts:" + user.Username
+	pageToken := util.EncodePageToken(util.PageCursor{CreatedAt: accounts[2].CreatedAt, ID: accounts[2].ID}, query)
+
 	RunTestCases(t, []APITestCase{
 		{
 			name:   "OK",
-			path:   fmt.Sprintf("/accounts?page_id=%d&page_size=%d", 2, 10),
+			path:   "/accounts",
+			method: http.MethodGet,
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, util.DepositorRole, time.Minute)
+			},
+			buildStubs: func(store *mocks.Store) {
+				// the default page size and one more
+				store.EXPECT().
+					ListAccounts(mock.Anything, db.ListAccountsParams{Owner: user.Username, LimitCount: 6}).
+					Times(1).
+					Return(accounts, nil)
+			},
+			checkResponse: func(t *testing.T, recoder *httptest.ResponseRecorder, tokenMaker token.Maker) {
+				assert.Equal(t, http.StatusOK, recoder.Code)
+
+				rsp := requireMatchAccounts(t, recoder.Body, accounts[:5])
+				cursor, err := util.DecodePageToken(rsp.NextPageToken, query)
+				assert.NoError(t, err)
+				assert.Equal(t, accounts[4].ID, cursor.ID)
+				assert.True(t, accounts[4].CreatedAt.Equal(cursor.CreatedAt))
+			},
+		},
+		{
+			name:   "LastPage",
+			path:   fmt.Sprintf("/accounts?page_size=%d&page_token=%s", 3, pageToken),
+			method: http.MethodGet,
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, util.DepositorRole, time.Minute)
+			},
+			buildStubs: func(store *mocks.Store) {
+				store.EXPECT().
+					ListAccounts(mock.Anything, mock.MatchedBy(func(arg db.ListAccountsParams) bool {
+						return arg.Owner == user.Username &&
+							arg.AfterCreatedAt.Equal(accounts[2].CreatedAt) &&
+							arg.AfterID == accounts[2].ID &&
+							arg.LimitCount == 4
+					})).
+					Times(1).
+					Return(accounts[3:], nil)
+			},
+			checkResponse: func(t *testing.T, recoder *httptest.ResponseRecorder, tokenMaker token.Maker) {
+				assert.Equal(t, http.StatusOK, recoder.Code)
+
+				rsp := requireMatchAccounts(t, recoder.Body, accounts[3:])
+				assert.Empty(t, rsp.NextPageToken)
+			},
+		},
+		{
+			name:   "PageSizeCapped",
+			path:   fmt.Sprintf("/accounts?page_size=%d", 100),
 			method: http.MethodGet,
 			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
-				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, util.DepositorRole, time.Minute)
 			},
 			buildStubs: func(store *mocks.Store) {
 				store.EXPECT().
-					ListAccounts(mock.Anything, db.ListAccountsParams{Owner: user.Username, Limit: 10, Offset: 10}).
+					ListAccounts(mock.Anything, db.ListAccountsParams{Owner: user.Username, LimitCount: 11}).
 					Times(1).
 					Return(accounts, nil)
 			},
 			checkResponse: func(t *testing.T, recoder *httptest.ResponseRecorder, tokenMaker token.Maker) {
 				assert.Equal(t, http.StatusOK, recoder.Code)
-				requireMatchAccounts(t, recoder.Body, accounts)
 			},
 		},
 		{
 			name:   "NoAuthorization",
-			path:   fmt.Sprintf("/accounts?page_id=%d&page_size=%d", 2, 10),
+			path:   "/accounts",
 			method: http.MethodGet,
 			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
 			},
@@ -236,11 +306,23 @@ func TestListAccountsAPI(t *testing.T) {
 			},
 		},
 		{
-			name:   "InvalidRequest",
-			path:   fmt.Sprintf("/accounts?page_id=%d&page_size=%d", 2, 100),
+			name:   "InvalidPageSize",
+			path:   fmt.Sprintf("/accounts?page_size=%d", -1),
 			method: http.MethodGet,
 			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
-				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, util.DepositorRole, time.Minute)
+			},
+			buildStubs: func(store *mocks.Store) {},
+			checkResponse: func(t *testing.T, recoder *httptest.ResponseRecorder, tokenMaker token.Maker) {
+				assert.Equal(t, http.StatusBadRequest, recoder.Code)
+			},
+		},
+		{
+			name:   "PageTokenOfAnotherUser",
+			path:   fmt.Sprintf("/accounts?page_token=%s", pageToken),
+			method: http.MethodGet,
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, util.RandomOwner(), util.DepositorRole, time.Minute)
 			},
 			buildStubs: func(store *mocks.Store) {},
 			checkResponse: func(t *testing.T, recoder *httptest.ResponseRecorder, tokenMaker token.Maker) {
@@ -249,14 +331,14 @@ func TestListAccountsAPI(t *testing.T) {
 		},
 		{
 			name:   "InternalError",
-			path:   fmt.Sprintf("/accounts?page_id=%d&page_size=%d", 2, 10),
+			path:   "/accounts",
 			method: http.MethodGet,
 			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
-				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, util.DepositorRole, time.Minute)
 			},
 			buildStubs: func(store *mocks.Store) {
 				store.EXPECT().
-					ListAccounts(mock.Anything, db.ListAccountsParams{Owner: user.Username, Limit: 10, Offset: 10}).
+					ListAccounts(mock.Anything, db.ListAccountsParams{Owner: user.Username, LimitCount: 6}).
 					Times(1).
 					Return([]db.Account{}, sql.ErrConnDone)
 			},
@@ -267,10 +349,182 @@ func TestListAccountsAPI(t *testing.T) {
 	})
 }
 
+func TestListAccountEntriesAPI(t *testing.T) {
+	user, _ := randomUser(t)
+	account := randomAccount(user.Username)
+	entries := make([]db.Entry, 3)
+	for i := range entries {
+		entries[i] = db.Entry{
+			ID:        int64(i + 1),
+			AccountID: account.ID,
+			Amount:    10,
+			CreatedAt: time.Date(2023, 6, 1, 0, 0, i, 0, time.UTC),
+		}
+	}
+
+	query := fmt.Sprintf("entries:%d", account.ID)
+
+	RunTestCases(t, []APITestCase{
+		{
+			name:   "OK",
+			path:   fmt.Sprintf("/accounts/%d/entries?page_size=2", account.ID),
+			method: http.MethodGet,
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, util.DepositorRole, time.Minute)
+			},
+			buildStubs: func(store *mocks.Store) {
+				store.EXPECT().GetAccount(mock.Anything, account.ID).Times(1).Return(account, nil)
+				store.EXPECT().
+					ListEntriesOf(mock.Anything, db.ListEntriesOfParams{AccountID: account.ID, LimitCount: 3}).
+					Times(1).
+					Return(entries, nil)
+			},
+			checkResponse: func(t *testing.T, recoder *httptest.ResponseRecorder, tokenMaker token.Maker) {
+				assert.Equal(t, http.StatusOK, recoder.Code)
+
+				var rsp ListAccountEntriesResponse
+				err := json.Unmarshal(recoder.Body.Bytes(), &rsp)
+				assert.NoError(t, err)
+				assert.Len(t, rsp.Entries, 2)
+
+				cursor, err := util.DecodePageToken(rsp.NextPageToken, query)
+				assert.NoError(t, err)
+				assert.Equal(t, entries[1].ID, cursor.ID)
+			},
+		},
+		{
+			name:   "OtherUser",
+			path:   fmt.Sprintf("/accounts/%d/entries", account.ID),
+			method: http.MethodGet,
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, util.RandomOwner(), util.DepositorRole, time.Minute)
+			},
+			buildStubs: func(store *mocks.Store) {
+				store.EXPECT().GetAccount(mock.Anything, account.ID).Times(1).Return(account, nil)
+			},
+			checkResponse: func(t *testing.T, recoder *httptest.ResponseRecorder, tokenMaker token.Maker) {
+				assert.Equal(t, http.StatusUnauthorized, recoder.Code)
+			},
+		},
+		{
+			name:   "PageTokenOfAnotherAccount",
+			path:   fmt.Sprintf("/accounts/%d/entries?page_token=%s", account.ID, util.EncodePageToken(util.PageCursor{ID: 1}, "accounts:"+user.Username)),
+			method: http.MethodGet,
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, util.DepositorRole, time.Minute)
+			},
+			buildStubs: func(store *mocks.Store) {
+				store.EXPECT().GetAccount(mock.Anything, account.ID).Times(1).Return(account, nil)
+			},
+			checkResponse: func(t *testing.T, recoder *httptest.ResponseRecorder, tokenMaker token.Maker) {
+				assert.Equal(t, http.StatusBadRequest, recoder.Code)
+			},
+		},
+	})
+}
+
+func TestListAccountTransfersAPI(t *testing.T) {
+	user, _ := randomUser(t)
+	account := randomAccount(user.Username)
+	transfer := db.Transfer{ID: 1, FromAccountID: util.RandomInt(1, 1000), ToAccountID: account.ID, Amount: 10, ToAmount: 10}
+
+	RunTestCases(t, []APITestCase{
+		{
+			name:   "Incoming",
+			path:   fmt.Sprintf("/accounts/%d/transfers?direction=incoming", account.ID),
+			method: http.MethodGet,
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, util.DepositorRole, time.Minute)
+			},
+			buildStubs: func(store *mocks.Store) {
+				store.EXPECT().GetAccount(mock.Anything, account.ID).Times(1).Return(account, nil)
+				store.EXPECT().
+					ListTransfersTo(mock.Anything, db.ListTransfersToParams{ToAccountID: account.ID, LimitCount: 6}).
+					Times(1).
+					Return([]db.Transfer{transfer}, nil)
+			},
+			checkResponse: func(t *testing.T, recoder *httptest.ResponseRecorder, tokenMaker token.Maker) {
+				assert.Equal(t, http.StatusOK, recoder.Code)
+
+				var rsp ListAccountTransfersResponse
+				err := json.Unmarshal(recoder.Body.Bytes(), &rsp)
+				assert.NoError(t, err)
+				assert.Len(t, rsp.Transfers, 1)
+				assert.Empty(t, rsp.NextPageToken)
+			},
+		},
+		{
+			name:   "Outgoing",
+			path:   fmt.Sprintf("/accounts/%d/transfers?direction=outgoing", account.ID),
+			method: http.MethodGet,
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, util.DepositorRole, time.Minute)
+			},
+			buildStubs: func(store *mocks.Store) {
+				store.EXPECT().GetAccount(mock.Anything, account.ID).Times(1).Return(account, nil)
+				store.EXPECT().
+					ListTransfersFrom(mock.Anything, db.ListTransfersFromParams{FromAccountID: account.ID, LimitCount: 6}).
+					Times(1).
+					Return([]db.Transfer{}, nil)
+			},
+			checkResponse: func(t *testing.T, recoder *httptest.ResponseRecorder, tokenMaker token.Maker) {
+				assert.Equal(t, http.StatusOK, recoder.Code)
+			},
+		},
+		{
+			name:   "InvalidDirection",
+			path:   fmt.Sprintf("/accounts/%d/transfers?direction=both", account.ID),
+			method: http.MethodGet,
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, util.DepositorRole, time.Minute)
+			},
+			buildStubs: func(store *mocks.Store) {},
+			checkResponse: func(t *testing.T, recoder *httptest.ResponseRecorder, tokenMaker token.Maker) {
+				assert.Equal(t, http.StatusBadRequest, recoder.Code)
+			},
+		},
+	})
+}
+
+// the config built from the secrets may not have the page sizes
+func TestListAccountsAPIZeroPageSizeConfig(t *testing.T) {
+	user, _ := randomUser(t)
+	account := randomAccount(user.Username)
+
+	store := mocks.NewStore(t)
+	store.EXPECT().
+		ListAccounts(mock.Anything, db.ListAccountsParams{Owner: user.Username, LimitCount: util.DefaultPageSize + 1}).
+		Times(1).
+		Return([]db.Account{account}, nil)
+
+	server, err := NewServer(util.Config{TokenSymmetricKey: util.RandomString(32), AccessTokenDuration: time.Minute}, store)
+	assert.NoError(t, err)
+
+	request, err := http.NewRequest(http.MethodGet, "/accounts", nil)
+	assert.NoError(t, err)
+	addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, user.Username, util.DepositorRole, time.Minute)
+
+	recorder := httptest.NewRecorder()
+	server.router.ServeHTTP(recorder, request)
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	rsp := requireMatchAccounts(t, recorder.Body, []db.Account{account})
+	assert.Empty(t, rsp.NextPageToken)
+}
+
 func TestDeleteAccount(t *testing.T) {
 	user, _ := randomUser(t)
 	attacker, _ := randomUser(t)
 	account := randomAccount(user.Username)
+	closed := account
+	closed.Status = db.AccountClosed
+
+	closeArg := db.UpdateAccountStatusTxParams{
+		AccountID:  account.ID,
+		FromStatus: db.AccountActive,
+		Status:     db.AccountClosed,
+		Actor:      user.Username,
+	}
 
 	RunTestCases(t, []APITestCase{
 		{
@@ -279,7 +533,7 @@ func TestDeleteAccount(t *testing.T) {
 			method: http.MethodPost,
 			body:   gin.H{"id": account.ID},
 			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
-				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, util.DepositorRole, time.Minute)
 			},
 			buildStubs: func(store *mocks.Store) {
 				store.EXPECT().
@@ -288,13 +542,59 @@ func TestDeleteAccount(t *testing.T) {
 					Return(account, nil)
 
 				store.EXPECT().
-					DeleteAccount(mock.Anything, account.ID).
+					UpdateAccountStatusTx(mock.Anything, closeArg).
 					Times(1).
-					Return(nil)
+					Return(db.UpdateAccountStatusTxResult{Account: closed}, nil)
 			},
 			checkResponse: func(t *testing.T, recoder *httptest.ResponseRecorder, tokenMaker token.Maker) {
 				assert.Equal(t, http.StatusOK, recoder.Code)
-				requireMatchSafeDeleteTxResult(t, recoder.Body, account)
+				requireMatchSafeDeleteTxResult(t, recoder.Body, closed)
+			},
+		},
+		{
+			name:   "BalanceNotZero",
+			path:   "/delete_account",
+			method: http.MethodPost,
+			body:   gin.H{"id": account.ID},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, util.DepositorRole, time.Minute)
+			},
+			buildStubs: func(store *mocks.Store) {
+				store.EXPECT().
+					GetAccount(mock.Anything, account.ID).
+					Times(1).
+					Return(account, nil)
+
+				store.EXPECT().
+					UpdateAccountStatusTx(mock.Anything, closeArg).
+					Times(1).
+					Return(db.UpdateAccountStatusTxResult{}, db.ErrAccountBalanceNotZero)
+			},
+			checkResponse: func(t *testing.T, recoder *httptest.ResponseRecorder, tokenMaker token.Maker) {
+				assert.Equal(t, http.StatusUnprocessableEntity, recoder.Code)
+			},
+		},
+		{
+			name:   "AlreadyClosed",
+			path:   "/delete_account",
+			method: http.MethodPost,
+			body:   gin.H{"id": account.ID},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, util.DepositorRole, time.Minute)
+			},
+			buildStubs: func(store *mocks.Store) {
+				store.EXPECT().
+					GetAccount(mock.Anything, account.ID).
+					Times(1).
+					Return(closed, nil)
+
+				store.EXPECT().
+					UpdateAccountStatusTx(mock.Anything, closeArg).
+					Times(1).
+					Return(db.UpdateAccountStatusTxResult{}, db.ErrInvalidAccountStatusTransition)
+			},
+			checkResponse: func(t *testing.T, recoder *httptest.ResponseRecorder, tokenMaker token.Maker) {
+				assert.Equal(t, http.StatusConflict, recoder.Code)
 			},
 		},
 		{
@@ -303,7 +603,7 @@ func TestDeleteAccount(t *testing.T) {
 			method: http.MethodPost,
 			body:   gin.H{},
 			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
-				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, util.DepositorRole, time.Minute)
 			},
 			buildStubs: func(store *mocks.Store) {},
 			checkResponse: func(t *testing.T, recoder *httptest.ResponseRecorder, tokenMaker token.Maker) {
@@ -316,7 +616,7 @@ func TestDeleteAccount(t *testing.T) {
 			method: http.MethodPost,
 			body:   gin.H{"id": account.ID},
 			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
-				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, util.DepositorRole, time.Minute)
 			},
 			buildStubs: func(store *mocks.Store) {
 				store.EXPECT().
@@ -334,7 +634,7 @@ func TestDeleteAccount(t *testing.T) {
 			method: http.MethodPost,
 			body:   gin.H{"id": account.ID},
 			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
-				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, util.DepositorRole, time.Minute)
 			},
 			buildStubs: func(store *mocks.Store) {
 				store.EXPECT().
@@ -352,7 +652,7 @@ func TestDeleteAccount(t *testing.T) {
 			method: http.MethodPost,
 			body:   gin.H{"id": account.ID},
 			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
-				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, util.DepositorRole, time.Minute)
 			},
 			buildStubs: func(store *mocks.Store) {
 				store.EXPECT().
@@ -361,9 +661,9 @@ func TestDeleteAccount(t *testing.T) {
 					Return(account, nil)
 
 				store.EXPECT().
-					DeleteAccount(mock.Anything, account.ID).
+					UpdateAccountStatusTx(mock.Anything, closeArg).
 					Times(1).
-					Return(sql.ErrConnDone)
+					Return(db.UpdateAccountStatusTxResult{}, sql.ErrConnDone)
 			},
 			checkResponse: func(t *testing.T, recoder *httptest.ResponseRecorder, tokenMaker token.Maker) {
 				assert.Equal(t, http.StatusInternalServerError, recoder.Code)
@@ -375,7 +675,7 @@ func TestDeleteAccount(t *testing.T) {
 			method: http.MethodPost,
 			body:   gin.H{"id": account.ID},
 			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
-				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, attacker.Username, time.Minute)
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, attacker.Username, util.DepositorRole, time.Minute)
 			},
 			buildStubs: func(store *mocks.Store) {
 				store.EXPECT().
@@ -396,6 +696,7 @@ func randomAccount(owner string) db.Account {
 		Owner:    owner,
 		Balance:  util.RandomBalance(),
 		Currency: util.RandomCurrency(),
+		Status:   db.AccountActive,
 	}
 }
 
@@ -410,15 +711,16 @@ func requireMatchAccount(t *testing.T, body *bytes.Buffer, account db.Account) {
 	assert.Equal(t, gotAccount, account)
 }
 
-func requireMatchAccounts(t *testing.T, body *bytes.Buffer, accounts []db.Account) {
+func requireMatchAccounts(t *testing.T, body *bytes.Buffer, accounts []db.Account) ListAccountResponse {
 	data, err := ioutil.ReadAll(body)
 	assert.NoError(t, err)
 
-	var gotAccounts []db.Account
-	err = json.Unmarshal(data, &gotAccounts)
+	var rsp ListAccountResponse
+	err = json.Unmarshal(data, &rsp)
 	assert.NoError(t, err)
 
-	assert.Equal(t, gotAccounts, accounts)
+	assert.Equal(t, accounts, rsp.Accounts)
+	return rsp
 }
 
 func requireMatchSafeDeleteTxResult(t *testing.T, body *bytes.Buffer, account db.Account) {
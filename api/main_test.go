@@ -23,6 +23,8 @@ func newTestServer(t *testing.T, store db.Store) *Server {
 	config := util.Config{
 		TokenSymmetricKey:   util.RandomString(32),
 		AccessTokenDuration: time.Minute,
+		DefaultPageSize:     5,
+		MaxPageSize:         10,
 	}
 
 	server, err := NewServer(config, store)
@@ -16,7 +16,8 @@ const (
 	authorizationPayloadKey = "authorization_payload_key"
 )
 
-func authMiddleWare(tokenMaker token.Maker) gin.HandlerFunc {
+// authMiddleWare verifies the access token and lets only the accessible roles go through
+func authMiddleWare(tokenMaker token.Maker, accessibleRoles []string) gin.HandlerFunc {
 	return func(ctx *gin.Context) {
 		authorizationHeader := ctx.GetHeader(authorizationHeaderKey)
 		if len(authorizationHeader) == 0 {
@@ -46,6 +47,11 @@ func authMiddleWare(tokenMaker token.Maker) gin.HandlerFunc {
 			return
 		}
 
+		if err := payload.CheckRole(accessibleRoles); err != nil {
+			ctx.AbortWithStatusJSON(http.StatusForbidden, errorResponse(err))
+			return
+		}
+
 		ctx.Set(authorizationPayloadKey, payload) // provide the payload via context
 		ctx.Next()                                // do next
 	}
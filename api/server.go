@@ -7,28 +7,36 @@ import (
 	"github.com/gin-gonic/gin/binding"
 	"github.com/go-playground/validator/v10"
 	db "github.com/tgfukuda/be-master/db/sqlc"
+	"github.com/tgfukuda/be-master/fx"
 	token "github.com/tgfukuda/be-master/token"
 	"github.com/tgfukuda/be-master/util"
 )
 
 type Server struct {
-	config     util.Config
-	store      db.Store
-	router     *gin.Engine
-	tokenMaker token.Maker
+	config               util.Config
+	store                db.Store
+	router               *gin.Engine
+	tokenMaker           token.Maker
+	exchangeRateProvider fx.ExchangeRateProvider
 }
 
 // new Http Server and setup routes
 func NewServer(config util.Config, store db.Store) (*Server, error) {
-	tokenMaker, err := token.NewPasetoMaker(config.TokenSymmetricKey)
+	tokenMaker, err := token.NewMaker(config)
 	if err != nil {
 		return nil, fmt.Errorf("cannot create token maker: %w", err)
 	}
 
+	exchangeRateProvider, err := fx.NewExchangeRateProvider(config.ExchangeRateFile)
+	if err != nil {
+		return nil, fmt.Errorf("cannot create exchange rate provider: %w", err)
+	}
+
 	server := &Server{
-		config:     config,
-		store:      store,
-		tokenMaker: tokenMaker,
+		config:               config,
+		store:                store,
+		tokenMaker:           tokenMaker,
+		exchangeRateProvider: exchangeRateProvider,
 	}
 
 	if v, ok := binding.Validator.Engine().(*validator.Validate); ok {
@@ -46,14 +54,17 @@ func (server *Server) setupRouter() {
 	router.POST("/users/login", server.loginUser)
 	router.POST("/tokens/renew_access", server.renewAccessToken)
 
-	authRoutes := router.Group("/").Use(authMiddleWare(server.tokenMaker))
+	authRoutes := router.Group("/").Use(authMiddleWare(server.tokenMaker, util.AllRoles))
 
 	// add routes to the router group
 	authRoutes.POST("/accounts", server.CreateAccount)
 	authRoutes.GET("/accounts/:id", server.GetAccount)
 	authRoutes.GET("/accounts", server.ListAccounts)
+	authRoutes.GET("/accounts/:id/entries", server.ListAccountEntries)
+	authRoutes.GET("/accounts/:id/transfers", server.ListAccountTransfers)
 	authRoutes.POST("/delete_account", server.DeleteAccount)
 	authRoutes.POST("/transfers", server.CreateTransfer)
+	authRoutes.POST("/transfers/:id/reverse", server.ReverseTransfer)
 
 	server.router = router
 }
@@ -2,11 +2,14 @@ package api
 
 import (
 	"database/sql"
-	"fmt"
+	"errors"
 	"net/http"
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	db "github.com/tgfukuda/be-master/db/sqlc"
+	"github.com/tgfukuda/be-master/token"
 )
 
 type renewAccessTokenRequest struct {
@@ -14,10 +17,15 @@ type renewAccessTokenRequest struct {
 }
 
 type renewAccessTokenResponse struct {
-	AccessToken          string    `json:"access_token"`
-	AccessTokenExpiredAt time.Time `json:"access_token_expired_at"`
+	SessionId             uuid.UUID `json:"session_id"`
+	AccessToken           string    `json:"access_token"`
+	AccessTokenExpiredAt  time.Time `json:"access_token_expired_at"`
+	RefreshToken          string    `json:"refresh_token"`
+	RefreshTokenExpiredAt time.Time `json:"refresh_token_expired_at"`
 }
 
+// renewAccessToken exchanges a refresh token for a new pair of tokens.
+// the refresh token is retired, and presenting it again blocks every session rotated from the same login.
 func (server *Server) renewAccessToken(ctx *gin.Context) {
 	var req renewAccessTokenRequest
 	if err := ctx.ShouldBindJSON(&req); err != nil {
@@ -28,46 +36,66 @@ func (server *Server) renewAccessToken(ctx *gin.Context) {
 	refreshPayload, err := server.tokenMaker.VerifyToken(req.RefreshToken)
 	if err != nil {
 		ctx.JSON(http.StatusUnauthorized, errorResponse(err))
-	}
-
-	session, err := server.store.GetSession(ctx, refreshPayload.ID)
-	if err != nil {
-		if err == sql.ErrNoRows {
-			ctx.JSON(http.StatusNotFound, errorResponse(err))
-		}
-		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
 		return
 	}
 
-	if session.IsBlocked {
-		err := fmt.Errorf("blocked session")
-		ctx.JSON(http.StatusUnauthorized, errorResponse(err))
-	}
+	var accessToken, refreshToken string
+	var accessPayload, newRefreshPayload *token.Payload
 
-	if session.Username != refreshPayload.Username {
-		err := fmt.Errorf("incorrect session user")
-		ctx.JSON(http.StatusUnauthorized, errorResponse(err))
-	}
+	result, err := server.store.RenewSessionTx(ctx, db.RenewSessionTxParams{
+		ID:           refreshPayload.ID,
+		Username:     refreshPayload.Username,
+		RefreshToken: req.RefreshToken,
+		NewSession: func(old db.Session) (db.CreateNewSessionParams, error) {
+			// read the role again so the change of the role takes effect on the renewal
+			user, err := server.store.GetUser(ctx, old.Username)
+			if err != nil {
+				return db.CreateNewSessionParams{}, err
+			}
 
-	if session.RefreshToken != req.RefreshToken {
-		err := fmt.Errorf("mismatched session user")
-		ctx.JSON(http.StatusUnauthorized, errorResponse(err))
-	}
+			accessToken, accessPayload, err = server.tokenMaker.CreateToken(user.Username, user.Role, server.config.AccessTokenDuration)
+			if err != nil {
+				return db.CreateNewSessionParams{}, err
+			}
 
-	if time.Now().After(session.ExpiredAt) {
-		err := fmt.Errorf("expired session")
-		ctx.JSON(http.StatusUnauthorized, errorResponse(err))
-	}
+			refreshToken, newRefreshPayload, err = server.tokenMaker.CreateToken(user.Username, user.Role, server.config.RefreshTokenDuration)
+			if err != nil {
+				return db.CreateNewSessionParams{}, err
+			}
 
-	accessToken, accessPayload, err := server.tokenMaker.CreateToken(refreshPayload.Username, server.config.AccessTokenDuration)
+			return db.CreateNewSessionParams{
+				ID:           newRefreshPayload.ID,
+				Username:     old.Username,
+				RefreshToken: refreshToken,
+				UserAgent:    ctx.Request.UserAgent(),
+				ClientIp:     ctx.ClientIP(),
+				IsBlocked:    false,
+				ExpiredAt:    newRefreshPayload.ExpiredAt,
+			}, nil
+		},
+	})
 	if err != nil {
-		ctx.JSON(http.StatusUnauthorized, errorResponse(err))
+		if errors.Is(err, sql.ErrNoRows) {
+			ctx.JSON(http.StatusUnauthorized, errorResponse(errors.New("session not found")))
+			return
+		}
+		if errors.Is(err, db.ErrSessionBlocked) ||
+			errors.Is(err, db.ErrSessionExpired) ||
+			errors.Is(err, db.ErrSessionMismatch) ||
+			errors.Is(err, db.ErrRefreshTokenReused) {
+			ctx.JSON(http.StatusUnauthorized, errorResponse(err))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
 		return
 	}
 
 	rsp := renewAccessTokenResponse{
-		AccessToken:          accessToken,
-		AccessTokenExpiredAt: accessPayload.ExpiredAt,
+		SessionId:             result.Session.ID,
+		AccessToken:           accessToken,
+		AccessTokenExpiredAt:  accessPayload.ExpiredAt,
+		RefreshToken:          refreshToken,
+		RefreshTokenExpiredAt: newRefreshPayload.ExpiredAt,
 	}
 	ctx.JSON(http.StatusOK, rsp)
 }
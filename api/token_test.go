@@ -0,0 +1,260 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	db "github.com/tgfukuda/be-master/db/sqlc"
+	"github.com/tgfukuda/be-master/mocks"
+	"github.com/tgfukuda/be-master/token"
+)
+
+// setRefreshToken replaces the request body with the refresh token created by the server under test
+func setRefreshToken(t *testing.T, request *http.Request, refreshToken string) {
+	body, err := json.Marshal(gin.H{"refresh_token": refreshToken})
+	assert.NoError(t, err)
+
+	request.Body = ioutil.NopCloser(bytes.NewReader(body))
+	request.ContentLength = int64(len(body))
+}
+
+func TestRenewAccessToken(t *testing.T) {
+	user, _ := randomUser(t)
+
+	// set in setupAuth since the token maker of the server is created for each test case
+	var refreshToken string
+	var refreshPayload *token.Payload
+	createRefreshToken := func(t *testing.T, request *http.Request, tokenMaker token.Maker, duration time.Duration) {
+		var err error
+		refreshToken, refreshPayload, err = tokenMaker.CreateToken(user.Username, user.Role, duration)
+		assert.NoError(t, err)
+		setRefreshToken(t, request, refreshToken)
+	}
+	matchParams := mock.MatchedBy(func(arg db.RenewSessionTxParams) bool {
+		return arg.ID == refreshPayload.ID &&
+			arg.Username == user.Username &&
+			arg.RefreshToken == refreshToken &&
+			arg.NewSession != nil
+	})
+
+	RunTestCases(t, []APITestCase{
+		{
+			name:   "OK",
+			path:   "/tokens/renew_access",
+			method: http.MethodPost,
+			body:   gin.H{},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				createRefreshToken(t, request, tokenMaker, time.Minute)
+			},
+			buildStubs: func(store *mocks.Store) {
+				store.EXPECT().
+					GetUser(mock.Anything, user.Username).
+					Times(1).
+					Return(user, nil)
+
+				store.EXPECT().
+					RenewSessionTx(mock.Anything, matchParams).
+					RunAndReturn(func(ctx context.Context, arg db.RenewSessionTxParams) (db.RenewSessionTxResult, error) {
+						old := db.Session{
+							ID:           arg.ID,
+							Username:     arg.Username,
+							RefreshToken: arg.RefreshToken,
+							FamilyID:     arg.ID,
+							ExpiredAt:    refreshPayload.ExpiredAt,
+						}
+						newSession, err := arg.NewSession(old)
+						assert.NoError(t, err)
+						assert.Equal(t, user.Username, newSession.Username)
+						assert.NotEqual(t, old.ID, newSession.ID)
+						assert.NotEqual(t, old.RefreshToken, newSession.RefreshToken)
+
+						return db.RenewSessionTxResult{
+							OldSession: old,
+							Session: db.Session{
+								ID:           newSession.ID,
+								Username:     newSession.Username,
+								RefreshToken: newSession.RefreshToken,
+								FamilyID:     old.FamilyID,
+								ExpiredAt:    newSession.ExpiredAt,
+							},
+						}, nil
+					}).
+					Times(1)
+			},
+			checkResponse: func(t *testing.T, recoder *httptest.ResponseRecorder, tokenMaker token.Maker) {
+				assert.Equal(t, http.StatusOK, recoder.Code)
+
+				var rsp renewAccessTokenResponse
+				err := json.Unmarshal(recoder.Body.Bytes(), &rsp)
+				assert.NoError(t, err)
+				assert.NotEqual(t, refreshPayload.ID, rsp.SessionId)
+				assert.NotEqual(t, refreshToken, rsp.RefreshToken)
+
+				payload, err := tokenMaker.VerifyToken(rsp.AccessToken)
+				assert.NoError(t, err)
+				assert.Equal(t, user.Username, payload.Username)
+				assert.Equal(t, user.Role, payload.Role)
+			},
+		},
+		{
+			name:   "NoRefreshToken",
+			path:   "/tokens/renew_access",
+			method: http.MethodPost,
+			body:   gin.H{},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+			},
+			buildStubs: func(store *mocks.Store) {
+			},
+			checkResponse: func(t *testing.T, recoder *httptest.ResponseRecorder, tokenMaker token.Maker) {
+				assert.Equal(t, http.StatusBadRequest, recoder.Code)
+			},
+		},
+		{
+			name:   "InvalidRefreshToken",
+			path:   "/tokens/renew_access",
+			method: http.MethodPost,
+			body: gin.H{
+				"refresh_token": "invalid",
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+			},
+			buildStubs: func(store *mocks.Store) {
+			},
+			checkResponse: func(t *testing.T, recoder *httptest.ResponseRecorder, tokenMaker token.Maker) {
+				assert.Equal(t, http.StatusUnauthorized, recoder.Code)
+			},
+		},
+		{
+			name:   "ExpiredRefreshToken",
+			path:   "/tokens/renew_access",
+			method: http.MethodPost,
+			body:   gin.H{},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				createRefreshToken(t, request, tokenMaker, -time.Minute)
+			},
+			buildStubs: func(store *mocks.Store) {
+			},
+			checkResponse: func(t *testing.T, recoder *httptest.ResponseRecorder, tokenMaker token.Maker) {
+				assert.Equal(t, http.StatusUnauthorized, recoder.Code)
+			},
+		},
+		{
+			name:   "SessionNotFound",
+			path:   "/tokens/renew_access",
+			method: http.MethodPost,
+			body:   gin.H{},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				createRefreshToken(t, request, tokenMaker, time.Minute)
+			},
+			buildStubs: func(store *mocks.Store) {
+				store.EXPECT().
+					RenewSessionTx(mock.Anything, matchParams).
+					Times(1).
+					Return(db.RenewSessionTxResult{}, sql.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, recoder *httptest.ResponseRecorder, tokenMaker token.Maker) {
+				assert.Equal(t, http.StatusUnauthorized, recoder.Code)
+			},
+		},
+		{
+			name:   "BlockedSession",
+			path:   "/tokens/renew_access",
+			method: http.MethodPost,
+			body:   gin.H{},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				createRefreshToken(t, request, tokenMaker, time.Minute)
+			},
+			buildStubs: func(store *mocks.Store) {
+				store.EXPECT().
+					RenewSessionTx(mock.Anything, matchParams).
+					Times(1).
+					Return(db.RenewSessionTxResult{}, db.ErrSessionBlocked)
+			},
+			checkResponse: func(t *testing.T, recoder *httptest.ResponseRecorder, tokenMaker token.Maker) {
+				assert.Equal(t, http.StatusUnauthorized, recoder.Code)
+			},
+		},
+		{
+			name:   "ExpiredSession",
+			path:   "/tokens/renew_access",
+			method: http.MethodPost,
+			body:   gin.H{},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				createRefreshToken(t, request, tokenMaker, time.Minute)
+			},
+			buildStubs: func(store *mocks.Store) {
+				store.EXPECT().
+					RenewSessionTx(mock.Anything, matchParams).
+					Times(1).
+					Return(db.RenewSessionTxResult{}, db.ErrSessionExpired)
+			},
+			checkResponse: func(t *testing.T, recoder *httptest.ResponseRecorder, tokenMaker token.Maker) {
+				assert.Equal(t, http.StatusUnauthorized, recoder.Code)
+			},
+		},
+		{
+			name:   "MismatchedSession",
+			path:   "/tokens/renew_access",
+			method: http.MethodPost,
+			body:   gin.H{},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				createRefreshToken(t, request, tokenMaker, time.Minute)
+			},
+			buildStubs: func(store *mocks.Store) {
+				store.EXPECT().
+					RenewSessionTx(mock.Anything, matchParams).
+					Times(1).
+					Return(db.RenewSessionTxResult{}, db.ErrSessionMismatch)
+			},
+			checkResponse: func(t *testing.T, recoder *httptest.ResponseRecorder, tokenMaker token.Maker) {
+				assert.Equal(t, http.StatusUnauthorized, recoder.Code)
+			},
+		},
+		{
+			name:   "ReusedRefreshToken",
+			path:   "/tokens/renew_access",
+			method: http.MethodPost,
+			body:   gin.H{},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				createRefreshToken(t, request, tokenMaker, time.Minute)
+			},
+			buildStubs: func(store *mocks.Store) {
+				store.EXPECT().
+					RenewSessionTx(mock.Anything, matchParams).
+					Times(1).
+					Return(db.RenewSessionTxResult{}, db.ErrRefreshTokenReused)
+			},
+			checkResponse: func(t *testing.T, recoder *httptest.ResponseRecorder, tokenMaker token.Maker) {
+				assert.Equal(t, http.StatusUnauthorized, recoder.Code)
+			},
+		},
+		{
+			name:   "InternalError",
+			path:   "/tokens/renew_access",
+			method: http.MethodPost,
+			body:   gin.H{},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				createRefreshToken(t, request, tokenMaker, time.Minute)
+			},
+			buildStubs: func(store *mocks.Store) {
+				store.EXPECT().
+					RenewSessionTx(mock.Anything, matchParams).
+					Times(1).
+					Return(db.RenewSessionTxResult{}, sql.ErrConnDone)
+			},
+			checkResponse: func(t *testing.T, recoder *httptest.ResponseRecorder, tokenMaker token.Maker) {
+				assert.Equal(t, http.StatusInternalServerError, recoder.Code)
+			},
+		},
+	})
+}
@@ -8,7 +8,15 @@ import (
 
 	"github.com/gin-gonic/gin"
 	db "github.com/tgfukuda/be-master/db/sqlc"
+	"github.com/tgfukuda/be-master/fx"
 	"github.com/tgfukuda/be-master/token"
+	"github.com/tgfukuda/be-master/util"
+	"github.com/tgfukuda/be-master/val"
+)
+
+const (
+	idempotencyKeyHeaderKey     = "Idempotency-Key"
+	idempotentReplayedHeaderKey = "Idempotent-Replayed"
 )
 
 type TransferRequest struct {
@@ -16,6 +24,7 @@ type TransferRequest struct {
 	ToAccountID   int64  `json:"to_account_id" binding:"required,min=1"`
 	Amount        int64  `json:"amount" binding:"required,gt=0"`
 	Currency      string `json:"currency" binding:"required,currency"`
+	ToCurrency    string `json:"to_currency" binding:"omitempty,currency"` // currency of the to account. same as currency if omitted
 }
 
 func (server *Server) CreateTransfer(ctx *gin.Context) {
@@ -25,6 +34,14 @@ func (server *Server) CreateTransfer(ctx *gin.Context) {
 		return
 	}
 
+	idempotencyKey := ctx.GetHeader(idempotencyKeyHeaderKey)
+	if len(idempotencyKey) > 0 {
+		if err := val.ValidateIdempotencyKey(idempotencyKey); err != nil {
+			ctx.JSON(http.StatusBadRequest, errorResponse(fmt.Errorf("invalid %s header: %w", idempotencyKeyHeaderKey, err)))
+			return
+		}
+	}
+
 	from, valid := server.validateAccount(ctx, req.FromAccountID, req.Currency)
 	if !valid {
 		return
@@ -37,23 +54,54 @@ func (server *Server) CreateTransfer(ctx *gin.Context) {
 		return
 	}
 
-	_, valid = server.validateAccount(ctx, req.ToAccountID, req.Currency)
+	toCurrency := req.ToCurrency
+	if len(toCurrency) == 0 {
+		toCurrency = req.Currency
+	}
+
+	_, valid = server.validateAccount(ctx, req.ToAccountID, toCurrency)
 	if !valid {
 		return
 	}
 
 	arg := db.TransferTxParams{
-		FromAccountID: req.FromAccountID,
-		ToAccountID:   req.ToAccountID,
-		Amount:        req.Amount,
+		FromAccountID:  req.FromAccountID,
+		ToAccountID:    req.ToAccountID,
+		Amount:         req.Amount,
+		IdempotencyKey: idempotencyKey,
 	}
 
-	result, err := server.store.TransferTx(ctx, arg)
+	var result db.TransferTxResult
+	var err error
+	if toCurrency == req.Currency {
+		result, err = server.store.TransferTx(ctx, arg)
+	} else {
+		result, err = server.store.CrossCurrencyTransferTx(ctx, db.CrossCurrencyTransferTxParams{
+			TransferTxParams:     arg,
+			ExchangeRateProvider: server.exchangeRateProvider,
+		})
+	}
 	if err != nil {
+		if errors.Is(err, db.ErrTransferTooSmall) {
+			ctx.JSON(http.StatusBadRequest, errorResponse(err))
+			return
+		}
+		if errors.Is(err, db.ErrInsufficientFunds) || errors.Is(err, fx.ErrRateNotFound) {
+			ctx.JSON(http.StatusUnprocessableEntity, errorResponse(err))
+			return
+		}
+		if errors.Is(err, db.ErrIdempotencyKeyReused) || errors.Is(err, db.ErrAccountFrozen) || errors.Is(err, db.ErrAccountClosed) {
+			ctx.JSON(http.StatusConflict, errorResponse(err))
+			return
+		}
 		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
 		return
 	}
 
+	if result.Replayed {
+		ctx.Header(idempotentReplayedHeaderKey, "true")
+	}
+
 	ctx.JSON(http.StatusOK, result)
 }
 
@@ -77,3 +125,75 @@ func (server *Server) validateAccount(ctx *gin.Context, accountID int64, currenc
 
 	return account, true
 }
+
+type reverseTransferUri struct {
+	ID int64 `uri:"id" binding:"required,min=1"`
+}
+
+type ReverseTransferRequest struct {
+	Amount int64 `json:"amount" binding:"omitempty,gt=0"` // in the currency of the from account of the transfer. the whole rest if omitted
+}
+
+// ReverseTransfer refunds a transfer received by the authenticated user. staff can reverse any transfer
+func (server *Server) ReverseTransfer(ctx *gin.Context) {
+	var uri reverseTransferUri
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	// the body is optional to refund the whole rest
+	var req ReverseTransferRequest
+	if ctx.Request.ContentLength != 0 {
+		if err := ctx.ShouldBindJSON(&req); err != nil {
+			ctx.JSON(http.StatusBadRequest, errorResponse(err))
+			return
+		}
+	}
+
+	transfer, err := server.store.GetTransfer(ctx, uri.ID)
+	if err == sql.ErrNoRows {
+		ctx.JSON(http.StatusNotFound, errorResponse(err))
+		return
+	} else if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	receiver, err := server.store.GetAccount(ctx, transfer.ToAccountID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	if receiver.Owner != authPayload.Username && authPayload.CheckRole(util.StaffRoles) != nil {
+		err := errors.New("transfer wasn't received by the authenticated user")
+		ctx.JSON(http.StatusUnauthorized, errorResponse(err))
+		return
+	}
+
+	result, err := server.store.ReverseTransferTx(ctx, db.ReverseTransferTxParams{
+		TransferID: transfer.ID,
+		Amount:     req.Amount,
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrReversalTooSmall) {
+			ctx.JSON(http.StatusBadRequest, errorResponse(err))
+			return
+		}
+		if errors.Is(err, db.ErrInsufficientFunds) || errors.Is(err, db.ErrReversalExceedsTransfer) {
+			ctx.JSON(http.StatusUnprocessableEntity, errorResponse(err))
+			return
+		}
+		if errors.Is(err, db.ErrTransferAlreadyReversed) || errors.Is(err, db.ErrReversalNotReversible) ||
+			errors.Is(err, db.ErrAccountFrozen) || errors.Is(err, db.ErrAccountClosed) {
+			ctx.JSON(http.StatusConflict, errorResponse(err))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, result)
+}
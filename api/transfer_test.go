@@ -2,7 +2,9 @@ package api
 
 import (
 	"bytes"
+	"database/sql"
 	"encoding/json"
+	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
@@ -13,6 +15,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	db "github.com/tgfukuda/be-master/db/sqlc"
+	"github.com/tgfukuda/be-master/fx"
 	"github.com/tgfukuda/be-master/mocks"
 	"github.com/tgfukuda/be-master/token"
 	"github.com/tgfukuda/be-master/util"
@@ -37,6 +40,25 @@ func TestCreateTransfer(t *testing.T) {
 		FromEntry:   entry1,
 		ToEntry:     entry2,
 	}
+	idempotencyKey := util.RandomString(32)
+	account4 := randomAccount(user2.Username)
+	for account4.Currency == account1.Currency {
+		account4.Currency = util.RandomCurrency()
+	}
+	fxTransfer := randomTransfer(account1, account4, amount)
+	fxTransfer.ToAmount = amount * 2
+	fxTransfer.ExchangeRate = "2"
+	fxResult := db.TransferTxResult{
+		Transfer:    fxTransfer,
+		FromAccount: account1,
+		ToAccount:   account4,
+		FromEntry:   entry1,
+		ToEntry:     randomEntry(account4, fxTransfer.ToAmount),
+	}
+	matchFxTransferParams := mock.MatchedBy(func(arg db.CrossCurrencyTransferTxParams) bool {
+		return arg.TransferTxParams == db.TransferTxParams{FromAccountID: account1.ID, ToAccountID: account4.ID, Amount: amount} &&
+			arg.ExchangeRateProvider != nil
+	})
 
 	RunTestCases(t, []APITestCase{
 		{
@@ -50,7 +72,7 @@ func TestCreateTransfer(t *testing.T) {
 				"currency":        account1.Currency,
 			},
 			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
-				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user1.Username, time.Minute)
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user1.Username, util.DepositorRole, time.Minute)
 			},
 			buildStubs: func(store *mocks.Store) {
 				store.EXPECT().
@@ -84,7 +106,7 @@ func TestCreateTransfer(t *testing.T) {
 				"currency":        account1.Currency,
 			},
 			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
-				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, account3.Owner, time.Minute)
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, account3.Owner, util.DepositorRole, time.Minute)
 			},
 			buildStubs: func(store *mocks.Store) {
 				store.EXPECT().
@@ -106,7 +128,7 @@ func TestCreateTransfer(t *testing.T) {
 				"currency":      account1.Currency,
 			},
 			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
-				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user1.Username, time.Minute)
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user1.Username, util.DepositorRole, time.Minute)
 			},
 			buildStubs: func(store *mocks.Store) {
 			},
@@ -124,7 +146,7 @@ func TestCreateTransfer(t *testing.T) {
 				"currency":        account1.Currency,
 			},
 			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
-				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user1.Username, time.Minute)
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user1.Username, util.DepositorRole, time.Minute)
 			},
 			buildStubs: func(store *mocks.Store) {
 			},
@@ -143,7 +165,7 @@ func TestCreateTransfer(t *testing.T) {
 				"currency":        account1.Currency,
 			},
 			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
-				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user1.Username, time.Minute)
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user1.Username, util.DepositorRole, time.Minute)
 			},
 			buildStubs: func(store *mocks.Store) {
 			},
@@ -162,7 +184,228 @@ func TestCreateTransfer(t *testing.T) {
 				"currency":        "NOT A CURRENCY",
 			},
 			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
-				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user1.Username, time.Minute)
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user1.Username, util.DepositorRole, time.Minute)
+			},
+			buildStubs: func(store *mocks.Store) {
+			},
+			checkResponse: func(t *testing.T, recoder *httptest.ResponseRecorder, tokenMaker token.Maker) {
+				assert.Equal(t, http.StatusBadRequest, recoder.Code)
+			},
+		},
+		{
+			name:   "InsufficientFunds",
+			path:   "/transfers",
+			method: http.MethodPost,
+			body: gin.H{
+				"from_account_id": account1.ID,
+				"to_account_id":   account2.ID,
+				"amount":          amount,
+				"currency":        account1.Currency,
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user1.Username, util.DepositorRole, time.Minute)
+			},
+			buildStubs: func(store *mocks.Store) {
+				store.EXPECT().
+					GetAccount(mock.Anything, account1.ID).
+					Times(1).
+					Return(account1, nil)
+
+				store.EXPECT().
+					GetAccount(mock.Anything, account2.ID).
+					Times(1).
+					Return(account2, nil)
+
+				store.EXPECT().
+					TransferTx(mock.Anything, db.TransferTxParams{FromAccountID: account1.ID, ToAccountID: account2.ID, Amount: amount}).
+					Times(1).
+					Return(db.TransferTxResult{}, db.ErrInsufficientFunds)
+			},
+			checkResponse: func(t *testing.T, recoder *httptest.ResponseRecorder, tokenMaker token.Maker) {
+				assert.Equal(t, http.StatusUnprocessableEntity, recoder.Code)
+			},
+		},
+		{
+			name:   "AccountFrozen",
+			path:   "/transfers",
+			method: http.MethodPost,
+			body: gin.H{
+				"from_account_id": account1.ID,
+				"to_account_id":   account2.ID,
+				"amount":          amount,
+				"currency":        account1.Currency,
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user1.Username, util.DepositorRole, time.Minute)
+			},
+			buildStubs: func(store *mocks.Store) {
+				store.EXPECT().
+					GetAccount(mock.Anything, account1.ID).
+					Times(1).
+					Return(account1, nil)
+
+				store.EXPECT().
+					GetAccount(mock.Anything, account2.ID).
+					Times(1).
+					Return(account2, nil)
+
+				store.EXPECT().
+					TransferTx(mock.Anything, db.TransferTxParams{FromAccountID: account1.ID, ToAccountID: account2.ID, Amount: amount}).
+					Times(1).
+					Return(db.TransferTxResult{}, db.ErrAccountFrozen)
+			},
+			checkResponse: func(t *testing.T, recoder *httptest.ResponseRecorder, tokenMaker token.Maker) {
+				assert.Equal(t, http.StatusConflict, recoder.Code)
+			},
+		},
+		{
+			name:   "IdempotentReplay",
+			path:   "/transfers",
+			method: http.MethodPost,
+			body: gin.H{
+				"from_account_id": account1.ID,
+				"to_account_id":   account2.ID,
+				"amount":          amount,
+				"currency":        account1.Currency,
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user1.Username, util.DepositorRole, time.Minute)
+				request.Header.Set(idempotencyKeyHeaderKey, idempotencyKey)
+			},
+			buildStubs: func(store *mocks.Store) {
+				store.EXPECT().
+					GetAccount(mock.Anything, account1.ID).
+					Times(1).
+					Return(account1, nil)
+
+				store.EXPECT().
+					GetAccount(mock.Anything, account2.ID).
+					Times(1).
+					Return(account2, nil)
+
+				replayed := result
+				replayed.Replayed = true
+				store.EXPECT().
+					TransferTx(mock.Anything, db.TransferTxParams{FromAccountID: account1.ID, ToAccountID: account2.ID, Amount: amount, IdempotencyKey: idempotencyKey}).
+					Times(1).
+					Return(replayed, nil)
+			},
+			checkResponse: func(t *testing.T, recoder *httptest.ResponseRecorder, tokenMaker token.Maker) {
+				assert.Equal(t, http.StatusOK, recoder.Code)
+				assert.Equal(t, "true", recoder.Header().Get(idempotentReplayedHeaderKey))
+				requireMatchTransferTxResult(t, recoder.Body, result)
+			},
+		},
+		{
+			name:   "IdempotencyKeyReused",
+			path:   "/transfers",
+			method: http.MethodPost,
+			body: gin.H{
+				"from_account_id": account1.ID,
+				"to_account_id":   account2.ID,
+				"amount":          amount,
+				"currency":        account1.Currency,
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user1.Username, util.DepositorRole, time.Minute)
+				request.Header.Set(idempotencyKeyHeaderKey, idempotencyKey)
+			},
+			buildStubs: func(store *mocks.Store) {
+				store.EXPECT().
+					GetAccount(mock.Anything, account1.ID).
+					Times(1).
+					Return(account1, nil)
+
+				store.EXPECT().
+					GetAccount(mock.Anything, account2.ID).
+					Times(1).
+					Return(account2, nil)
+
+				store.EXPECT().
+					TransferTx(mock.Anything, db.TransferTxParams{FromAccountID: account1.ID, ToAccountID: account2.ID, Amount: amount, IdempotencyKey: idempotencyKey}).
+					Times(1).
+					Return(db.TransferTxResult{}, db.ErrIdempotencyKeyReused)
+			},
+			checkResponse: func(t *testing.T, recoder *httptest.ResponseRecorder, tokenMaker token.Maker) {
+				assert.Equal(t, http.StatusConflict, recoder.Code)
+			},
+		},
+		{
+			name:   "CrossCurrency",
+			path:   "/transfers",
+			method: http.MethodPost,
+			body: gin.H{
+				"from_account_id": account1.ID,
+				"to_account_id":   account4.ID,
+				"amount":          amount,
+				"currency":        account1.Currency,
+				"to_currency":     account4.Currency,
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user1.Username, util.DepositorRole, time.Minute)
+			},
+			buildStubs: func(store *mocks.Store) {
+				store.EXPECT().
+					GetAccount(mock.Anything, account1.ID).
+					Times(1).
+					Return(account1, nil)
+
+				store.EXPECT().
+					GetAccount(mock.Anything, account4.ID).
+					Times(1).
+					Return(account4, nil)
+
+				store.EXPECT().
+					CrossCurrencyTransferTx(mock.Anything, matchFxTransferParams).
+					Times(1).
+					Return(fxResult, nil)
+			},
+			checkResponse: func(t *testing.T, recoder *httptest.ResponseRecorder, tokenMaker token.Maker) {
+				assert.Equal(t, http.StatusOK, recoder.Code)
+				requireMatchTransferTxResult(t, recoder.Body, fxResult)
+			},
+		},
+		{
+			name:   "CrossCurrencyWithoutToCurrency",
+			path:   "/transfers",
+			method: http.MethodPost,
+			body: gin.H{
+				"from_account_id": account1.ID,
+				"to_account_id":   account4.ID,
+				"amount":          amount,
+				"currency":        account1.Currency,
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user1.Username, util.DepositorRole, time.Minute)
+			},
+			buildStubs: func(store *mocks.Store) {
+				store.EXPECT().
+					GetAccount(mock.Anything, account1.ID).
+					Times(1).
+					Return(account1, nil)
+
+				store.EXPECT().
+					GetAccount(mock.Anything, account4.ID).
+					Times(1).
+					Return(account4, nil)
+			},
+			checkResponse: func(t *testing.T, recoder *httptest.ResponseRecorder, tokenMaker token.Maker) {
+				assert.Equal(t, http.StatusBadRequest, recoder.Code)
+			},
+		},
+		{
+			name:   "InvalidToCurrency",
+			path:   "/transfers",
+			method: http.MethodPost,
+			body: gin.H{
+				"from_account_id": account1.ID,
+				"to_account_id":   account4.ID,
+				"amount":          amount,
+				"currency":        account1.Currency,
+				"to_currency":     "NOT A CURRENCY",
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user1.Username, util.DepositorRole, time.Minute)
 			},
 			buildStubs: func(store *mocks.Store) {
 			},
@@ -170,10 +413,319 @@ func TestCreateTransfer(t *testing.T) {
 				assert.Equal(t, http.StatusBadRequest, recoder.Code)
 			},
 		},
+		{
+			name:   "RateNotFound",
+			path:   "/transfers",
+			method: http.MethodPost,
+			body: gin.H{
+				"from_account_id": account1.ID,
+				"to_account_id":   account4.ID,
+				"amount":          amount,
+				"currency":        account1.Currency,
+				"to_currency":     account4.Currency,
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user1.Username, util.DepositorRole, time.Minute)
+			},
+			buildStubs: func(store *mocks.Store) {
+				store.EXPECT().
+					GetAccount(mock.Anything, account1.ID).
+					Times(1).
+					Return(account1, nil)
+
+				store.EXPECT().
+					GetAccount(mock.Anything, account4.ID).
+					Times(1).
+					Return(account4, nil)
+
+				store.EXPECT().
+					CrossCurrencyTransferTx(mock.Anything, matchFxTransferParams).
+					Times(1).
+					Return(db.TransferTxResult{}, fx.ErrRateNotFound)
+			},
+			checkResponse: func(t *testing.T, recoder *httptest.ResponseRecorder, tokenMaker token.Maker) {
+				assert.Equal(t, http.StatusUnprocessableEntity, recoder.Code)
+			},
+		},
+		{
+			name:   "TooSmallToConvert",
+			path:   "/transfers",
+			method: http.MethodPost,
+			body: gin.H{
+				"from_account_id": account1.ID,
+				"to_account_id":   account4.ID,
+				"amount":          amount,
+				"currency":        account1.Currency,
+				"to_currency":     account4.Currency,
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user1.Username, util.DepositorRole, time.Minute)
+			},
+			buildStubs: func(store *mocks.Store) {
+				store.EXPECT().
+					GetAccount(mock.Anything, account1.ID).
+					Times(1).
+					Return(account1, nil)
+
+				store.EXPECT().
+					GetAccount(mock.Anything, account4.ID).
+					Times(1).
+					Return(account4, nil)
+
+				store.EXPECT().
+					CrossCurrencyTransferTx(mock.Anything, matchFxTransferParams).
+					Times(1).
+					Return(db.TransferTxResult{}, db.ErrTransferTooSmall)
+			},
+			checkResponse: func(t *testing.T, recoder *httptest.ResponseRecorder, tokenMaker token.Maker) {
+				assert.Equal(t, http.StatusBadRequest, recoder.Code)
+			},
+		},
 		// TODO: add tests
 	})
 }
 
+func TestReverseTransfer(t *testing.T) {
+	user1, _ := randomUser(t)
+	account1 := randomAccount(user1.Username)
+	user2, _ := randomUser(t)
+	account2 := randomAccount(user2.Username)
+	account2.Currency = account1.Currency
+	user3, _ := randomUser(t)
+	amount := account1.Balance / 2
+	original := randomTransfer(account1, account2, amount)
+	reversal := randomTransfer(account2, account1, amount/2)
+	reversal.ReversalOf = sql.NullInt64{Int64: original.ID, Valid: true}
+	result := db.ReverseTransferTxResult{
+		TransferTxResult: db.TransferTxResult{
+			Transfer:    reversal,
+			FromAccount: account2,
+			ToAccount:   account1,
+			FromEntry:   randomEntry(account2, -amount/2),
+			ToEntry:     randomEntry(account1, amount/2),
+		},
+		Original:       original,
+		ReversedAmount: amount / 2,
+	}
+	path := fmt.Sprintf("/transfers/%d/reverse", original.ID)
+
+	RunTestCases(t, []APITestCase{
+		{
+			name:   "OK",
+			path:   path,
+			method: http.MethodPost,
+			body:   gin.H{"amount": amount / 2},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user2.Username, util.DepositorRole, time.Minute)
+			},
+			buildStubs: func(store *mocks.Store) {
+				store.EXPECT().
+					GetTransfer(mock.Anything, original.ID).
+					Times(1).
+					Return(original, nil)
+
+				store.EXPECT().
+					GetAccount(mock.Anything, account2.ID).
+					Times(1).
+					Return(account2, nil)
+
+				store.EXPECT().
+					ReverseTransferTx(mock.Anything, db.ReverseTransferTxParams{TransferID: original.ID, Amount: amount / 2}).
+					Times(1).
+					Return(result, nil)
+			},
+			checkResponse: func(t *testing.T, recoder *httptest.ResponseRecorder, tokenMaker token.Maker) {
+				assert.Equal(t, http.StatusOK, recoder.Code)
+
+				var gotResult db.ReverseTransferTxResult
+				err := json.Unmarshal(recoder.Body.Bytes(), &gotResult)
+				assert.NoError(t, err)
+				assert.Equal(t, result, gotResult)
+			},
+		},
+		{
+			name:   "WholeRest",
+			path:   path,
+			method: http.MethodPost,
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user2.Username, util.DepositorRole, time.Minute)
+			},
+			buildStubs: func(store *mocks.Store) {
+				store.EXPECT().
+					GetTransfer(mock.Anything, original.ID).
+					Times(1).
+					Return(original, nil)
+
+				store.EXPECT().
+					GetAccount(mock.Anything, account2.ID).
+					Times(1).
+					Return(account2, nil)
+
+				store.EXPECT().
+					ReverseTransferTx(mock.Anything, db.ReverseTransferTxParams{TransferID: original.ID}).
+					Times(1).
+					Return(result, nil)
+			},
+			checkResponse: func(t *testing.T, recoder *httptest.ResponseRecorder, tokenMaker token.Maker) {
+				assert.Equal(t, http.StatusOK, recoder.Code)
+			},
+		},
+		{
+			name:   "Banker",
+			path:   path,
+			method: http.MethodPost,
+			body:   gin.H{"amount": amount / 2},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user3.Username, util.BankerRole, time.Minute)
+			},
+			buildStubs: func(store *mocks.Store) {
+				store.EXPECT().
+					GetTransfer(mock.Anything, original.ID).
+					Times(1).
+					Return(original, nil)
+
+				store.EXPECT().
+					GetAccount(mock.Anything, account2.ID).
+					Times(1).
+					Return(account2, nil)
+
+				store.EXPECT().
+					ReverseTransferTx(mock.Anything, db.ReverseTransferTxParams{TransferID: original.ID, Amount: amount / 2}).
+					Times(1).
+					Return(result, nil)
+			},
+			checkResponse: func(t *testing.T, recoder *httptest.ResponseRecorder, tokenMaker token.Maker) {
+				assert.Equal(t, http.StatusOK, recoder.Code)
+			},
+		},
+		{
+			// the sender can't take the money back by itself
+			name:   "UnAuthorizedSender",
+			path:   path,
+			method: http.MethodPost,
+			body:   gin.H{"amount": amount / 2},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user1.Username, util.DepositorRole, time.Minute)
+			},
+			buildStubs: func(store *mocks.Store) {
+				store.EXPECT().
+					GetTransfer(mock.Anything, original.ID).
+					Times(1).
+					Return(original, nil)
+
+				store.EXPECT().
+					GetAccount(mock.Anything, account2.ID).
+					Times(1).
+					Return(account2, nil)
+			},
+			checkResponse: func(t *testing.T, recoder *httptest.ResponseRecorder, tokenMaker token.Maker) {
+				assert.Equal(t, http.StatusUnauthorized, recoder.Code)
+			},
+		},
+		{
+			name:   "NoAuthorization",
+			path:   path,
+			method: http.MethodPost,
+			body:   gin.H{"amount": amount / 2},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+			},
+			buildStubs: func(store *mocks.Store) {
+			},
+			checkResponse: func(t *testing.T, recoder *httptest.ResponseRecorder, tokenMaker token.Maker) {
+				assert.Equal(t, http.StatusUnauthorized, recoder.Code)
+			},
+		},
+		{
+			name:   "InvalidAmount",
+			path:   path,
+			method: http.MethodPost,
+			body:   gin.H{"amount": -1},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user2.Username, util.DepositorRole, time.Minute)
+			},
+			buildStubs: func(store *mocks.Store) {
+			},
+			checkResponse: func(t *testing.T, recoder *httptest.ResponseRecorder, tokenMaker token.Maker) {
+				assert.Equal(t, http.StatusBadRequest, recoder.Code)
+			},
+		},
+		{
+			name:   "NotFound",
+			path:   path,
+			method: http.MethodPost,
+			body:   gin.H{"amount": amount / 2},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user2.Username, util.DepositorRole, time.Minute)
+			},
+			buildStubs: func(store *mocks.Store) {
+				store.EXPECT().
+					GetTransfer(mock.Anything, original.ID).
+					Times(1).
+					Return(db.Transfer{}, sql.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, recoder *httptest.ResponseRecorder, tokenMaker token.Maker) {
+				assert.Equal(t, http.StatusNotFound, recoder.Code)
+			},
+		},
+		{
+			name:   "AlreadyReversed",
+			path:   path,
+			method: http.MethodPost,
+			body:   gin.H{"amount": amount / 2},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user2.Username, util.DepositorRole, time.Minute)
+			},
+			buildStubs: func(store *mocks.Store) {
+				store.EXPECT().
+					GetTransfer(mock.Anything, original.ID).
+					Times(1).
+					Return(original, nil)
+
+				store.EXPECT().
+					GetAccount(mock.Anything, account2.ID).
+					Times(1).
+					Return(account2, nil)
+
+				store.EXPECT().
+					ReverseTransferTx(mock.Anything, mock.Anything).
+					Times(1).
+					Return(db.ReverseTransferTxResult{}, db.ErrTransferAlreadyReversed)
+			},
+			checkResponse: func(t *testing.T, recoder *httptest.ResponseRecorder, tokenMaker token.Maker) {
+				assert.Equal(t, http.StatusConflict, recoder.Code)
+			},
+		},
+		{
+			name:   "ExceedsTransfer",
+			path:   path,
+			method: http.MethodPost,
+			body:   gin.H{"amount": amount * 2},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user2.Username, util.DepositorRole, time.Minute)
+			},
+			buildStubs: func(store *mocks.Store) {
+				store.EXPECT().
+					GetTransfer(mock.Anything, original.ID).
+					Times(1).
+					Return(original, nil)
+
+				store.EXPECT().
+					GetAccount(mock.Anything, account2.ID).
+					Times(1).
+					Return(account2, nil)
+
+				store.EXPECT().
+					ReverseTransferTx(mock.Anything, mock.Anything).
+					Times(1).
+					Return(db.ReverseTransferTxResult{}, db.ErrReversalExceedsTransfer)
+			},
+			checkResponse: func(t *testing.T, recoder *httptest.ResponseRecorder, tokenMaker token.Maker) {
+				assert.Equal(t, http.StatusUnprocessableEntity, recoder.Code)
+			},
+		},
+	})
+}
+
 func randomEntry(account db.Account, amount int64) db.Entry {
 	return db.Entry{
 		ID:        util.RandomInt(1, 1000),
@@ -188,6 +740,8 @@ func randomTransfer(from, to db.Account, amount int64) db.Transfer {
 		FromAccountID: from.ID,
 		ToAccountID:   to.ID,
 		Amount:        amount,
+		ToAmount:      amount,
+		ExchangeRate:  "1",
 	}
 }
 
@@ -291,6 +291,7 @@ func randomUser(t *testing.T) (user db.User, password string) {
 		HashedPassword: hashedPassword,
 		FullName:       util.RandomString(10),
 		Email:          util.RandomEmail(),
+		Role:           util.DepositorRole,
 	}
 
 	return
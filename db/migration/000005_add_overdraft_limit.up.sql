@@ -0,0 +1,11 @@
+ALTER TABLE "accounts" ADD COLUMN "overdraft_limit" bigint NOT NULL DEFAULT 0;
+
+ALTER TABLE "accounts" ADD CONSTRAINT "overdraft_limit_non_negative" CHECK ("overdraft_limit" >= 0);
+
+-- the accounts already below zero keep their debt as the limit, otherwise the constraint below fails on them
+UPDATE "accounts" SET "overdraft_limit" = -"balance" WHERE "balance" < 0;
+
+-- balance can go below zero only within the overdraft limit of the account
+ALTER TABLE "accounts" ADD CONSTRAINT "balance_within_overdraft_limit" CHECK ("balance" >= -"overdraft_limit");
+
+COMMENT ON COLUMN "accounts"."overdraft_limit" IS 'must be positive. the balance can not be less than -overdraft_limit';
@@ -0,0 +1,14 @@
+ALTER TABLE "transfers" ADD COLUMN "to_amount" bigint;
+
+ALTER TABLE "transfers" ADD COLUMN "exchange_rate" numeric NOT NULL DEFAULT 1;
+
+-- transfers so far are between the same currency
+UPDATE "transfers" SET "to_amount" = "amount";
+
+ALTER TABLE "transfers" ALTER COLUMN "to_amount" SET NOT NULL;
+
+COMMENT ON COLUMN "transfers"."amount" IS 'must be positive. in the currency of the from account';
+
+COMMENT ON COLUMN "transfers"."to_amount" IS 'must be positive. in the currency of the to account';
+
+COMMENT ON COLUMN "transfers"."exchange_rate" IS 'to_amount = amount * exchange_rate rounded toward zero';
@@ -0,0 +1,16 @@
+ALTER TABLE "sessions" ADD COLUMN "family_id" uuid;
+
+ALTER TABLE "sessions" ADD COLUMN "is_rotated" boolean NOT NULL DEFAULT false;
+
+-- every existing session starts its own family
+UPDATE "sessions" SET "family_id" = "id";
+
+ALTER TABLE "sessions" ALTER COLUMN "family_id" SET NOT NULL;
+
+CREATE INDEX ON "sessions" ("family_id");
+
+CREATE INDEX ON "sessions" ("username");
+
+COMMENT ON COLUMN "sessions"."family_id" IS 'id of the session created at login. shared by all the sessions rotated from it';
+
+COMMENT ON COLUMN "sessions"."is_rotated" IS 'the refresh token is already exchanged. presenting it again blocks the family';
@@ -0,0 +1,21 @@
+CREATE TABLE "outbox" (
+  "id" bigserial PRIMARY KEY,
+  "task_type" varchar NOT NULL,
+  "payload" jsonb NOT NULL,
+  "queue" varchar NOT NULL,
+  "max_retry" integer NOT NULL,
+  "process_in_seconds" integer NOT NULL DEFAULT 0,
+  "attempts" integer NOT NULL DEFAULT 0,
+  "last_error" varchar,
+  "next_attempt_at" timestamptz NOT NULL DEFAULT (now()),
+  "sent_at" timestamptz,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX ON "outbox" ("next_attempt_at") WHERE "sent_at" IS NULL;
+
+COMMENT ON COLUMN "outbox"."process_in_seconds" IS 'delay of the task counted from the relay';
+
+COMMENT ON COLUMN "outbox"."next_attempt_at" IS 'the relay backs off when the task queue is unavailable';
+
+COMMENT ON COLUMN "outbox"."sent_at" IS 'null until the task is enqueued';
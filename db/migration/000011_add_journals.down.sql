@@ -0,0 +1,16 @@
+DROP TRIGGER IF EXISTS "entries_immutable" ON "entries";
+
+DROP FUNCTION IF EXISTS "reject_entry_change";
+
+-- the exchange legs didn't exist before the journals
+DELETE FROM "entries" WHERE "account_id" IN (SELECT "id" FROM "accounts" WHERE "owner" = 'simple-bank');
+
+DELETE FROM "accounts" WHERE "owner" = 'simple-bank';
+
+DELETE FROM "users" WHERE "username" = 'simple-bank';
+
+ALTER TABLE IF EXISTS "entries" DROP COLUMN IF EXISTS "journal_id";
+
+ALTER TABLE IF EXISTS "transfers" DROP COLUMN IF EXISTS "journal_id";
+
+DROP TABLE IF EXISTS "journals";
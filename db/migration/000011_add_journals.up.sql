@@ -0,0 +1,56 @@
+CREATE TABLE "journals" (
+  "id" bigserial PRIMARY KEY,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+ALTER TABLE "transfers" ADD COLUMN "journal_id" bigint;
+
+ALTER TABLE "entries" ADD COLUMN "journal_id" bigint;
+
+-- a journal for each transfer so far. its entries were written in the same transaction, so they share created_at
+INSERT INTO "journals" ("id", "created_at") SELECT "id", "created_at" FROM "transfers";
+
+SELECT setval(pg_get_serial_sequence('journals', 'id'), COALESCE((SELECT max("id") FROM "journals"), 0) + 1, false);
+
+UPDATE "transfers" SET "journal_id" = "id";
+
+UPDATE "entries" AS e SET "journal_id" = t."id"
+FROM "transfers" AS t
+WHERE e."created_at" = t."created_at"
+  AND ((e."account_id" = t."from_account_id" AND e."amount" = -t."amount")
+    OR (e."account_id" = t."to_account_id" AND e."amount" = t."to_amount"));
+
+-- entries without a transfer get a journal on their own. they are reported as unbalanced by the ledger check
+UPDATE "entries" SET "journal_id" = nextval(pg_get_serial_sequence('journals', 'id')) WHERE "journal_id" IS NULL;
+
+INSERT INTO "journals" ("id", "created_at")
+SELECT "journal_id", "created_at" FROM "entries" WHERE "journal_id" NOT IN (SELECT "id" FROM "journals");
+
+ALTER TABLE "transfers" ALTER COLUMN "journal_id" SET NOT NULL;
+
+ALTER TABLE "entries" ALTER COLUMN "journal_id" SET NOT NULL;
+
+ALTER TABLE "transfers" ADD FOREIGN KEY ("journal_id") REFERENCES "journals" ("id");
+
+ALTER TABLE "transfers" ADD CONSTRAINT "transfers_journal_id_key" UNIQUE ("journal_id");
+
+ALTER TABLE "entries" ADD FOREIGN KEY ("journal_id") REFERENCES "journals" ("id");
+
+CREATE INDEX ON "entries" ("journal_id");
+
+COMMENT ON COLUMN "entries"."journal_id" IS 'the entries of a journal net to zero in each currency';
+
+-- the bank owns the accounts which take the other side of the currency exchanges.
+-- the username can't be registered by the users.
+INSERT INTO "users" ("username", "hashed_password", "full_name", "email")
+VALUES ('simple-bank', '', 'Simple Bank', 'ledger@simple-bank.invalid');
+
+-- entries are never changed. a mistake is corrected by another journal
+CREATE FUNCTION "reject_entry_change"() RETURNS trigger AS $$
+BEGIN
+  RAISE EXCEPTION 'entries are immutable' USING ERRCODE = 'restrict_violation';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER "entries_immutable" BEFORE UPDATE OR DELETE ON "entries"
+FOR EACH ROW EXECUTE FUNCTION "reject_entry_change"();
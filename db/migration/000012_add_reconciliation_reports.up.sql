@@ -0,0 +1,15 @@
+CREATE TABLE "reconciliation_reports" (
+  "id" bigserial PRIMARY KEY,
+  "account_id" bigint NOT NULL,
+  "balance" bigint NOT NULL,
+  "entries_total" bigint NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX ON "reconciliation_reports" ("account_id");
+
+COMMENT ON COLUMN "reconciliation_reports"."balance" IS 'the balance of the account at the reconciliation';
+
+COMMENT ON COLUMN "reconciliation_reports"."entries_total" IS 'the sum of the entries of the account at the reconciliation';
+
+ALTER TABLE "reconciliation_reports" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
@@ -0,0 +1,2 @@
+-- the statements read the entries of an account in a time range
+CREATE INDEX ON "entries" ("account_id", "created_at", "id");
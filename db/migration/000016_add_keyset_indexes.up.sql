@@ -0,0 +1,6 @@
+-- the lists are paged by (created_at, id) from the last row of the previous page
+CREATE INDEX ON "accounts" ("owner", "created_at", "id");
+
+CREATE INDEX ON "transfers" ("from_account_id", "created_at", "id");
+
+CREATE INDEX ON "transfers" ("to_account_id", "created_at", "id");
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS "account_status_events";
+
+ALTER TABLE IF EXISTS "accounts" DROP CONSTRAINT IF EXISTS "account_status_valid";
+
+ALTER TABLE "accounts" DROP COLUMN "status";
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS "scheduled_transfer_runs_scheduled_transfer_id_created_at_id_idx";
+
+DROP INDEX IF EXISTS "scheduled_transfers_owner_created_at_id_idx";
@@ -0,0 +1,4 @@
+-- the scheduled transfers and their runs are paged by (created_at, id) from the last row of the previous page
+CREATE INDEX ON "scheduled_transfers" ("owner", "created_at", "id");
+
+CREATE INDEX ON "scheduled_transfer_runs" ("scheduled_transfer_id", "created_at", "id");
@@ -23,23 +23,46 @@ FOR UPDATE;
 
 -- name: ListAccounts :many
 SELECT * FROM accounts
-WHERE owner = $1
-ORDER BY id
-LIMIT $2
-OFFSET $3;
+WHERE owner = sqlc.arg(owner)
+  AND (created_at, id) > (sqlc.arg(after_created_at)::timestamptz, sqlc.arg(after_id)::bigint)
+ORDER BY created_at, id
+LIMIT sqlc.arg(limit_count);
 
--- name: UpdateAccount :one
+-- name: AddAccountBalance :one
 UPDATE accounts
-SET balance = $2
-WHERE id = $1
+SET balance = balance + sqlc.arg(amount)
+WHERE id = sqlc.arg(id)
 RETURNING *;
 
--- name: AddAccountBalance :one
+-- name: UpdateAccountStatus :one
 UPDATE accounts
-SET balance = balance + sqlc.arg(amount)
+SET status = sqlc.arg(status)
+WHERE id = sqlc.arg(id)
+RETURNING *;
+
+-- name: UpdateAccountOverdraftLimit :one
+UPDATE accounts
+SET overdraft_limit = sqlc.arg(overdraft_limit)
 WHERE id = sqlc.arg(id)
 RETURNING *;
 
--- name: DeleteAccount :exec
-DELETE FROM accounts
-WHERE id = $1;
+-- name: GetAccountByCurrency :one
+SELECT * FROM accounts
+WHERE owner = $1 AND currency = $2 LIMIT 1;
+
+-- name: CreateExchangeAccount :one
+-- the exchange account takes any amount, so the overdraft limit is the max of bigint
+INSERT INTO accounts (
+  owner,
+  balance,
+  currency,
+  overdraft_limit
+) VALUES (
+  $1, 0, $2, 9223372036854775807
+) RETURNING *;
+
+-- name: ListAccountIDs :many
+SELECT id FROM accounts
+WHERE id > sqlc.arg(after_id)
+ORDER BY id
+LIMIT sqlc.arg(limit_count);
@@ -0,0 +1,17 @@
+-- name: CreateAccountStatusEvent :one
+INSERT INTO account_status_events (
+  account_id,
+  from_status,
+  to_status,
+  reason,
+  actor
+) VALUES (
+  $1, $2, $3, $4, $5
+) RETURNING *;
+
+-- name: ListAccountStatusEvents :many
+SELECT * FROM account_status_events
+WHERE account_id = $1
+ORDER BY id
+LIMIT $2
+OFFSET $3;
@@ -1,9 +1,10 @@
 -- name: CreateEntry :one
 INSERT INTO entries (
   account_id,
-  amount
+  amount,
+  journal_id
 ) VALUES (
-  $1, $2
+  $1, $2, $3
 ) RETURNING *;
 
 -- name: GetEntry :one
@@ -18,17 +19,35 @@ OFFSET $2;
 
 -- name: ListEntriesOf :many
 SELECT * FROM entries
-WHERE account_id = $1
-ORDER BY id
-LIMIT $2
-OFFSET $3;
+WHERE account_id = sqlc.arg(account_id)
+  AND (created_at, id) > (sqlc.arg(after_created_at)::timestamptz, sqlc.arg(after_id)::bigint)
+ORDER BY created_at, id
+LIMIT sqlc.arg(limit_count);
+
+-- name: ListJournalEntries :many
+SELECT * FROM entries
+WHERE journal_id = $1
+ORDER BY id;
 
--- name: UpdateEntry :one
-UPDATE entries
-SET amount = $2
-WHERE id = $1
-RETURNING *;
+-- name: GetBalanceBefore :one
+SELECT COALESCE(SUM(amount), 0)::bigint AS balance FROM entries
+WHERE account_id = $1 AND created_at < $2;
 
--- name: DeleteEntry :exec
-DELETE FROM entries
-WHERE id = $1;
+-- name: ListStatementEntries :many
+SELECT e.id, e.account_id, e.amount, e.created_at, e.journal_id,
+  t.id AS transfer_id,
+  c.id AS counterparty_account_id,
+  c.owner AS counterparty_owner
+FROM entries AS e
+LEFT JOIN transfers AS t ON t.journal_id = e.journal_id
+-- the exchange legs of the bank are neither side of the transfer, so they have no counterparty
+LEFT JOIN accounts AS c ON c.id = CASE e.account_id
+  WHEN t.from_account_id THEN t.to_account_id
+  WHEN t.to_account_id THEN t.from_account_id
+END
+WHERE e.account_id = sqlc.arg(account_id)
+  AND e.created_at >= sqlc.arg(start_time)
+  AND e.created_at < sqlc.arg(end_time)
+  AND (e.created_at, e.id) > (sqlc.arg(after_created_at)::timestamptz, sqlc.arg(after_id)::bigint)
+ORDER BY e.created_at, e.id
+LIMIT sqlc.arg(limit_count);
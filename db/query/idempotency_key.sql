@@ -0,0 +1,24 @@
+-- name: CreateIdempotencyKey :one
+INSERT INTO idempotency_keys (
+  username,
+  idempotency_key,
+  request_hash
+) VALUES (
+  $1, $2, $3
+)
+ON CONFLICT (username, idempotency_key) DO NOTHING
+RETURNING *;
+
+-- name: GetIdempotencyKey :one
+SELECT * FROM idempotency_keys
+WHERE username = $1 AND idempotency_key = $2 LIMIT 1;
+
+-- name: UpdateIdempotencyKeyResponse :one
+UPDATE idempotency_keys
+SET
+  transfer_id = sqlc.arg(transfer_id),
+  response = sqlc.arg(response)
+WHERE
+  username = sqlc.arg(username)
+  AND idempotency_key = sqlc.arg(idempotency_key)
+RETURNING *;
@@ -0,0 +1,26 @@
+-- name: CreateJournal :one
+INSERT INTO journals DEFAULT VALUES
+RETURNING *;
+
+-- name: GetJournal :one
+SELECT * FROM journals
+WHERE id = $1 LIMIT 1;
+
+-- name: ListBalanceMismatches :many
+SELECT a.id AS account_id, a.balance, COALESCE(SUM(e.amount), 0)::bigint AS entries_total
+FROM accounts AS a
+LEFT JOIN entries AS e ON e.account_id = a.id
+WHERE sqlc.narg(account_id)::bigint IS NULL OR a.id = sqlc.narg(account_id)
+GROUP BY a.id
+HAVING a.balance <> COALESCE(SUM(e.amount), 0)
+ORDER BY a.id;
+
+-- name: ListUnbalancedJournals :many
+SELECT e.journal_id, a.currency, SUM(e.amount)::bigint AS total
+FROM entries AS e
+JOIN accounts AS a ON a.id = e.account_id
+WHERE sqlc.narg(account_id)::bigint IS NULL
+   OR e.journal_id IN (SELECT journal_id FROM entries WHERE account_id = sqlc.narg(account_id))
+GROUP BY e.journal_id, a.currency
+HAVING SUM(e.amount) <> 0
+ORDER BY e.journal_id;
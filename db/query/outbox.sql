@@ -0,0 +1,40 @@
+-- name: CreateOutbox :one
+INSERT INTO outbox (
+  task_type,
+  payload,
+  queue,
+  max_retry,
+  process_in_seconds
+) VALUES (
+  $1, $2, $3, $4, $5
+) RETURNING *;
+
+-- name: ClaimPendingOutbox :many
+-- the claimed tasks are leased to the relay until leased_until, so they are not published by another relay meanwhile
+UPDATE outbox
+SET next_attempt_at = sqlc.arg(leased_until)
+WHERE id IN (
+  SELECT id FROM outbox
+  WHERE sent_at IS NULL
+    AND next_attempt_at <= now()
+  ORDER BY id
+  LIMIT sqlc.arg(limit_count)
+  FOR UPDATE SKIP LOCKED
+)
+RETURNING *;
+
+-- name: MarkOutboxSent :exec
+UPDATE outbox
+SET
+  attempts = attempts + 1,
+  last_error = NULL,
+  sent_at = now()
+WHERE id = $1;
+
+-- name: MarkOutboxFailed :exec
+UPDATE outbox
+SET
+  attempts = attempts + 1,
+  last_error = @last_error,
+  next_attempt_at = @next_attempt_at
+WHERE id = @id;
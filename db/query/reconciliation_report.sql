@@ -0,0 +1,14 @@
+-- name: CreateReconciliationReport :one
+INSERT INTO reconciliation_reports (
+  account_id,
+  balance,
+  entries_total
+) VALUES (
+  $1, $2, $3
+) RETURNING *;
+
+-- name: ListReconciliationReports :many
+SELECT * FROM reconciliation_reports
+WHERE id < sqlc.arg(before_id)
+ORDER BY id DESC
+LIMIT sqlc.arg(limit_count);
@@ -2,15 +2,28 @@
 INSERT INTO transfers (
   from_account_id,
   to_account_id,
-  amount
+  amount,
+  to_amount,
+  exchange_rate,
+  journal_id,
+  reversal_of
 ) VALUES (
-  $1, $2, $3
+  $1, $2, $3, $4, $5, $6, $7
 ) RETURNING *;
 
 -- name: GetTransfer :one
 SELECT * FROM transfers
 WHERE id = $1 LIMIT 1;
 
+-- name: GetTransferForUpdate :one
+SELECT * FROM transfers
+WHERE id = $1 LIMIT 1
+FOR NO KEY UPDATE;
+
+-- name: GetReversedAmount :one
+SELECT COALESCE(SUM(to_amount), 0)::bigint AS reversed_amount FROM transfers
+WHERE reversal_of = $1;
+
 -- name: ListTransfers :many
 SELECT * FROM transfers
 ORDER BY id
@@ -19,36 +32,38 @@ OFFSET $2;
 
 -- name: ListTransfersFrom :many
 SELECT * FROM transfers
-WHERE from_account_id = $1
-ORDER BY id
-LIMIT $2
-OFFSET $3;
+WHERE from_account_id = sqlc.arg(from_account_id)
+  AND (created_at, id) > (sqlc.arg(after_created_at)::timestamptz, sqlc.arg(after_id)::bigint)
+ORDER BY created_at, id
+LIMIT sqlc.arg(limit_count);
 
 -- name: ListTransfersTo :many
 SELECT * FROM transfers
-WHERE to_account_id = $1
-ORDER BY id
-LIMIT $2
-OFFSET $3;
-
--- name: UpdateTransferFrom :one
-UPDATE transfers
-SET from_account_id = $2
-WHERE id = $1
-RETURNING *;
-
--- name: UpdateTransferTo :one
-UPDATE transfers
-SET to_account_id = $2
-WHERE id = $1
-RETURNING *;
-
--- name: UpdateTransferAmount :one
-UPDATE transfers
-SET amount = $2
-WHERE id = $1
-RETURNING *;
+WHERE to_account_id = sqlc.arg(to_account_id)
+  AND (created_at, id) > (sqlc.arg(after_created_at)::timestamptz, sqlc.arg(after_id)::bigint)
+ORDER BY created_at, id
+LIMIT sqlc.arg(limit_count);
 
--- name: DeleteTransfer :exec
-DELETE FROM transfers
-WHERE id = $1;
+-- name: ListTransfersOfOwner :many
+-- the filters apply to the side of the owner. a transfer between the own accounts matches either side
+SELECT t.*,
+  fa.owner AS from_owner,
+  fa.currency AS from_currency,
+  ta.owner AS to_owner,
+  ta.currency AS to_currency
+FROM transfers AS t
+JOIN accounts AS fa ON fa.id = t.from_account_id
+JOIN accounts AS ta ON ta.id = t.to_account_id
+WHERE (
+    (sqlc.arg(outgoing)::boolean AND fa.owner = sqlc.arg(owner)
+      AND (sqlc.arg(currency)::varchar = '' OR fa.currency = sqlc.arg(currency))
+      AND t.amount BETWEEN sqlc.arg(min_amount) AND sqlc.arg(max_amount))
+    OR (sqlc.arg(incoming)::boolean AND ta.owner = sqlc.arg(owner)
+      AND (sqlc.arg(currency)::varchar = '' OR ta.currency = sqlc.arg(currency))
+      AND t.to_amount BETWEEN sqlc.arg(min_amount) AND sqlc.arg(max_amount))
+  )
+  AND t.created_at >= sqlc.arg(start_time)
+  AND t.created_at < sqlc.arg(end_time)
+  AND (t.created_at, t.id) < (sqlc.arg(before_created_at)::timestamptz, sqlc.arg(before_id)::bigint)
+ORDER BY t.created_at DESC, t.id DESC
+LIMIT sqlc.arg(limit_count);
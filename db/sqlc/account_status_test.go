@@ -0,0 +1,188 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tgfukuda/be-master/util"
+)
+
+func TestUpdateAccountStatusTx(t *testing.T) {
+	store := NewStore(testDB)
+
+	account := createRandAccountWithCurrency(t, util.RandomCurrency(), 1)
+	banker := createRandUser(t)
+
+	// frozen and unfrozen by a banker
+	result, err := store.UpdateAccountStatusTx(context.Background(), UpdateAccountStatusTxParams{
+		AccountID:  account.ID,
+		FromStatus: AccountActive,
+		Status:     AccountFrozen,
+		Reason:     util.RandomString(20),
+		Actor:      banker.Username,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, AccountFrozen, result.Account.Status)
+	assert.Equal(t, AccountActive, result.Event.FromStatus)
+	assert.Equal(t, AccountFrozen, result.Event.ToStatus)
+	assert.Equal(t, banker.Username, result.Event.Actor)
+
+	// the owner can't close it while frozen
+	_, err = store.UpdateAccountStatusTx(context.Background(), UpdateAccountStatusTxParams{
+		AccountID:  account.ID,
+		FromStatus: AccountActive,
+		Status:     AccountClosed,
+		Actor:      account.Owner,
+	})
+	assert.ErrorIs(t, err, ErrInvalidAccountStatusTransition)
+
+	result, err = store.UpdateAccountStatusTx(context.Background(), UpdateAccountStatusTxParams{
+		AccountID:  account.ID,
+		FromStatus: AccountFrozen,
+		Status:     AccountActive,
+		Reason:     util.RandomString(20),
+		Actor:      banker.Username,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, AccountActive, result.Account.Status)
+
+	// only a zero balance account can be closed
+	_, err = store.UpdateAccountStatusTx(context.Background(), UpdateAccountStatusTxParams{
+		AccountID:  account.ID,
+		FromStatus: AccountActive,
+		Status:     AccountClosed,
+		Actor:      account.Owner,
+	})
+	assert.ErrorIs(t, err, ErrAccountBalanceNotZero)
+
+	_, err = testQueries.AddAccountBalance(context.Background(), AddAccountBalanceParams{ID: account.ID, Amount: -1})
+	assert.NoError(t, err)
+
+	result, err = store.UpdateAccountStatusTx(context.Background(), UpdateAccountStatusTxParams{
+		AccountID:  account.ID,
+		FromStatus: AccountActive,
+		Status:     AccountClosed,
+		Actor:      account.Owner,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, AccountClosed, result.Account.Status)
+
+	// every change is audited in order, the failed ones are not
+	events, err := testQueries.ListAccountStatusEvents(context.Background(), ListAccountStatusEventsParams{
+		AccountID: account.ID,
+		Limit:     10,
+	})
+	assert.NoError(t, err)
+	require.Len(t, events, 3)
+	assert.Equal(t, []string{AccountFrozen, AccountActive, AccountClosed},
+		[]string{events[0].ToStatus, events[1].ToStatus, events[2].ToStatus})
+}
+
+func TestUpdateAccountStatusTxFromStatus(t *testing.T) {
+	store := NewStore(testDB)
+
+	account := createRandAccount(t)
+	banker := createRandUser(t)
+
+	_, err := store.UpdateAccountStatusTx(context.Background(), UpdateAccountStatusTxParams{
+		AccountID:  account.ID,
+		FromStatus: AccountActive,
+		Status:     AccountFrozen,
+		Reason:     util.RandomString(20),
+		Actor:      banker.Username,
+	})
+	require.NoError(t, err)
+
+	// the owner can't undo the freeze by reopening
+	_, err = store.UpdateAccountStatusTx(context.Background(), UpdateAccountStatusTxParams{
+		AccountID:  account.ID,
+		FromStatus: AccountClosed,
+		Status:     AccountActive,
+		Actor:      account.Owner,
+	})
+	assert.ErrorIs(t, err, ErrInvalidAccountStatusTransition)
+
+	closed := createRandAccountWithCurrency(t, util.RandomCurrency(), 0)
+
+	_, err = store.UpdateAccountStatusTx(context.Background(), UpdateAccountStatusTxParams{
+		AccountID:  closed.ID,
+		FromStatus: AccountActive,
+		Status:     AccountClosed,
+		Actor:      closed.Owner,
+	})
+	require.NoError(t, err)
+
+	// the banker can't reopen a closed account by unfreezing
+	_, err = store.UpdateAccountStatusTx(context.Background(), UpdateAccountStatusTxParams{
+		AccountID:  closed.ID,
+		FromStatus: AccountFrozen,
+		Status:     AccountActive,
+		Reason:     util.RandomString(20),
+		Actor:      banker.Username,
+	})
+	assert.ErrorIs(t, err, ErrInvalidAccountStatusTransition)
+
+	// both are still as they were
+	frozen, err := testQueries.GetAccount(context.Background(), account.ID)
+	require.NoError(t, err)
+	assert.Equal(t, AccountFrozen, frozen.Status)
+
+	closed, err = testQueries.GetAccount(context.Background(), closed.ID)
+	require.NoError(t, err)
+	assert.Equal(t, AccountClosed, closed.Status)
+}
+
+func TestTransferTxInactiveAccount(t *testing.T) {
+	store := NewStore(testDB)
+
+	amount := int64(10)
+	account1 := createRandAccountWithBalance(t, amount)
+	account2 := createRandAccount(t)
+
+	_, err := store.UpdateAccountStatusTx(context.Background(), UpdateAccountStatusTxParams{
+		AccountID:  account2.ID,
+		FromStatus: AccountActive,
+		Status:     AccountFrozen,
+		Actor:      account2.Owner,
+	})
+	assert.NoError(t, err)
+
+	// frozen accounts neither send nor receive
+	_, err = store.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        amount,
+	})
+	assert.ErrorIs(t, err, ErrAccountFrozen)
+
+	_, err = store.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account2.ID,
+		ToAccountID:   account1.ID,
+		Amount:        amount,
+	})
+	assert.ErrorIs(t, err, ErrAccountFrozen)
+
+	account3 := createRandAccountWithCurrency(t, util.RandomCurrency(), 0)
+
+	_, err = store.UpdateAccountStatusTx(context.Background(), UpdateAccountStatusTxParams{
+		AccountID:  account3.ID,
+		FromStatus: AccountActive,
+		Status:     AccountClosed,
+		Actor:      account3.Owner,
+	})
+	assert.NoError(t, err)
+
+	_, err = store.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account3.ID,
+		Amount:        amount,
+	})
+	assert.ErrorIs(t, err, ErrAccountClosed)
+
+	// the failed transfers must not change any balance
+	updatedAccount1, err := store.GetAccount(context.Background(), account1.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, account1.Balance, updatedAccount1.Balance)
+}
@@ -2,7 +2,6 @@ package db
 
 import (
 	"context"
-	"database/sql"
 	"testing"
 	"time"
 
@@ -25,6 +24,7 @@ func createRandAccount(t *testing.T) Account {
 	assert.Equal(t, arg.Owner, account.Owner)
 	assert.Equal(t, arg.Balance, account.Balance)
 	assert.Equal(t, arg.Currency, account.Currency)
+	assert.Equal(t, AccountActive, account.Status)
 
 	assert.NotZero(t, account.ID)
 	assert.NotZero(t, account.CreatedAt)
@@ -49,54 +49,53 @@ func TestGetAccount(t *testing.T) {
 	assert.WithinDuration(t, account1.CreatedAt, account2.CreatedAt, time.Millisecond)
 }
 
-func TestUpdateAccount(t *testing.T) {
+func TestAddAccountBalance(t *testing.T) {
 	account1 := createRandAccount(t)
 
-	arg := UpdateAccountParams{
-		ID:      account1.ID,
-		Balance: util.RandomBalance(),
+	arg := AddAccountBalanceParams{
+		ID:     account1.ID,
+		Amount: util.RandomBalance(),
 	}
 
-	account2, err := testQueries.UpdateAccount(context.Background(), arg)
+	account2, err := testQueries.AddAccountBalance(context.Background(), arg)
 	assert.NoError(t, err)
 	assert.NotEmpty(t, account2)
 
 	assert.Equal(t, account1.ID, account2.ID)
 	assert.Equal(t, account1.Owner, account2.Owner)
-	assert.Equal(t, arg.Balance, account2.Balance)
+	assert.Equal(t, account1.Balance+arg.Amount, account2.Balance)
 	assert.Equal(t, account1.Currency, account2.Currency)
 	assert.WithinDuration(t, account1.CreatedAt, account2.CreatedAt, time.Millisecond)
 }
 
-func TestDeleteAccount(t *testing.T) {
-	account1 := createRandAccount(t)
-
-	err := testQueries.DeleteAccount(context.Background(), account1.ID)
-	assert.NoError(t, err)
-
-	account2, err := testQueries.GetAccount(context.Background(), account1.ID)
-	assert.Error(t, err)
-	assert.EqualError(t, err, sql.ErrNoRows.Error())
-	assert.Empty(t, account2)
-}
-
 func TestListAccounts(t *testing.T) {
-	var lastAccount Account
-	for i := 0; i < 10; i++ {
-		lastAccount = createRandAccount(t)
+	user := createRandUser(t)
+
+	var accounts []Account
+	for _, currency := range []string{util.USD, util.EUR, util.JPY} {
+		account, err := testQueries.CreateAccount(context.Background(), CreateAccountParams{
+			Owner:    user.Username,
+			Balance:  util.RandomBalance(),
+			Currency: currency,
+		})
+		assert.NoError(t, err)
+		accounts = append(accounts, account)
 	}
 
 	arg := ListAccountsParams{
-		Owner:  lastAccount.Owner,
-		Limit:  5,
-		Offset: 0,
+		Owner:      user.Username,
+		LimitCount: 2,
 	}
 
-	accounts2, err := testQueries.ListAccounts(context.Background(), arg)
+	page1, err := testQueries.ListAccounts(context.Background(), arg)
 	assert.NoError(t, err)
-	assert.NotEmpty(t, accounts2)
+	assert.Equal(t, accounts[:2], page1)
 
-	for _, account := range accounts2 {
-		assert.NotEmpty(t, account)
-	}
+	// the next page starts after the last one of the previous page
+	arg.AfterCreatedAt = page1[1].CreatedAt
+	arg.AfterID = page1[1].ID
+
+	page2, err := testQueries.ListAccounts(context.Background(), arg)
+	assert.NoError(t, err)
+	assert.Equal(t, accounts[2:], page2)
 }
@@ -0,0 +1,127 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tgfukuda/be-master/fx"
+	"github.com/tgfukuda/be-master/util"
+)
+
+// createFundedAccount creates an account whose balance comes only from the entries
+func createFundedAccount(t *testing.T, currency string, overdraftLimit int64) Account {
+	account := createRandAccountWithCurrency(t, currency, 0)
+
+	account, err := testQueries.UpdateAccountOverdraftLimit(context.Background(), UpdateAccountOverdraftLimitParams{
+		ID:             account.ID,
+		OverdraftLimit: overdraftLimit,
+	})
+	assert.NoError(t, err)
+
+	return account
+}
+
+func TestCheckLedgerTx(t *testing.T) {
+	store := NewStore(testDB)
+
+	rateFile := filepath.Join(t.TempDir(), "rates.json")
+	err := os.WriteFile(rateFile, []byte(`{"USD/JPY": "149.25"}`), 0o600)
+	assert.NoError(t, err)
+
+	provider, err := fx.NewFileExchangeRateProvider(rateFile)
+	assert.NoError(t, err)
+
+	account1 := createFundedAccount(t, util.USD, 1000)
+	account2 := createFundedAccount(t, util.USD, 0)
+	account3 := createFundedAccount(t, util.JPY, 0)
+
+	_, err = store.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        100,
+	})
+	assert.NoError(t, err)
+
+	result, err := store.CrossCurrencyTransferTx(context.Background(), CrossCurrencyTransferTxParams{
+		TransferTxParams: TransferTxParams{
+			FromAccountID: account1.ID,
+			ToAccountID:   account3.ID,
+			Amount:        3,
+		},
+		ExchangeRateProvider: provider,
+	})
+	assert.NoError(t, err)
+
+	// the exchange accounts of the bank take the other side of the exchange
+	entries, err := store.ListJournalEntries(context.Background(), result.Transfer.JournalID)
+	assert.NoError(t, err)
+	assert.Len(t, entries, 4)
+
+	totals := map[string]int64{}
+	for _, entry := range entries {
+		account, err := store.GetAccount(context.Background(), entry.AccountID)
+		assert.NoError(t, err)
+		totals[account.Currency] += entry.Amount
+	}
+	assert.Equal(t, map[string]int64{util.USD: 0, util.JPY: 0}, totals)
+
+	for _, account := range []Account{account1, account2, account3} {
+		check, err := store.CheckLedgerTx(context.Background(), CheckLedgerTxParams{AccountID: account.ID})
+		assert.NoError(t, err)
+		assert.True(t, check.Balanced(), "account %d: %+v", account.ID, check)
+	}
+
+	// the balance set without entries is a drift
+	account4 := createRandAccountWithCurrency(t, util.EUR, 100)
+	check, err := store.CheckLedgerTx(context.Background(), CheckLedgerTxParams{AccountID: account4.ID})
+	assert.NoError(t, err)
+	assert.False(t, check.Balanced())
+	assert.Equal(t, []ListBalanceMismatchesRow{{AccountID: account4.ID, Balance: 100, EntriesTotal: 0}}, check.BalanceMismatches)
+	assert.Empty(t, check.UnbalancedJournals)
+
+	// the whole ledger
+	_, err = store.CheckLedgerTx(context.Background(), CheckLedgerTxParams{})
+	assert.NoError(t, err)
+}
+
+func TestEntriesImmutable(t *testing.T) {
+	store := NewStore(testDB)
+
+	account1 := createFundedAccount(t, util.USD, 100)
+	account2 := createFundedAccount(t, util.USD, 0)
+
+	result, err := store.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        10,
+	})
+	assert.NoError(t, err)
+
+	_, err = testDB.ExecContext(context.Background(), "UPDATE entries SET amount = 0 WHERE id = $1", result.FromEntry.ID)
+	assert.Error(t, err)
+
+	_, err = testDB.ExecContext(context.Background(), "DELETE FROM entries WHERE id = $1", result.ToEntry.ID)
+	assert.Error(t, err)
+
+	entry, err := store.GetEntry(context.Background(), result.FromEntry.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(-10), entry.Amount)
+}
+
+func TestCreateJournal(t *testing.T) {
+	journal, err := testQueries.CreateJournal(context.Background())
+	assert.NoError(t, err)
+	assert.NotZero(t, journal.ID)
+	assert.NotZero(t, journal.CreatedAt)
+
+	got, err := testQueries.GetJournal(context.Background(), journal.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, journal.ID, got.ID)
+
+	_, err = testQueries.GetJournal(context.Background(), journal.ID+1000000)
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+}
@@ -0,0 +1,175 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tgfukuda/be-master/util"
+)
+
+func createRandOutbox(t *testing.T) Outbox {
+	arg := CreateOutboxParams{
+		TaskType: "task:" + util.RandomString(6),
+		Payload:  []byte(`{"key":"value"}`),
+		Queue:    "default",
+		MaxRetry: 10,
+	}
+
+	task, err := testQueries.CreateOutbox(context.Background(), arg)
+	assert.NoError(t, err)
+
+	assert.NotZero(t, task.ID)
+	assert.Equal(t, arg.TaskType, task.TaskType)
+	assert.JSONEq(t, string(arg.Payload), string(task.Payload))
+	assert.Equal(t, arg.Queue, task.Queue)
+	assert.Equal(t, arg.MaxRetry, task.MaxRetry)
+	assert.Zero(t, task.Attempts)
+	assert.False(t, task.SentAt.Valid)
+
+	return task
+}
+
+func TestCreateUserTxWritesOutbox(t *testing.T) {
+	store := NewStore(testDB)
+
+	hp, err := util.HashPassword(util.RandomString(6))
+	assert.NoError(t, err)
+
+	var taskType string
+	result, err := store.CreateUserTx(context.Background(), CreateUserTxParams{
+		CreateUserParams: CreateUserParams{
+			Username:       util.RandomOwner(),
+			HashedPassword: hp,
+			FullName:       util.RandomOwner(),
+			Email:          util.RandomEmail(),
+		},
+		AfterCreate: func(user User) ([]CreateOutboxParams, error) {
+			taskType = "task:" + user.Username
+			return []CreateOutboxParams{{TaskType: taskType, Payload: []byte(`{}`), Queue: "default"}}, nil
+		},
+	})
+	assert.NoError(t, err)
+	assert.NotEmpty(t, result.User)
+
+	// nothing is committed when the tasks can't be created
+	username := util.RandomOwner()
+	_, err = store.CreateUserTx(context.Background(), CreateUserTxParams{
+		CreateUserParams: CreateUserParams{
+			Username:       username,
+			HashedPassword: hp,
+			FullName:       util.RandomOwner(),
+			Email:          util.RandomEmail(),
+		},
+		AfterCreate: func(user User) ([]CreateOutboxParams, error) {
+			return nil, errors.New("failed")
+		},
+	})
+	assert.Error(t, err)
+
+	_, err = testQueries.GetUser(context.Background(), username)
+	assert.Error(t, err)
+
+	relayed := false
+	_, err = store.RelayOutbox(context.Background(), RelayOutboxParams{
+		Limit: 1000,
+		Lease: time.Minute,
+		Publish: func(task Outbox) error {
+			relayed = relayed || task.TaskType == taskType
+			return nil
+		},
+		Backoff: func(attempts int32) time.Duration { return time.Minute },
+	})
+	assert.NoError(t, err)
+	assert.True(t, relayed)
+}
+
+func TestRelayOutbox(t *testing.T) {
+	store := NewStore(testDB)
+
+	sent := createRandOutbox(t)
+	failed := createRandOutbox(t)
+
+	result, err := store.RelayOutbox(context.Background(), RelayOutboxParams{
+		Limit: 1000,
+		Lease: time.Minute,
+		Publish: func(task Outbox) error {
+			if task.ID == failed.ID {
+				return errors.New("failed to publish")
+			}
+			return nil
+		},
+		Backoff: func(attempts int32) time.Duration { return time.Minute },
+	})
+	assert.NoError(t, err)
+
+	ids := func(tasks []Outbox) []int64 {
+		var ids []int64
+		for _, task := range tasks {
+			ids = append(ids, task.ID)
+		}
+		return ids
+	}
+	assert.Contains(t, ids(result.Sent), sent.ID)
+	assert.Contains(t, ids(result.Failed), failed.ID)
+
+	// neither the sent one nor the one waiting for the backoff is relayed again
+	result, err = store.RelayOutbox(context.Background(), RelayOutboxParams{
+		Limit: 1000,
+		Lease: time.Minute,
+		Publish: func(task Outbox) error {
+			return nil
+		},
+		Backoff: func(attempts int32) time.Duration { return time.Minute },
+	})
+	assert.NoError(t, err)
+	assert.NotContains(t, ids(result.Sent), sent.ID)
+	assert.NotContains(t, ids(result.Sent), failed.ID)
+}
+
+func TestRelayOutboxLease(t *testing.T) {
+	store := NewStore(testDB)
+	relay := func(publish func(task Outbox) error) RelayOutboxResult {
+		result, err := store.RelayOutbox(context.Background(), RelayOutboxParams{
+			Limit:   1000,
+			Lease:   time.Minute,
+			Publish: publish,
+			Backoff: func(attempts int32) time.Duration { return time.Minute },
+		})
+		assert.NoError(t, err)
+		return result
+	}
+	relayed := func(result RelayOutboxResult, task Outbox) bool {
+		for _, sent := range result.Sent {
+			if sent.ID == task.ID {
+				return true
+			}
+		}
+		return false
+	}
+
+	// the task claimed by a relay isn't published by another one while it's publishing
+	leased := createRandOutbox(t)
+	result := relay(func(task Outbox) error {
+		if task.ID == leased.ID {
+			other := relay(func(task Outbox) error { return nil })
+			assert.False(t, relayed(other, leased))
+		}
+		return nil
+	})
+	assert.True(t, relayed(result, leased))
+
+	// the task left by a crashed relay is claimed again after the lease
+	crashed := createRandOutbox(t)
+	tasks, err := store.ClaimPendingOutbox(context.Background(), ClaimPendingOutboxParams{
+		LeasedUntil: time.Now().Add(-time.Second),
+		LimitCount:  1000,
+	})
+	assert.NoError(t, err)
+	assert.NotEmpty(t, tasks)
+
+	result = relay(func(task Outbox) error { return nil })
+	assert.True(t, relayed(result, crashed))
+}
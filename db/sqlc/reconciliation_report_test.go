@@ -0,0 +1,60 @@
+package db
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tgfukuda/be-master/util"
+)
+
+func TestReconcileAccountTx(t *testing.T) {
+	store := NewStore(testDB)
+
+	account1 := createFundedAccount(t, util.USD, 100)
+	account2 := createFundedAccount(t, util.USD, 0)
+
+	_, err := store.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        10,
+	})
+	assert.NoError(t, err)
+
+	result, err := store.ReconcileAccountTx(context.Background(), ReconcileAccountTxParams{AccountID: account1.ID})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(-10), result.EntriesTotal)
+	assert.Equal(t, result.Account.Balance, result.EntriesTotal)
+	assert.Nil(t, result.Report)
+
+	// the balance set without entries
+	account3 := createRandAccountWithCurrency(t, util.EUR, 100)
+
+	result, err = store.ReconcileAccountTx(context.Background(), ReconcileAccountTxParams{AccountID: account3.ID})
+	assert.NoError(t, err)
+	assert.NotNil(t, result.Report)
+	assert.Equal(t, account3.ID, result.Report.AccountID)
+	assert.Equal(t, int64(100), result.Report.Balance)
+	assert.Zero(t, result.Report.EntriesTotal)
+
+	// the newest first
+	reports, err := store.ListReconciliationReports(context.Background(), ListReconciliationReportsParams{BeforeID: math.MaxInt64, LimitCount: 5})
+	assert.NoError(t, err)
+	assert.NotEmpty(t, reports)
+	assert.GreaterOrEqual(t, reports[0].ID, result.Report.ID)
+}
+
+func TestListAccountIDs(t *testing.T) {
+	account1 := createRandAccount(t)
+	account2 := createRandAccount(t)
+
+	ids, err := testQueries.ListAccountIDs(context.Background(), ListAccountIDsParams{
+		AfterID:    account1.ID - 1,
+		LimitCount: 2,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, ids, 2)
+	assert.Equal(t, account1.ID, ids[0])
+	assert.LessOrEqual(t, ids[1], account2.ID)
+}
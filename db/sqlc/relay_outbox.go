@@ -0,0 +1,58 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+type RelayOutboxParams struct {
+	Limit   int32
+	Lease   time.Duration                      // the claimed tasks are published by this relay until it passes
+	Publish func(task Outbox) error            // enqueues the task
+	Backoff func(attempts int32) time.Duration // delay of the next attempt after the failures so far
+}
+
+type RelayOutboxResult struct {
+	Sent   []Outbox
+	Failed []Outbox
+}
+
+// RelayOutbox claims the pending tasks, publishes them and marks them sent.
+// no row is locked and no transaction is open while publishing, so a slow task queue doesn't hold the database.
+// the tasks of a relay crashed before marking them are claimed again after the lease, so a task is published at least once.
+// a failed task stays pending until the backoff passes.
+func (store *SQLStore) RelayOutbox(ctx context.Context, arg RelayOutboxParams) (RelayOutboxResult, error) {
+	var result RelayOutboxResult
+
+	tasks, err := store.ClaimPendingOutbox(ctx, ClaimPendingOutboxParams{
+		LeasedUntil: time.Now().Add(arg.Lease),
+		LimitCount:  arg.Limit,
+	})
+	if err != nil {
+		return result, err
+	}
+
+	for _, task := range tasks {
+		if err := arg.Publish(task); err != nil {
+			err = store.MarkOutboxFailed(ctx, MarkOutboxFailedParams{
+				ID:            task.ID,
+				LastError:     sql.NullString{String: err.Error(), Valid: true},
+				NextAttemptAt: time.Now().Add(arg.Backoff(task.Attempts + 1)),
+			})
+			if err != nil {
+				return result, err
+			}
+
+			result.Failed = append(result.Failed, task)
+			continue
+		}
+
+		if err := store.MarkOutboxSent(ctx, task.ID); err != nil {
+			return result, err
+		}
+		result.Sent = append(result.Sent, task)
+	}
+
+	return result, nil
+}
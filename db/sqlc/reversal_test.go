@@ -0,0 +1,202 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tgfukuda/be-master/fx"
+	"github.com/tgfukuda/be-master/util"
+)
+
+func TestReverseTransferTx(t *testing.T) {
+	store := NewStore(testDB)
+
+	// refund a transfer concurrently in n parts
+	n := 5
+	amount := int64(10)
+
+	account1 := createRandAccountWithBalance(t, int64(n)*amount)
+	account2 := createRandAccount(t)
+
+	original, err := store.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        int64(n) * amount,
+	})
+	assert.NoError(t, err)
+
+	errs := make(chan error)
+	txResults := make(chan ReverseTransferTxResult)
+
+	for i := 0; i < n; i++ {
+		go func() {
+			result, err := store.ReverseTransferTx(context.Background(), ReverseTransferTxParams{
+				TransferID: original.Transfer.ID,
+				Amount:     amount,
+			})
+
+			errs <- err
+			txResults <- result
+		}()
+	}
+
+	// the number of refunds done
+	exited := make(map[int]bool)
+
+	for i := 0; i < n; i++ {
+		err := <-errs
+		result := <-txResults
+
+		assert.NoError(t, err)
+
+		// the money goes back from the receiver to the sender
+		reversal := result.Transfer
+		assert.Equal(t, account2.ID, reversal.FromAccountID)
+		assert.Equal(t, account1.ID, reversal.ToAccountID)
+		assert.Equal(t, amount, reversal.Amount)
+		assert.Equal(t, amount, reversal.ToAmount)
+		assert.True(t, reversal.ReversalOf.Valid)
+		assert.Equal(t, original.Transfer.ID, reversal.ReversalOf.Int64)
+		assert.Equal(t, original.Transfer.ID, result.Original.ID)
+
+		assert.Equal(t, -amount, result.FromEntry.Amount)
+		assert.Equal(t, amount, result.ToEntry.Amount)
+		assert.Equal(t, reversal.JournalID, result.FromEntry.JournalID)
+		assert.Equal(t, reversal.JournalID, result.ToEntry.JournalID)
+		assert.NotEqual(t, original.Transfer.JournalID, reversal.JournalID)
+
+		k := int(result.ReversedAmount / amount)
+		assert.True(t, 1 <= k && k <= n)
+		assert.NotContains(t, exited, k)
+		exited[k] = true
+	}
+
+	// the balances are back to the ones before the transfer
+	updatedAccount1, err := store.GetAccount(context.Background(), account1.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, account1.Balance, updatedAccount1.Balance)
+
+	updatedAccount2, err := store.GetAccount(context.Background(), account2.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, account2.Balance, updatedAccount2.Balance)
+
+	_, err = store.ReverseTransferTx(context.Background(), ReverseTransferTxParams{TransferID: original.Transfer.ID})
+	assert.ErrorIs(t, err, ErrTransferAlreadyReversed)
+
+	ledger, err := store.CheckLedgerTx(context.Background(), CheckLedgerTxParams{AccountID: account2.ID})
+	assert.NoError(t, err)
+	assert.True(t, ledger.Balanced())
+}
+
+func TestReverseTransferTxDoubleReversal(t *testing.T) {
+	store := NewStore(testDB)
+
+	// only one of the concurrent full refunds goes through
+	n := 5
+	amount := int64(10)
+
+	account1 := createRandAccountWithBalance(t, amount)
+	account2 := createRandAccount(t)
+
+	original, err := store.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        amount,
+	})
+	assert.NoError(t, err)
+
+	errs := make(chan error)
+
+	for i := 0; i < n; i++ {
+		go func() {
+			_, err := store.ReverseTransferTx(context.Background(), ReverseTransferTxParams{
+				TransferID: original.Transfer.ID,
+			})
+
+			errs <- err
+		}()
+	}
+
+	succeeded := 0
+	for i := 0; i < n; i++ {
+		err := <-errs
+		if err == nil {
+			succeeded++
+			continue
+		}
+		assert.ErrorIs(t, err, ErrTransferAlreadyReversed)
+	}
+	assert.Equal(t, 1, succeeded)
+
+	reversed, err := store.GetReversedAmount(context.Background(), sql.NullInt64{Int64: original.Transfer.ID, Valid: true})
+	assert.NoError(t, err)
+	assert.Equal(t, amount, reversed)
+
+	updatedAccount1, err := store.GetAccount(context.Background(), account1.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, account1.Balance, updatedAccount1.Balance)
+}
+
+func TestReverseTransferTxPartial(t *testing.T) {
+	store := NewStore(testDB)
+
+	rateFile := filepath.Join(t.TempDir(), "rates.json")
+	err := os.WriteFile(rateFile, []byte(`{"USD/JPY": "149.25"}`), 0o600)
+	assert.NoError(t, err)
+
+	provider, err := fx.NewFileExchangeRateProvider(rateFile)
+	assert.NoError(t, err)
+
+	account1 := createRandAccountWithCurrency(t, util.USD, 1000)
+	account2 := createRandAccountWithCurrency(t, util.JPY, 1000)
+
+	// 3 USD is 447 JPY
+	original, err := store.CrossCurrencyTransferTx(context.Background(), CrossCurrencyTransferTxParams{
+		TransferTxParams: TransferTxParams{
+			FromAccountID: account1.ID,
+			ToAccountID:   account2.ID,
+			Amount:        3,
+		},
+		ExchangeRateProvider: provider,
+	})
+	assert.NoError(t, err)
+
+	_, err = store.ReverseTransferTx(context.Background(), ReverseTransferTxParams{
+		TransferID: original.Transfer.ID,
+		Amount:     4,
+	})
+	assert.ErrorIs(t, err, ErrReversalExceedsTransfer)
+
+	// 1 USD takes back 447 / 3 = 149 JPY
+	result, err := store.ReverseTransferTx(context.Background(), ReverseTransferTxParams{
+		TransferID: original.Transfer.ID,
+		Amount:     1,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(149), result.Transfer.Amount)
+	assert.Equal(t, int64(1), result.Transfer.ToAmount)
+	assert.Equal(t, int64(1), result.ReversedAmount)
+	// the rate of this leg, 1 / 149 rounded up, gives back the refund
+	assert.Equal(t, "0.0067114095", result.Transfer.ExchangeRate)
+
+	_, err = store.ReverseTransferTx(context.Background(), ReverseTransferTxParams{TransferID: result.Transfer.ID})
+	assert.ErrorIs(t, err, ErrReversalNotReversible)
+
+	// the rest settles what is left, so the full refund moves exactly the original amounts back
+	result, err = store.ReverseTransferTx(context.Background(), ReverseTransferTxParams{TransferID: original.Transfer.ID})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(298), result.Transfer.Amount)
+	assert.Equal(t, int64(2), result.Transfer.ToAmount)
+	assert.Equal(t, int64(3), result.ReversedAmount)
+
+	assert.Equal(t, account1.Balance, result.ToAccount.Balance)
+	assert.Equal(t, account2.Balance, result.FromAccount.Balance)
+
+	ledger, err := store.CheckLedgerTx(context.Background(), CheckLedgerTxParams{AccountID: account2.ID})
+	assert.NoError(t, err)
+	assert.True(t, ledger.Balanced())
+}
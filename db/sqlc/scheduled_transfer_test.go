@@ -0,0 +1,114 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func createRandScheduledTransfer(t *testing.T, nextRunAt time.Time) ScheduledTransfer {
+	from := createRandAccount(t)
+	to := createRandAccount(t)
+
+	arg := CreateScheduledTransferParams{
+		Owner:         from.Owner,
+		FromAccountID: from.ID,
+		ToAccountID:   to.ID,
+		Amount:        10,
+		Currency:      from.Currency,
+		Schedule:      "@every 24h",
+		NextRunAt:     nextRunAt,
+	}
+
+	scheduled, err := testQueries.CreateScheduledTransfer(context.Background(), arg)
+	assert.NoError(t, err)
+
+	assert.NotZero(t, scheduled.ID)
+	assert.Equal(t, arg.Owner, scheduled.Owner)
+	assert.Equal(t, arg.Amount, scheduled.Amount)
+	assert.Equal(t, ScheduledTransferActive, scheduled.Status)
+	assert.WithinDuration(t, arg.NextRunAt, scheduled.NextRunAt, time.Second)
+
+	return scheduled
+}
+
+func TestListDueScheduledTransferIDs(t *testing.T) {
+	now := time.Now()
+	due := createRandScheduledTransfer(t, now.Add(-time.Minute))
+	notDue := createRandScheduledTransfer(t, now.Add(time.Hour))
+
+	ids, err := testQueries.ListDueScheduledTransferIDs(context.Background(), ListDueScheduledTransferIDsParams{
+		Now:        now,
+		AfterID:    due.ID - 1,
+		LimitCount: 1000,
+	})
+	assert.NoError(t, err)
+	assert.Contains(t, ids, due.ID)
+	assert.NotContains(t, ids, notDue.ID)
+
+	// paused ones aren't due
+	_, err = testQueries.UpdateScheduledTransferStatus(context.Background(), UpdateScheduledTransferStatusParams{
+		Status:       ScheduledTransferPaused,
+		NextRunAt:    due.NextRunAt,
+		ID:           due.ID,
+		FromStatuses: []string{ScheduledTransferActive},
+	})
+	assert.NoError(t, err)
+
+	ids, err = testQueries.ListDueScheduledTransferIDs(context.Background(), ListDueScheduledTransferIDsParams{
+		Now:        now,
+		AfterID:    due.ID - 1,
+		LimitCount: 1000,
+	})
+	assert.NoError(t, err)
+	assert.NotContains(t, ids, due.ID)
+
+	// only from the given statuses
+	_, err = testQueries.UpdateScheduledTransferStatus(context.Background(), UpdateScheduledTransferStatusParams{
+		Status:       ScheduledTransferPaused,
+		NextRunAt:    due.NextRunAt,
+		ID:           due.ID,
+		FromStatuses: []string{ScheduledTransferActive},
+	})
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func TestRecordScheduledTransferRunTx(t *testing.T) {
+	store := NewStore(testDB)
+
+	scheduled := createRandScheduledTransfer(t, time.Now().Add(-time.Minute))
+	nextRunAt := scheduled.NextRunAt.Add(24 * time.Hour)
+
+	arg := RecordScheduledTransferRunTxParams{
+		ScheduledTransferID: scheduled.ID,
+		ScheduledAt:         scheduled.NextRunAt,
+		Status:              ScheduledTransferRunInsufficientFunds,
+		Error:               "insufficient funds",
+		NextRunAt:           nextRunAt,
+	}
+
+	result, err := store.RecordScheduledTransferRunTx(context.Background(), arg)
+	assert.NoError(t, err)
+	assert.NotNil(t, result.Run)
+	assert.Equal(t, ScheduledTransferRunInsufficientFunds, result.Run.Status)
+	assert.False(t, result.Run.TransferID.Valid)
+	assert.WithinDuration(t, nextRunAt, result.ScheduledTransfer.NextRunAt, time.Second)
+
+	// the retry of the same run isn't recorded twice
+	result, err = store.RecordScheduledTransferRunTx(context.Background(), arg)
+	assert.NoError(t, err)
+	assert.Nil(t, result.Run)
+
+	runs, err := store.ListScheduledTransferRuns(context.Background(), ListScheduledTransferRunsParams{
+		ScheduledTransferID: scheduled.ID,
+		BeforeCreatedAt:     time.Date(9999, 12, 31, 0, 0, 0, 0, time.UTC),
+		BeforeID:            math.MaxInt64,
+		LimitCount:          10,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, runs, 1)
+}
@@ -0,0 +1,113 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/tgfukuda/be-master/util"
+)
+
+func createRandSession(t *testing.T, username string) Session {
+	id := uuid.New()
+	arg := CreateNewSessionParams{
+		ID:           id,
+		Username:     username,
+		RefreshToken: util.RandomString(32),
+		UserAgent:    util.RandomString(6),
+		ClientIp:     "127.0.0.1",
+		ExpiredAt:    time.Now().Add(time.Hour),
+		FamilyID:     id,
+	}
+
+	session, err := testQueries.CreateNewSession(context.Background(), arg)
+	assert.NoError(t, err)
+	assert.Equal(t, arg.ID, session.ID)
+	assert.Equal(t, arg.FamilyID, session.FamilyID)
+	assert.False(t, session.IsBlocked)
+	assert.False(t, session.IsRotated)
+
+	return session
+}
+
+func renewSession(store Store, old Session) (RenewSessionTxResult, error) {
+	return store.RenewSessionTx(context.Background(), RenewSessionTxParams{
+		ID:           old.ID,
+		Username:     old.Username,
+		RefreshToken: old.RefreshToken,
+		NewSession: func(old Session) (CreateNewSessionParams, error) {
+			return CreateNewSessionParams{
+				ID:           uuid.New(),
+				Username:     old.Username,
+				RefreshToken: util.RandomString(32),
+				UserAgent:    old.UserAgent,
+				ClientIp:     old.ClientIp,
+				ExpiredAt:    time.Now().Add(time.Hour),
+			}, nil
+		},
+	})
+}
+
+func TestRenewSessionTx(t *testing.T) {
+	store := NewStore(testDB)
+	user := createRandUser(t)
+	session1 := createRandSession(t, user.Username)
+
+	result, err := renewSession(store, session1)
+	assert.NoError(t, err)
+	assert.True(t, result.OldSession.IsRotated)
+	assert.Equal(t, session1.FamilyID, result.Session.FamilyID)
+	assert.NotEqual(t, session1.ID, result.Session.ID)
+	session2 := result.Session
+
+	result, err = renewSession(store, session2)
+	assert.NoError(t, err)
+	session3 := result.Session
+
+	// the retired token is presented again
+	_, err = renewSession(store, session1)
+	assert.ErrorIs(t, err, ErrRefreshTokenReused)
+
+	// every session of the family is blocked
+	for _, session := range []Session{session1, session2, session3} {
+		got, err := store.GetSession(context.Background(), session.ID)
+		assert.NoError(t, err)
+		assert.True(t, got.IsBlocked)
+	}
+
+	_, err = renewSession(store, session3)
+	assert.ErrorIs(t, err, ErrSessionBlocked)
+}
+
+func TestRenewSessionTxMismatch(t *testing.T) {
+	store := NewStore(testDB)
+	user := createRandUser(t)
+	session := createRandSession(t, user.Username)
+
+	session.RefreshToken = util.RandomString(32)
+	_, err := renewSession(store, session)
+	assert.ErrorIs(t, err, ErrSessionMismatch)
+}
+
+func TestBlockUserSessions(t *testing.T) {
+	user := createRandUser(t)
+	session1 := createRandSession(t, user.Username)
+	session2 := createRandSession(t, user.Username)
+
+	blocked, err := testQueries.BlockUserSessions(context.Background(), user.Username)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), blocked)
+
+	for _, session := range []Session{session1, session2} {
+		got, err := testQueries.GetSession(context.Background(), session.ID)
+		assert.NoError(t, err)
+		assert.True(t, got.IsBlocked)
+	}
+
+	// already blocked
+	blocked, err = testQueries.BlockUserSessions(context.Background(), user.Username)
+	assert.NoError(t, err)
+	assert.Zero(t, blocked)
+}
@@ -0,0 +1,68 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tgfukuda/be-master/util"
+)
+
+func TestGetStatementTx(t *testing.T) {
+	store := NewStore(testDB)
+
+	account1 := createFundedAccount(t, util.USD, 1000)
+	account2 := createFundedAccount(t, util.USD, 0)
+
+	var results []TransferTxResult
+	for _, amount := range []int64{10, 20, 30} {
+		result, err := store.TransferTx(context.Background(), TransferTxParams{
+			FromAccountID: account1.ID,
+			ToAccountID:   account2.ID,
+			Amount:        amount,
+		})
+		assert.NoError(t, err)
+		results = append(results, result)
+	}
+
+	// the range is taken from the entries, so the clock of the test doesn't matter
+	arg := GetStatementTxParams{
+		AccountID: account1.ID,
+		StartTime: results[1].FromEntry.CreatedAt,
+		EndTime:   results[2].FromEntry.CreatedAt.Add(time.Microsecond),
+	}
+
+	statement, err := store.GetStatementTx(context.Background(), arg)
+	assert.NoError(t, err)
+	assert.Equal(t, account1.ID, statement.Account.ID)
+	assert.Equal(t, int64(-10), statement.OpeningBalance)
+	assert.Equal(t, int64(-60), statement.ClosingBalance)
+
+	assert.Len(t, statement.Lines, 2)
+	for i, line := range statement.Lines {
+		result := results[i+1]
+		assert.Equal(t, result.FromEntry.ID, line.ID)
+		assert.Equal(t, result.FromEntry.Amount, line.Amount)
+		assert.Equal(t, result.Transfer.ID, line.TransferID.Int64)
+		assert.Equal(t, account2.ID, line.CounterpartyAccountID.Int64)
+		assert.Equal(t, account2.Owner, line.CounterpartyOwner.String)
+	}
+	assert.Equal(t, int64(-30), statement.Lines[0].Balance)
+	assert.Equal(t, int64(-60), statement.Lines[1].Balance)
+
+	// the receiver sees the sender as the counterparty
+	statement, err = store.GetStatementTx(context.Background(), GetStatementTxParams{
+		AccountID: account2.ID,
+		StartTime: arg.StartTime,
+		EndTime:   arg.EndTime,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(10), statement.OpeningBalance)
+	assert.Len(t, statement.Lines, 2)
+	assert.Equal(t, account1.ID, statement.Lines[0].CounterpartyAccountID.Int64)
+
+	arg.MaxLines = 1
+	_, err = store.GetStatementTx(context.Background(), arg)
+	assert.ErrorIs(t, err, ErrStatementTooLong)
+}
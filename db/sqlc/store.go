@@ -3,14 +3,32 @@ package db
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/tgfukuda/be-master/metrics"
+	"github.com/tgfukuda/be-master/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type Store interface {
 	Querier
 	TransferTx(ctx context.Context, arg TransferTxParams) (TransferTxResult, error)
+	CrossCurrencyTransferTx(ctx context.Context, arg CrossCurrencyTransferTxParams) (TransferTxResult, error)
+	ReverseTransferTx(ctx context.Context, arg ReverseTransferTxParams) (ReverseTransferTxResult, error)
 	CreateUserTx(ctx context.Context, arg CreateUserTxParams) (CreateUserTxResult, error)
 	VerifyEmailTx(ctx context.Context, arg VerifyEmailTxParams) (VerifyEmailTxResult, error)
+	RenewSessionTx(ctx context.Context, arg RenewSessionTxParams) (RenewSessionTxResult, error)
+	RelayOutbox(ctx context.Context, arg RelayOutboxParams) (RelayOutboxResult, error)
+	CheckLedgerTx(ctx context.Context, arg CheckLedgerTxParams) (CheckLedgerTxResult, error)
+	ReconcileAccountTx(ctx context.Context, arg ReconcileAccountTxParams) (ReconcileAccountTxResult, error)
+	RecordScheduledTransferRunTx(ctx context.Context, arg RecordScheduledTransferRunTxParams) (RecordScheduledTransferRunTxResult, error)
+	GetStatementTx(ctx context.Context, arg GetStatementTxParams) (GetStatementTxResult, error)
+	UpdateAccountStatusTx(ctx context.Context, arg UpdateAccountStatusTxParams) (UpdateAccountStatusTxResult, error)
 }
 
 type SQLStore struct {
@@ -21,31 +39,133 @@ type SQLStore struct {
 func NewStore(db *sql.DB) Store {
 	return &SQLStore{
 		db:      db,
-		Queries: New(db),
+		Queries: New(newTracedDB(db, nil)),
 	}
 }
 
 // var txKey = struct{}{}	// for debug
 
-// tx utility: unexported
-func (store *SQLStore) execTx(ctx context.Context, fn func(*Queries) error) error {
-	tx, err := store.db.BeginTx(ctx, nil)
+// TxOptions configures a transaction run by execTx
+type TxOptions struct {
+	Isolation sql.IsolationLevel // default of the db (read committed) if zero
+	ReadOnly  bool
+	Retry     *RetryPolicy // DefaultRetryPolicy if nil
+}
+
+// RetryPolicy reruns the transactions aborted by postgres to resolve a conflict with the concurrent ones.
+// the transaction function must be safe to rerun, e.g. reset the result captured by the closure.
+type RetryPolicy struct {
+	MaxAttempts    int           // including the first one. 1 disables the retry
+	InitialBackoff time.Duration // doubled on each retry
+	MaxBackoff     time.Duration
+}
+
+var (
+	DefaultRetryPolicy = RetryPolicy{
+		MaxAttempts:    10,
+		InitialBackoff: 10 * time.Millisecond,
+		MaxBackoff:     time.Second,
+	}
+	NoRetry = RetryPolicy{MaxAttempts: 1}
+)
+
+// backoff of the n-th retry with full jitter, so the conflicting transactions don't collide again at the same time
+func (policy RetryPolicy) backoff(retry int) time.Duration {
+	backoff := policy.InitialBackoff
+	for i := 1; i < retry && backoff < policy.MaxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > policy.MaxBackoff {
+		backoff = policy.MaxBackoff
+	}
+	if backoff <= 0 {
+		return 0
+	}
+
+	return time.Duration(rand.Int63n(int64(backoff) + 1))
+}
+
+// isRetryable reports whether the transaction was aborted by postgres and succeeds by running it again
+func isRetryable(err error) bool {
+	var pqErr *pq.Error
+	if !errors.As(err, &pqErr) {
+		return false
+	}
+
+	switch pqErr.Code.Name() {
+	case "serialization_failure", "deadlock_detected":
+		return true
+	}
+	return false
+}
+
+// tx utility: unexported. name labels the metrics and the span of the transaction.
+// the transaction is retried transparently with the backoff while postgres aborts it by a serialization failure or a deadlock.
+func (store *SQLStore) execTx(ctx context.Context, name string, opts TxOptions, fn func(*Queries) error) (err error) {
+	startTime := time.Now()
+	outcome := metrics.TxFailed
+	ctx, span := tracing.Tracer().Start(ctx, "tx."+name)
+	defer func() {
+		metrics.ObserveTx(name, outcome, time.Since(startTime))
+		span.SetAttributes(attribute.String("db.tx.outcome", outcome))
+		tracing.End(span, err)
+	}()
+
+	policy := DefaultRetryPolicy
+	if opts.Retry != nil {
+		policy = *opts.Retry
+	}
+
+	for attempt := 1; ; attempt++ {
+		attemptTime := time.Now()
+		outcome, err = store.runTx(ctx, span, opts, fn)
+		if err == nil || !isRetryable(err) || attempt >= policy.MaxAttempts {
+			span.SetAttributes(attribute.Int("db.tx.attempts", attempt))
+			return err
+		}
+
+		metrics.ObserveTx(name, metrics.TxRetry, time.Since(attemptTime))
+		span.AddEvent("retry", trace.WithAttributes(
+			attribute.Int("db.tx.attempt", attempt),
+			attribute.String("error", err.Error()),
+		))
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("tx err: %v, retry canceled: %w", err, ctx.Err())
+		case <-time.After(policy.backoff(attempt)):
+		}
+	}
+}
+
+// runTx runs an attempt of the transaction
+func (store *SQLStore) runTx(ctx context.Context, span trace.Span, opts TxOptions, fn func(*Queries) error) (outcome string, err error) {
+	tx, err := store.db.BeginTx(ctx, &sql.TxOptions{
+		Isolation: opts.Isolation,
+		ReadOnly:  opts.ReadOnly,
+	})
 	if err != nil {
-		return err
+		return metrics.TxFailed, err
 	}
 
-	q := New(tx) // get queries
-	err = fn(q)  //	run queries
+	// get queries. they are traced as the children of the tx span
+	q := New(newTracedDB(tx, span))
+	err = fn(q) //	run queries
 
 	if err != nil { // we must rollback
 		rbErr := tx.Rollback()
 		if rbErr != nil {
-			return fmt.Errorf("tx err: %v, rb err: %v", err, rbErr) // combine errors
+			return metrics.TxFailed, fmt.Errorf("tx err: %v, rb err: %v", err, rbErr) // combine errors
 		}
 
 		// rollback succeeded but tx failed
-		return err
+		return metrics.TxRollback, err
+	}
+
+	err = tx.Commit() // try commit. a serializable transaction can fail here
+	if err != nil {
+		return metrics.TxFailed, err
 	}
 
-	return tx.Commit() // try commit
+	return metrics.TxCommit, nil
 }
@@ -2,24 +2,49 @@ package db
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/assert"
+	"github.com/tgfukuda/be-master/fx"
+	"github.com/tgfukuda/be-master/util"
 )
 
-func TestTransferTx(t *testing.T) {
-	store := NewStore(testDB)
+// createRandAccountWithBalance creates an account which can afford at least minBalance without overdraft
+func createRandAccountWithBalance(t *testing.T, minBalance int64) Account {
+	account := createRandAccount(t)
+	if account.Balance >= minBalance {
+		return account
+	}
 
-	account1 := createRandAccount(t)
-	account2 := createRandAccount(t)
+	account, err := testQueries.AddAccountBalance(context.Background(), AddAccountBalanceParams{
+		ID:     account.ID,
+		Amount: minBalance - account.Balance,
+	})
+	assert.NoError(t, err)
 
-	fmt.Printf(">>> before: %d, %d\n", account1.Balance, account2.Balance)
+	return account
+}
+
+func TestTransferTx(t *testing.T) {
+	store := NewStore(testDB)
 
 	// run a concurrent transfer transaction
 	n := 5
 	amount := int64(10)
 
+	account1 := createRandAccountWithBalance(t, int64(n)*amount)
+	account2 := createRandAccount(t)
+
+	fmt.Printf(">>> before: %d, %d\n", account1.Balance, account2.Balance)
+
 	errs := make(chan error)
 	txResults := make(chan TransferTxResult)
 
@@ -80,6 +105,11 @@ func TestTransferTx(t *testing.T) {
 		_, err = store.GetEntry(context.Background(), toEntry.ID)
 		assert.NoError(t, err)
 
+		// the transfer and its entries share the journal
+		assert.NotZero(t, transfer.JournalID)
+		assert.Equal(t, transfer.JournalID, fromEntry.JournalID)
+		assert.Equal(t, transfer.JournalID, toEntry.JournalID)
+
 		// check the account's balance
 		fromAccount := result.FromAccount
 		assert.NotEmpty(t, fromAccount)
@@ -117,15 +147,16 @@ func TestTransferTx(t *testing.T) {
 func TestTransferTxDeadLock(t *testing.T) {
 	store := NewStore(testDB)
 
-	account1 := createRandAccount(t)
-	account2 := createRandAccount(t)
-
-	fmt.Printf(">>> before: %d, %d\n", account1.Balance, account2.Balance)
-
 	// run a concurrent transfer transaction
 	n := 10
 	amount := int64(10)
 
+	// each side may send all of its half before receiving anything
+	account1 := createRandAccountWithBalance(t, int64(n)*amount)
+	account2 := createRandAccountWithBalance(t, int64(n)*amount)
+
+	fmt.Printf(">>> before: %d, %d\n", account1.Balance, account2.Balance)
+
 	errs := make(chan error)
 
 	for i := 0; i < n; i++ {
@@ -168,3 +199,487 @@ func TestTransferTxDeadLock(t *testing.T) {
 
 	fmt.Printf(">>> after: %d, %d\n", updatedAccount1.Balance, updatedAccount2.Balance)
 }
+
+// TestExecTxRetry moves money in the opposite directions without ordering the locks at serializable.
+// postgres aborts the conflicting ones by deadlocks or serialization failures, but all of them must succeed with the retry.
+func TestExecTxRetry(t *testing.T) {
+	store := NewStore(testDB).(*SQLStore)
+
+	n := 10
+	amount := int64(10)
+	account1 := createRandAccountWithBalance(t, int64(n)*amount)
+	account2 := createRandAccountWithBalance(t, int64(n)*amount)
+
+	var attempts int32
+	errs := make(chan error)
+
+	for i := 0; i < n; i++ {
+		fromAccountID, toAccountID := account1.ID, account2.ID
+		if i%2 == 1 {
+			fromAccountID, toAccountID = toAccountID, fromAccountID
+		}
+
+		go func() {
+			errs <- store.execTx(context.Background(), "TestExecTxRetry", TxOptions{
+				Isolation: sql.LevelSerializable,
+				Retry:     &RetryPolicy{MaxAttempts: 2 * n, InitialBackoff: time.Millisecond, MaxBackoff: 100 * time.Millisecond},
+			}, func(q *Queries) error {
+				atomic.AddInt32(&attempts, 1)
+
+				_, _, err := addMoney(context.Background(), q, fromAccountID, -amount, toAccountID, amount)
+				return err
+			})
+		}()
+	}
+
+	for i := 0; i < n; i++ {
+		err := <-errs
+		assert.NoError(t, err)
+	}
+	assert.GreaterOrEqual(t, int(atomic.LoadInt32(&attempts)), n)
+
+	updatedAccount1, err := store.GetAccount(context.Background(), account1.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, account1.Balance, updatedAccount1.Balance)
+
+	updatedAccount2, err := store.GetAccount(context.Background(), account2.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, account2.Balance, updatedAccount2.Balance)
+}
+
+// TestTransferTxSerializable runs the opposing transfers of different amounts among three accounts at the same time.
+// the aborted ones are retried by the default policy of TransferTx, so all of them succeed and no money is created or lost.
+func TestTransferTxSerializable(t *testing.T) {
+	store := NewStore(testDB)
+
+	n := 12
+	accounts := []Account{
+		createRandAccountWithBalance(t, int64(n)*int64(n)),
+		createRandAccountWithBalance(t, int64(n)*int64(n)),
+		createRandAccountWithBalance(t, int64(n)*int64(n)),
+	}
+
+	var total int64
+	for _, account := range accounts {
+		total += account.Balance
+	}
+
+	// the expected change of each account
+	diffs := make(map[int64]int64)
+	errs := make(chan error)
+
+	for i := 0; i < n; i++ {
+		fromAccount := accounts[i%len(accounts)]
+		toAccount := accounts[(i+1)%len(accounts)]
+		if i%2 == 1 {
+			fromAccount, toAccount = toAccount, fromAccount
+		}
+		amount := int64(i + 1)
+
+		diffs[fromAccount.ID] -= amount
+		diffs[toAccount.ID] += amount
+
+		go func() {
+			_, err := store.TransferTx(context.Background(), TransferTxParams{
+				FromAccountID: fromAccount.ID,
+				ToAccountID:   toAccount.ID,
+				Amount:        amount,
+			})
+
+			errs <- err
+		}()
+	}
+
+	for i := 0; i < n; i++ {
+		err := <-errs
+		assert.NoError(t, err)
+	}
+
+	var updatedTotal int64
+	for _, account := range accounts {
+		updatedAccount, err := store.GetAccount(context.Background(), account.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, account.Balance+diffs[account.ID], updatedAccount.Balance)
+
+		updatedTotal += updatedAccount.Balance
+	}
+	assert.Equal(t, total, updatedTotal)
+}
+
+func TestExecTxNotRetryable(t *testing.T) {
+	store := NewStore(testDB).(*SQLStore)
+
+	attempts := 0
+	err := store.execTx(context.Background(), "TestExecTxNotRetryable", TxOptions{}, func(q *Queries) error {
+		attempts++
+		_, err := q.GetAccount(context.Background(), -1)
+		return err
+	})
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+	assert.Equal(t, 1, attempts)
+
+	// writes are rejected in the read only transaction
+	account := createRandAccount(t)
+	err = store.execTx(context.Background(), "TestExecTxReadOnly", TxOptions{ReadOnly: true}, func(q *Queries) error {
+		_, err := q.AddAccountBalance(context.Background(), AddAccountBalanceParams{ID: account.ID, Amount: 1})
+		return err
+	})
+	assert.Error(t, err)
+}
+
+func TestIsRetryable(t *testing.T) {
+	assert.True(t, isRetryable(&pq.Error{Code: "40001"}))
+	assert.True(t, isRetryable(fmt.Errorf("wrapped: %w", &pq.Error{Code: "40P01"})))
+	assert.False(t, isRetryable(&pq.Error{Code: "23505"}))
+	assert.False(t, isRetryable(sql.ErrNoRows))
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	policy := RetryPolicy{MaxAttempts: 10, InitialBackoff: 10 * time.Millisecond, MaxBackoff: 50 * time.Millisecond}
+
+	for retry := 1; retry < 10; retry++ {
+		backoff := policy.backoff(retry)
+		assert.GreaterOrEqual(t, backoff, time.Duration(0))
+		assert.LessOrEqual(t, backoff, policy.MaxBackoff)
+	}
+	assert.LessOrEqual(t, policy.backoff(1), policy.InitialBackoff)
+	assert.Zero(t, NoRetry.backoff(1))
+}
+
+func TestTransferTxInsufficientFunds(t *testing.T) {
+	store := NewStore(testDB)
+
+	account1 := createRandAccount(t)
+	account2 := createRandAccount(t)
+
+	// no overdraft allowed by default
+	_, err := store.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        account1.Balance + 1,
+	})
+	assert.ErrorIs(t, err, ErrInsufficientFunds)
+
+	// allow overdraft and transfer within the limit
+	overdraftLimit := int64(100)
+	account1, err = store.UpdateAccountOverdraftLimit(context.Background(), UpdateAccountOverdraftLimitParams{
+		ID:             account1.ID,
+		OverdraftLimit: overdraftLimit,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, overdraftLimit, account1.OverdraftLimit)
+
+	result, err := store.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        account1.Balance + overdraftLimit,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, -overdraftLimit, result.FromAccount.Balance)
+
+	// already at the limit
+	_, err = store.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        1,
+	})
+	assert.ErrorIs(t, err, ErrInsufficientFunds)
+
+	// the failed transfers must not change any balance
+	updatedAccount1, err := store.GetAccount(context.Background(), account1.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, -overdraftLimit, updatedAccount1.Balance)
+
+	updatedAccount2, err := store.GetAccount(context.Background(), account2.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, account2.Balance+account1.Balance+overdraftLimit, updatedAccount2.Balance)
+}
+
+func TestUpdateAccountNegativeOverdraftLimit(t *testing.T) {
+	account := createRandAccount(t)
+
+	_, err := testQueries.UpdateAccountOverdraftLimit(context.Background(), UpdateAccountOverdraftLimitParams{
+		ID:             account.ID,
+		OverdraftLimit: -1,
+	})
+	assert.Error(t, err)
+}
+
+func TestTransferTxIdempotency(t *testing.T) {
+	store := NewStore(testDB)
+
+	amount := int64(10)
+	account1 := createRandAccountWithBalance(t, 2*amount)
+	account2 := createRandAccount(t)
+
+	arg := TransferTxParams{
+		FromAccountID:  account1.ID,
+		ToAccountID:    account2.ID,
+		Amount:         amount,
+		IdempotencyKey: util.RandomString(32),
+	}
+
+	// retry the same request concurrently as clients do after timeouts
+	n := 5
+	errs := make(chan error)
+	txResults := make(chan TransferTxResult)
+
+	for i := 0; i < n; i++ {
+		go func() {
+			result, err := store.TransferTx(context.Background(), arg)
+
+			errs <- err
+			txResults <- result
+		}()
+	}
+
+	var transferID int64
+	replayed := 0
+	for i := 0; i < n; i++ {
+		err := <-errs
+		result := <-txResults
+
+		assert.NoError(t, err)
+		if transferID == 0 {
+			transferID = result.Transfer.ID
+		}
+		assert.Equal(t, transferID, result.Transfer.ID)
+		if result.Replayed {
+			replayed++
+		}
+	}
+	assert.Equal(t, n-1, replayed)
+
+	// money moved only once
+	updatedAccount1, err := store.GetAccount(context.Background(), account1.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, account1.Balance-amount, updatedAccount1.Balance)
+
+	updatedAccount2, err := store.GetAccount(context.Background(), account2.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, account2.Balance+amount, updatedAccount2.Balance)
+
+	// the same key with a different payload
+	arg.Amount = amount + 1
+	_, err = store.TransferTx(context.Background(), arg)
+	assert.ErrorIs(t, err, ErrIdempotencyKeyReused)
+}
+
+func createRandAccountWithCurrency(t *testing.T, currency string, balance int64) Account {
+	user := createRandUser(t)
+	account, err := testQueries.CreateAccount(context.Background(), CreateAccountParams{
+		Owner:    user.Username,
+		Balance:  balance,
+		Currency: currency,
+	})
+	assert.NoError(t, err)
+
+	return account
+}
+
+func TestCrossCurrencyTransferTx(t *testing.T) {
+	store := NewStore(testDB)
+
+	rateFile := filepath.Join(t.TempDir(), "rates.json")
+	err := os.WriteFile(rateFile, []byte(`{"USD/JPY": "149.25"}`), 0o600)
+	assert.NoError(t, err)
+
+	provider, err := fx.NewFileExchangeRateProvider(rateFile)
+	assert.NoError(t, err)
+
+	account1 := createRandAccountWithCurrency(t, util.USD, 1000)
+	account2 := createRandAccountWithCurrency(t, util.JPY, 1000)
+
+	amount := int64(3)
+	result, err := store.CrossCurrencyTransferTx(context.Background(), CrossCurrencyTransferTxParams{
+		TransferTxParams: TransferTxParams{
+			FromAccountID: account1.ID,
+			ToAccountID:   account2.ID,
+			Amount:        amount,
+		},
+		ExchangeRateProvider: provider,
+	})
+	assert.NoError(t, err)
+
+	// 3 * 149.25 = 447.75 is rounded toward zero
+	toAmount := int64(447)
+	assert.Equal(t, amount, result.Transfer.Amount)
+	assert.Equal(t, toAmount, result.Transfer.ToAmount)
+	assert.Equal(t, "149.25", result.Transfer.ExchangeRate)
+
+	assert.Equal(t, -amount, result.FromEntry.Amount)
+	assert.Equal(t, toAmount, result.ToEntry.Amount)
+
+	assert.Equal(t, account1.Balance-amount, result.FromAccount.Balance)
+	assert.Equal(t, account2.Balance+toAmount, result.ToAccount.Balance)
+
+	// the inverse is derived from the file
+	result, err = store.CrossCurrencyTransferTx(context.Background(), CrossCurrencyTransferTxParams{
+		TransferTxParams: TransferTxParams{
+			FromAccountID: account2.ID,
+			ToAccountID:   account1.ID,
+			Amount:        toAmount,
+		},
+		ExchangeRateProvider: provider,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), result.Transfer.ToAmount)
+
+	// the stored rate of 1 / 149.25 gives back the converted amount
+	reproduced, err := fx.MustParseRate(result.Transfer.ExchangeRate).Convert(toAmount)
+	assert.NoError(t, err)
+	assert.Equal(t, result.Transfer.ToAmount, reproduced)
+
+	// 100 JPY is less than 1 USD
+	_, err = store.CrossCurrencyTransferTx(context.Background(), CrossCurrencyTransferTxParams{
+		TransferTxParams: TransferTxParams{
+			FromAccountID: account2.ID,
+			ToAccountID:   account1.ID,
+			Amount:        100,
+		},
+		ExchangeRateProvider: provider,
+	})
+	assert.ErrorIs(t, err, ErrTransferTooSmall)
+
+	// no rate for EUR
+	account3 := createRandAccountWithCurrency(t, util.EUR, 1000)
+	_, err = store.CrossCurrencyTransferTx(context.Background(), CrossCurrencyTransferTxParams{
+		TransferTxParams: TransferTxParams{
+			FromAccountID: account1.ID,
+			ToAccountID:   account3.ID,
+			Amount:        amount,
+		},
+		ExchangeRateProvider: provider,
+	})
+	assert.ErrorIs(t, err, fx.ErrRateNotFound)
+}
+
+func TestListTransfersAndEntriesByCursor(t *testing.T) {
+	store := NewStore(testDB)
+
+	account1 := createFundedAccount(t, util.USD, 1000)
+	account2 := createFundedAccount(t, util.USD, 0)
+
+	var results []TransferTxResult
+	for i := 0; i < 3; i++ {
+		result, err := store.TransferTx(context.Background(), TransferTxParams{
+			FromAccountID: account1.ID,
+			ToAccountID:   account2.ID,
+			Amount:        10,
+		})
+		assert.NoError(t, err)
+		results = append(results, result)
+	}
+
+	transfersFrom, err := store.ListTransfersFrom(context.Background(), ListTransfersFromParams{
+		FromAccountID:  account1.ID,
+		AfterCreatedAt: results[0].Transfer.CreatedAt,
+		AfterID:        results[0].Transfer.ID,
+		LimitCount:     5,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, transfersFrom, 2)
+	assert.Equal(t, results[1].Transfer.ID, transfersFrom[0].ID)
+	assert.Equal(t, results[2].Transfer.ID, transfersFrom[1].ID)
+
+	transfersTo, err := store.ListTransfersTo(context.Background(), ListTransfersToParams{
+		ToAccountID: account2.ID,
+		LimitCount:  1,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, transfersTo, 1)
+	assert.Equal(t, results[0].Transfer.ID, transfersTo[0].ID)
+
+	entries, err := store.ListEntriesOf(context.Background(), ListEntriesOfParams{
+		AccountID:      account2.ID,
+		AfterCreatedAt: results[1].ToEntry.CreatedAt,
+		AfterID:        results[1].ToEntry.ID,
+		LimitCount:     5,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, entries, 1)
+	assert.Equal(t, results[2].ToEntry.ID, entries[0].ID)
+}
+
+func TestListTransfersOfOwner(t *testing.T) {
+	store := NewStore(testDB)
+
+	user := createRandUser(t)
+	mine := make([]Account, 0, 2)
+	for _, currency := range []string{util.USD, util.EUR} {
+		account, err := testQueries.CreateAccount(context.Background(), CreateAccountParams{
+			Owner:    user.Username,
+			Currency: currency,
+		})
+		assert.NoError(t, err)
+
+		account, err = testQueries.UpdateAccountOverdraftLimit(context.Background(), UpdateAccountOverdraftLimitParams{
+			ID:             account.ID,
+			OverdraftLimit: 1000,
+		})
+		assert.NoError(t, err)
+		mine = append(mine, account)
+	}
+	other := createFundedAccount(t, util.USD, 1000)
+
+	sent, err := store.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: mine[0].ID,
+		ToAccountID:   other.ID,
+		Amount:        10,
+	})
+	assert.NoError(t, err)
+
+	received, err := store.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: other.ID,
+		ToAccountID:   mine[0].ID,
+		Amount:        50,
+	})
+	assert.NoError(t, err)
+
+	arg := ListTransfersOfOwnerParams{
+		Outgoing:        true,
+		Incoming:        true,
+		Owner:           user.Username,
+		MaxAmount:       math.MaxInt64,
+		EndTime:         time.Now().Add(time.Hour),
+		BeforeCreatedAt: time.Now().Add(time.Hour),
+		LimitCount:      10,
+	}
+
+	// the newest first with the owner of the other side
+	transfers, err := store.ListTransfersOfOwner(context.Background(), arg)
+	assert.NoError(t, err)
+	assert.Len(t, transfers, 2)
+	assert.Equal(t, received.Transfer.ID, transfers[0].ID)
+	assert.Equal(t, other.Owner, transfers[0].FromOwner)
+	assert.Equal(t, sent.Transfer.ID, transfers[1].ID)
+	assert.Equal(t, other.Owner, transfers[1].ToOwner)
+
+	incoming := arg
+	incoming.Outgoing = false
+	transfers, err = store.ListTransfersOfOwner(context.Background(), incoming)
+	assert.NoError(t, err)
+	assert.Len(t, transfers, 1)
+	assert.Equal(t, received.Transfer.ID, transfers[0].ID)
+
+	large := arg
+	large.MinAmount = 20
+	transfers, err = store.ListTransfersOfOwner(context.Background(), large)
+	assert.NoError(t, err)
+	assert.Len(t, transfers, 1)
+	assert.Equal(t, received.Transfer.ID, transfers[0].ID)
+
+	euro := arg
+	euro.Currency = util.EUR
+	transfers, err = store.ListTransfersOfOwner(context.Background(), euro)
+	assert.NoError(t, err)
+	assert.Empty(t, transfers)
+
+	// the next page after the newest one
+	arg.BeforeCreatedAt = received.Transfer.CreatedAt
+	arg.BeforeID = received.Transfer.ID
+	transfers, err = store.ListTransfersOfOwner(context.Background(), arg)
+	assert.NoError(t, err)
+	assert.Len(t, transfers, 1)
+	assert.Equal(t, sent.Transfer.ID, transfers[0].ID)
+}
@@ -0,0 +1,79 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"github.com/tgfukuda/be-master/tracing"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracedDB starts a span for each query run by the generated Queries
+type tracedDB struct {
+	db DBTX
+	tx trace.Span // span of the transaction the queries belong to. nil out of a transaction.
+}
+
+func newTracedDB(db DBTX, tx trace.Span) DBTX {
+	return &tracedDB{db: db, tx: tx}
+}
+
+// queryName takes the name from the "-- name: GetAccount :one" header sqlc puts on every query
+func queryName(query string) string {
+	header, _, _ := strings.Cut(query, "\n")
+	fields := strings.Fields(header)
+	if len(fields) < 3 || fields[0] != "--" || fields[1] != "name:" {
+		return "query"
+	}
+	return fields[2]
+}
+
+func (db *tracedDB) start(ctx context.Context, query string) (context.Context, trace.Span) {
+	// the callbacks of execTx keep the context given to the tx, so the span of the tx is set here
+	if db.tx != nil {
+		ctx = trace.ContextWithSpan(ctx, db.tx)
+	}
+
+	return tracing.Tracer().Start(ctx, "db."+queryName(query),
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.DBSystemPostgreSQL,
+			semconv.DBStatement(query),
+		),
+	)
+}
+
+func (db *tracedDB) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	ctx, span := db.start(ctx, query)
+	result, err := db.db.ExecContext(ctx, query, args...)
+	tracing.End(span, err)
+
+	return result, err
+}
+
+func (db *tracedDB) PrepareContext(ctx context.Context, query string) (*sql.Stmt, error) {
+	ctx, span := db.start(ctx, query)
+	stmt, err := db.db.PrepareContext(ctx, query)
+	tracing.End(span, err)
+
+	return stmt, err
+}
+
+func (db *tracedDB) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	ctx, span := db.start(ctx, query)
+	rows, err := db.db.QueryContext(ctx, query, args...)
+	tracing.End(span, err)
+
+	return rows, err
+}
+
+// QueryRowContext ends the span before the row is scanned. the error of the query comes with Scan.
+func (db *tracedDB) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	ctx, span := db.start(ctx, query)
+	row := db.db.QueryRowContext(ctx, query, args...)
+	tracing.End(span, row.Err())
+
+	return row
+}
@@ -0,0 +1,124 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// status of an account
+const (
+	AccountActive = "active"
+	AccountFrozen = "frozen"
+	AccountClosed = "closed"
+)
+
+var (
+	ErrAccountFrozen                  = errors.New("account is frozen")
+	ErrAccountClosed                  = errors.New("account is closed")
+	ErrInvalidAccountStatusTransition = errors.New("account can't be moved to the status")
+	ErrAccountBalanceNotZero          = errors.New("account balance is not zero")
+)
+
+// accountStatusTransitions has the statuses an account can move to from each status.
+// a frozen account must be unfrozen before it's closed, so the owner can't get around the freeze.
+var accountStatusTransitions = map[string][]string{
+	AccountActive: {AccountFrozen, AccountClosed},
+	AccountFrozen: {AccountActive},
+	AccountClosed: {AccountActive},
+}
+
+type UpdateAccountStatusTxParams struct {
+	AccountID int64 `json:"account_id"`
+	// required. the status the caller is allowed to move the account from, e.g. the owner can't unfreeze by reopening
+	FromStatus string `json:"from_status"`
+	Status     string `json:"status"`
+	Reason     string `json:"reason"`
+	Actor      string `json:"actor"` // username of the user who changes the status
+}
+
+type UpdateAccountStatusTxResult struct {
+	Account Account            `json:"account"`
+	Event   AccountStatusEvent `json:"event"`
+}
+
+// UpdateAccountStatusTx moves the account to the status and records who did it and why.
+// only a zero balance account can be closed.
+func (store *SQLStore) UpdateAccountStatusTx(ctx context.Context, arg UpdateAccountStatusTxParams) (UpdateAccountStatusTxResult, error) {
+	var result UpdateAccountStatusTxResult
+
+	err := store.execTx(ctx, "UpdateAccountStatusTx", TxOptions{}, func(q *Queries) error {
+		result = UpdateAccountStatusTxResult{}
+
+		// the lock waits for the transfers in flight, so the balance is final when it's checked
+		account, err := q.GetAccountForUpdate(ctx, arg.AccountID)
+		if err != nil {
+			return err
+		}
+
+		if account.Status != arg.FromStatus || !canMoveAccountStatus(account.Status, arg.Status) {
+			return fmt.Errorf("%w: account [%d] is %s but %s to %s requested",
+				ErrInvalidAccountStatusTransition, account.ID, account.Status, arg.FromStatus, arg.Status)
+		}
+
+		if arg.Status == AccountClosed && account.Balance != 0 {
+			return fmt.Errorf("%w: account [%d] has balance %d", ErrAccountBalanceNotZero, account.ID, account.Balance)
+		}
+
+		result.Account, err = q.UpdateAccountStatus(ctx, UpdateAccountStatusParams{
+			Status: arg.Status,
+			ID:     arg.AccountID,
+		})
+		if err != nil {
+			return err
+		}
+
+		result.Event, err = q.CreateAccountStatusEvent(ctx, CreateAccountStatusEventParams{
+			AccountID:  arg.AccountID,
+			FromStatus: account.Status,
+			ToStatus:   arg.Status,
+			Reason:     arg.Reason,
+			Actor:      arg.Actor,
+		})
+		return err
+	})
+
+	return result, err
+}
+
+func canMoveAccountStatus(from string, to string) bool {
+	for _, status := range accountStatusTransitions[from] {
+		if status == to {
+			return true
+		}
+	}
+	return false
+}
+
+// AccountStatusError tells which account stopped the money movement.
+// it unwraps to ErrAccountFrozen or ErrAccountClosed by the status of the account.
+type AccountStatusError struct {
+	AccountID int64
+	Status    string
+}
+
+func (err *AccountStatusError) Error() string {
+	return fmt.Sprintf("account [%d] is %s", err.AccountID, err.Status)
+}
+
+func (err *AccountStatusError) Unwrap() error {
+	if err.Status == AccountFrozen {
+		return ErrAccountFrozen
+	}
+	return ErrAccountClosed
+}
+
+// checkAccountsActive rejects the money movement if any of the accounts is frozen or closed
+func checkAccountsActive(accounts ...Account) error {
+	for _, account := range accounts {
+		if account.Status != AccountActive {
+			return &AccountStatusError{AccountID: account.ID, Status: account.Status}
+		}
+	}
+	return nil
+}
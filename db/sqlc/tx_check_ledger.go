@@ -0,0 +1,45 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+)
+
+type CheckLedgerTxParams struct {
+	AccountID int64 // checks the account and its journals only. the whole ledger if zero
+}
+
+type CheckLedgerTxResult struct {
+	BalanceMismatches  []ListBalanceMismatchesRow  // the balance isn't the sum of the entries of the account
+	UnbalancedJournals []ListUnbalancedJournalsRow // the entries of the journal don't net to zero in the currency
+}
+
+// Balanced reports whether no invariant is violated
+func (result CheckLedgerTxResult) Balanced() bool {
+	return len(result.BalanceMismatches) == 0 && len(result.UnbalancedJournals) == 0
+}
+
+// CheckLedgerTx checks the invariants of the double-entry ledger on a snapshot:
+// the balance of each account is the sum of its entries, and the entries of each journal net to zero in each currency.
+func (store *SQLStore) CheckLedgerTx(ctx context.Context, arg CheckLedgerTxParams) (CheckLedgerTxResult, error) {
+	var result CheckLedgerTxResult
+
+	accountID := sql.NullInt64{
+		Int64: arg.AccountID,
+		Valid: arg.AccountID != 0,
+	}
+
+	err := store.execTx(ctx, "CheckLedgerTx", TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true}, func(q *Queries) error {
+		var err error
+
+		result.BalanceMismatches, err = q.ListBalanceMismatches(ctx, accountID)
+		if err != nil {
+			return err
+		}
+
+		result.UnbalancedJournals, err = q.ListUnbalancedJournals(ctx, accountID)
+		return err
+	})
+
+	return result, err
+}
@@ -4,7 +4,9 @@ import "context"
 
 type CreateUserTxParams struct {
 	CreateUserParams
-	AfterCreate func(user User) error // callback
+	// AfterCreate returns the tasks triggered by the new user.
+	// they are written to the outbox in the same transaction and enqueued by the relay after the commit.
+	AfterCreate func(user User) ([]CreateOutboxParams, error)
 }
 
 type CreateUserTxResult struct {
@@ -14,7 +16,7 @@ type CreateUserTxResult struct {
 func (store *SQLStore) CreateUserTx(ctx context.Context, arg CreateUserTxParams) (CreateUserTxResult, error) {
 	var result CreateUserTxResult
 
-	err := store.execTx(ctx, func(q *Queries) error {
+	err := store.execTx(ctx, "CreateUserTx", TxOptions{}, func(q *Queries) error {
 		var err error
 
 		result.User, err = q.CreateUser(ctx, arg.CreateUserParams)
@@ -22,7 +24,22 @@ func (store *SQLStore) CreateUserTx(ctx context.Context, arg CreateUserTxParams)
 			return err
 		}
 
-		return arg.AfterCreate(result.User)
+		if arg.AfterCreate == nil {
+			return nil
+		}
+
+		tasks, err := arg.AfterCreate(result.User)
+		if err != nil {
+			return err
+		}
+
+		for _, task := range tasks {
+			if _, err := q.CreateOutbox(ctx, task); err != nil {
+				return err
+			}
+		}
+
+		return nil
 	})
 
 	return result, err
@@ -0,0 +1,75 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+)
+
+// number of the entries read at once to sum them up
+const reconcileEntriesBatchSize = 1000
+
+type ReconcileAccountTxParams struct {
+	AccountID int64
+}
+
+type ReconcileAccountTxResult struct {
+	Account      Account
+	EntriesTotal int64
+	Report       *ReconciliationReport // saved only if the balance drifted from the entries
+}
+
+// ReconcileAccountTx recomputes the balance of the account from its entries and saves a report if it doesn't match.
+// the account and the entries are read on the same snapshot, so the concurrent transfers don't look like a drift.
+func (store *SQLStore) ReconcileAccountTx(ctx context.Context, arg ReconcileAccountTxParams) (ReconcileAccountTxResult, error) {
+	var result ReconcileAccountTxResult
+
+	err := store.execTx(ctx, "ReconcileAccountTx", TxOptions{Isolation: sql.LevelRepeatableRead}, func(q *Queries) error {
+		var err error
+		result = ReconcileAccountTxResult{}
+
+		result.Account, err = q.GetAccount(ctx, arg.AccountID)
+		if err != nil {
+			return err
+		}
+
+		var after Entry
+		for {
+			entries, err := q.ListEntriesOf(ctx, ListEntriesOfParams{
+				AccountID:      arg.AccountID,
+				AfterCreatedAt: after.CreatedAt,
+				AfterID:        after.ID,
+				LimitCount:     reconcileEntriesBatchSize,
+			})
+			if err != nil {
+				return err
+			}
+
+			for _, entry := range entries {
+				result.EntriesTotal += entry.Amount
+			}
+
+			if len(entries) < reconcileEntriesBatchSize {
+				break
+			}
+			after = entries[len(entries)-1]
+		}
+
+		if result.Account.Balance == result.EntriesTotal {
+			return nil
+		}
+
+		report, err := q.CreateReconciliationReport(ctx, CreateReconciliationReportParams{
+			AccountID:    result.Account.ID,
+			Balance:      result.Account.Balance,
+			EntriesTotal: result.EntriesTotal,
+		})
+		if err != nil {
+			return err
+		}
+		result.Report = &report
+
+		return nil
+	})
+
+	return result, err
+}
@@ -0,0 +1,86 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrSessionBlocked     = errors.New("blocked session")
+	ErrSessionExpired     = errors.New("expired session")
+	ErrSessionMismatch    = errors.New("mismatched session")
+	ErrRefreshTokenReused = errors.New("refresh token is already used")
+)
+
+type RenewSessionTxParams struct {
+	ID           uuid.UUID // id of the refresh token
+	Username     string    // owner of the refresh token
+	RefreshToken string
+	// NewSession builds the session replacing the old one. only called when the old one is still valid.
+	// FamilyID is overwritten by the family of the old one.
+	NewSession func(old Session) (CreateNewSessionParams, error)
+}
+
+type RenewSessionTxResult struct {
+	OldSession Session
+	Session    Session
+}
+
+// RenewSessionTx exchanges a refresh token for a new session and retires the old one.
+// if a retired refresh token is presented again, it's regarded as stolen and every session of the family is blocked.
+func (store *SQLStore) RenewSessionTx(ctx context.Context, arg RenewSessionTxParams) (RenewSessionTxResult, error) {
+	var result RenewSessionTxResult
+	reused := false
+
+	err := store.execTx(ctx, "RenewSessionTx", TxOptions{}, func(q *Queries) error {
+		var err error
+		reused = false
+
+		result.OldSession, err = q.GetSessionForUpdate(ctx, arg.ID)
+		if err != nil {
+			return err
+		}
+
+		old := result.OldSession
+		if old.Username != arg.Username || old.RefreshToken != arg.RefreshToken {
+			return ErrSessionMismatch
+		}
+
+		if old.IsBlocked {
+			return ErrSessionBlocked
+		}
+
+		if old.IsRotated {
+			// commit the blocking, the error is returned after the transaction
+			reused = true
+			_, err = q.BlockSessionFamily(ctx, old.FamilyID)
+			return err
+		}
+
+		if time.Now().After(old.ExpiredAt) {
+			return ErrSessionExpired
+		}
+
+		result.OldSession, err = q.RotateSession(ctx, old.ID)
+		if err != nil {
+			return err
+		}
+
+		newSession, err := arg.NewSession(result.OldSession)
+		if err != nil {
+			return err
+		}
+		newSession.FamilyID = old.FamilyID
+
+		result.Session, err = q.CreateNewSession(ctx, newSession)
+		return err
+	})
+	if err == nil && reused {
+		err = ErrRefreshTokenReused
+	}
+
+	return result, err
+}
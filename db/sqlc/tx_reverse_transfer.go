@@ -0,0 +1,130 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/tgfukuda/be-master/fx"
+)
+
+var (
+	ErrTransferAlreadyReversed = errors.New("transfer is already reversed")
+	ErrReversalExceedsTransfer = errors.New("reversal exceeds the rest of the transfer")
+	ErrReversalNotReversible   = errors.New("reversal can't be reversed")
+	ErrReversalTooSmall        = errors.New("reversal is too small to move any money back")
+)
+
+type ReverseTransferTxParams struct {
+	TransferID int64 `json:"transfer_id"`
+	// refunded to the sender in the currency of the from account of the original transfer. the whole rest if zero
+	Amount int64 `json:"amount"`
+}
+
+type ReverseTransferTxResult struct {
+	TransferTxResult          // the compensating transfer from the receiver back to the sender of the original
+	Original         Transfer `json:"original"`
+	ReversedAmount   int64    `json:"reversed_amount"` // refunded in total including this one
+}
+
+// ReverseTransferTx refunds the original transfer by a compensating transfer linked to it.
+// the refunds can be split, but their sum never exceeds the original amount.
+// the receiver gives back the share of what it received, so a full refund moves exactly the original amounts back.
+func (store *SQLStore) ReverseTransferTx(ctx context.Context, arg ReverseTransferTxParams) (ReverseTransferTxResult, error) {
+	var result ReverseTransferTxResult
+
+	// serializable to see the concurrent refunds of the same transfer. the aborted ones are retried by execTx
+	err := store.execTx(ctx, "ReverseTransferTx", TxOptions{Isolation: sql.LevelSerializable}, func(q *Queries) error {
+		var err error
+		result = ReverseTransferTxResult{}
+
+		// the lock on the original queues the refunds of the same transfer
+		result.Original, err = q.GetTransferForUpdate(ctx, arg.TransferID)
+		if err != nil {
+			return err
+		}
+		original := result.Original
+
+		if original.ReversalOf.Valid {
+			return fmt.Errorf("%w: transfer [%d] is the reversal of [%d]", ErrReversalNotReversible, original.ID, original.ReversalOf.Int64)
+		}
+
+		reversed, err := q.GetReversedAmount(ctx, sql.NullInt64{Int64: original.ID, Valid: true})
+		if err != nil {
+			return err
+		}
+
+		rest := original.Amount - reversed
+		if rest <= 0 {
+			return fmt.Errorf("%w: transfer [%d]", ErrTransferAlreadyReversed, original.ID)
+		}
+
+		amount := arg.Amount
+		if amount == 0 {
+			amount = rest
+		}
+		if amount > rest {
+			return fmt.Errorf("%w: transfer [%d] has %d left but %d requested", ErrReversalExceedsTransfer, original.ID, rest, amount)
+		}
+
+		// the receiver pays back its share of the refunds so far. rounded toward zero, so the last one settles the rest
+		share, err := fx.ParseRate(fmt.Sprintf("%d/%d", original.ToAmount, original.Amount))
+		if err != nil {
+			return err
+		}
+
+		paidBefore, err := share.Convert(reversed)
+		if err != nil {
+			return err
+		}
+
+		paidAfter, err := share.Convert(reversed + amount)
+		if err != nil {
+			return err
+		}
+
+		debit := paidAfter - paidBefore
+		if debit <= 0 {
+			return fmt.Errorf("%w: %d of transfer [%d] is %d in the currency of the receiver", ErrReversalTooSmall, amount, original.ID, debit)
+		}
+
+		// the money goes back from the receiver to the sender
+		fromAccount, toAccount, err := lockAccounts(ctx, q, original.ToAccountID, original.FromAccountID)
+		if err != nil {
+			return err
+		}
+
+		if err := checkAccountsActive(fromAccount, toAccount); err != nil {
+			return err
+		}
+
+		if fromAccount.Balance-debit < -fromAccount.OverdraftLimit {
+			return fmt.Errorf("%w: account [%d] has balance %d with overdraft limit %d but %d requested",
+				ErrInsufficientFunds, fromAccount.ID, fromAccount.Balance, fromAccount.OverdraftLimit, debit)
+		}
+
+		// the rate of this leg, not the inverse of the share, since the rounding of the share is on the receiver
+		rate, err := fx.ParseRate(fmt.Sprintf("%d/%d", amount, debit))
+		if err != nil {
+			return err
+		}
+
+		result.TransferTxResult, err = postTransfer(ctx, q, fromAccount, toAccount, CreateTransferParams{
+			FromAccountID: original.ToAccountID,
+			ToAccountID:   original.FromAccountID,
+			Amount:        debit,
+			ToAmount:      amount,
+			ExchangeRate:  rate.StringFor(debit),
+			ReversalOf:    sql.NullInt64{Int64: original.ID, Valid: true},
+		})
+		if err != nil {
+			return err
+		}
+
+		result.ReversedAmount = reversed + amount
+		return nil
+	})
+
+	return result, err
+}
@@ -0,0 +1,77 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+// status of a scheduled transfer
+const (
+	ScheduledTransferActive    = "active"
+	ScheduledTransferPaused    = "paused"
+	ScheduledTransferCancelled = "cancelled"
+)
+
+// outcome of a run of a scheduled transfer
+const (
+	ScheduledTransferRunSucceeded         = "succeeded"
+	ScheduledTransferRunInsufficientFunds = "insufficient_funds"
+	ScheduledTransferRunCurrencyMismatch  = "currency_mismatch"
+	ScheduledTransferRunFailed            = "failed"
+)
+
+type RecordScheduledTransferRunTxParams struct {
+	ScheduledTransferID int64
+	ScheduledAt         time.Time // next_run_at of the scheduled transfer when the run started
+	Status              string
+	TransferID          sql.NullInt64 // the transfer paid by the run if succeeded
+	Error               string
+	NextRunAt           time.Time
+}
+
+type RecordScheduledTransferRunTxResult struct {
+	ScheduledTransfer ScheduledTransfer
+	Run               *ScheduledTransferRun // nil if the run is already recorded by another attempt
+}
+
+// RecordScheduledTransferRunTx saves the outcome of the run and moves the scheduled transfer to the next run.
+// the run is recorded only once even if the task is retried or processed concurrently.
+func (store *SQLStore) RecordScheduledTransferRunTx(ctx context.Context, arg RecordScheduledTransferRunTxParams) (RecordScheduledTransferRunTxResult, error) {
+	var result RecordScheduledTransferRunTxResult
+
+	err := store.execTx(ctx, "RecordScheduledTransferRunTx", TxOptions{}, func(q *Queries) error {
+		var err error
+		result = RecordScheduledTransferRunTxResult{}
+
+		result.ScheduledTransfer, err = q.GetScheduledTransferForUpdate(ctx, arg.ScheduledTransferID)
+		if err != nil {
+			return err
+		}
+
+		// another attempt has already moved it to the next run
+		if !result.ScheduledTransfer.NextRunAt.Equal(arg.ScheduledAt) {
+			return nil
+		}
+
+		run, err := q.CreateScheduledTransferRun(ctx, CreateScheduledTransferRunParams{
+			ScheduledTransferID: arg.ScheduledTransferID,
+			ScheduledAt:         arg.ScheduledAt,
+			Status:              arg.Status,
+			TransferID:          arg.TransferID,
+			Error:               arg.Error,
+		})
+		if err != nil {
+			return err
+		}
+		result.Run = &run
+
+		result.ScheduledTransfer, err = q.UpdateScheduledTransferNextRunAt(ctx, UpdateScheduledTransferNextRunAtParams{
+			ID:        arg.ScheduledTransferID,
+			NextRunAt: arg.NextRunAt,
+		})
+		return err
+	})
+
+	return result, err
+}
@@ -0,0 +1,94 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// number of the entries read at once for a statement
+const statementEntriesBatchSize = 1000
+
+var ErrStatementTooLong = errors.New("statement has too many entries")
+
+type GetStatementTxParams struct {
+	AccountID int64     `json:"account_id"`
+	StartTime time.Time `json:"start_time"` // inclusive
+	EndTime   time.Time `json:"end_time"`   // exclusive
+	MaxLines  int       `json:"max_lines"`  // no limit if zero
+}
+
+type StatementLine struct {
+	ListStatementEntriesRow
+	Balance int64 `json:"balance"` // running balance after the entry
+}
+
+type GetStatementTxResult struct {
+	Account        Account         `json:"account"`
+	OpeningBalance int64           `json:"opening_balance"` // balance at the start time
+	ClosingBalance int64           `json:"closing_balance"` // balance at the end time
+	Lines          []StatementLine `json:"lines"`
+}
+
+// GetStatementTx lists the entries of the account in the time range with the running balance.
+// the opening balance and the entries are read on the same snapshot, so the lines add up to the closing balance.
+func (store *SQLStore) GetStatementTx(ctx context.Context, arg GetStatementTxParams) (GetStatementTxResult, error) {
+	var result GetStatementTxResult
+
+	err := store.execTx(ctx, "GetStatementTx", TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true}, func(q *Queries) error {
+		var err error
+		result = GetStatementTxResult{}
+
+		result.Account, err = q.GetAccount(ctx, arg.AccountID)
+		if err != nil {
+			return err
+		}
+
+		result.OpeningBalance, err = q.GetBalanceBefore(ctx, GetBalanceBeforeParams{
+			AccountID: arg.AccountID,
+			CreatedAt: arg.StartTime,
+		})
+		if err != nil {
+			return err
+		}
+
+		balance := result.OpeningBalance
+		result.Lines = []StatementLine{}
+		after := ListStatementEntriesRow{CreatedAt: arg.StartTime}
+		for {
+			entries, err := q.ListStatementEntries(ctx, ListStatementEntriesParams{
+				AccountID:      arg.AccountID,
+				StartTime:      arg.StartTime,
+				EndTime:        arg.EndTime,
+				AfterCreatedAt: after.CreatedAt,
+				AfterID:        after.ID,
+				LimitCount:     statementEntriesBatchSize,
+			})
+			if err != nil {
+				return err
+			}
+
+			for _, entry := range entries {
+				balance += entry.Amount
+				result.Lines = append(result.Lines, StatementLine{ListStatementEntriesRow: entry, Balance: balance})
+			}
+
+			if arg.MaxLines > 0 && len(result.Lines) > arg.MaxLines {
+				return fmt.Errorf("%w: account [%d] has more than %d entries from %s to %s",
+					ErrStatementTooLong, arg.AccountID, arg.MaxLines, arg.StartTime.Format(time.RFC3339), arg.EndTime.Format(time.RFC3339))
+			}
+
+			if len(entries) < statementEntriesBatchSize {
+				break
+			}
+			after = entries[len(entries)-1]
+		}
+
+		result.ClosingBalance = balance
+		return nil
+	})
+
+	return result, err
+}
@@ -1,11 +1,35 @@
 package db
 
-import "context"
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/lib/pq"
+	"github.com/tgfukuda/be-master/fx"
+)
+
+// constraint defined in the migration to keep the balance above -overdraft_limit
+const balanceOverdraftConstraint = "balance_within_overdraft_limit"
+
+// BankUsername owns the exchange accounts. it's created by the migration and can't be registered by the users.
+const BankUsername = "simple-bank"
+
+var (
+	ErrInsufficientFunds    = errors.New("insufficient funds")
+	ErrIdempotencyKeyReused = errors.New("idempotency key is already used for a different request")
+	ErrTransferTooSmall     = errors.New("transfer is too small to move any money in the currency of the receiver")
+)
 
 type TransferTxParams struct {
-	FromAccountID int64 `json:"from_account_id"`
-	ToAccountID   int64 `json:"to_account_id"`
-	Amount        int64 `json:"amount"`
+	FromAccountID  int64  `json:"from_account_id"`
+	ToAccountID    int64  `json:"to_account_id"`
+	Amount         int64  `json:"amount"`
+	IdempotencyKey string `json:"idempotency_key"` // optional. the same key returns the first result without moving money again
 }
 
 type TransferTxResult struct {
@@ -14,56 +38,294 @@ type TransferTxResult struct {
 	ToAccount   Account  `json:"to_account"`
 	FromEntry   Entry    `json:"from_entry"`
 	ToEntry     Entry    `json:"to_entry"`
+	Replayed    bool     `json:"-"` // true if the result is the saved one of the previous request with the same idempotency key
+}
+
+type CrossCurrencyTransferTxParams struct {
+	TransferTxParams                             // amount is in the currency of the from account
+	ExchangeRateProvider fx.ExchangeRateProvider // converts the amount into the currency of the to account
+}
+
+// requestHash identifies the payload bound to an idempotency key
+func (arg TransferTxParams) requestHash() string {
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%d:%d:%d", arg.FromAccountID, arg.ToAccountID, arg.Amount)))
+	return hex.EncodeToString(sum[:])
 }
 
+// TransferTx moves the amount as it is. the currencies of the accounts must be checked by the caller.
 func (store *SQLStore) TransferTx(ctx context.Context, arg TransferTxParams) (TransferTxResult, error) {
+	return store.transferTx(ctx, "TransferTx", arg, func(fromAccount Account, toAccount Account) (fx.Rate, error) {
+		return fx.Identity(), nil
+	})
+}
+
+// CrossCurrencyTransferTx converts the amount with the rate of the provider and
+// writes each entry in the currency of its account.
+func (store *SQLStore) CrossCurrencyTransferTx(ctx context.Context, arg CrossCurrencyTransferTxParams) (TransferTxResult, error) {
+	return store.transferTx(ctx, "CrossCurrencyTransferTx", arg.TransferTxParams, func(fromAccount Account, toAccount Account) (fx.Rate, error) {
+		return arg.ExchangeRateProvider.GetRate(ctx, fromAccount.Currency, toAccount.Currency)
+	})
+}
+
+func (store *SQLStore) transferTx(
+	ctx context.Context,
+	name string,
+	arg TransferTxParams,
+	rateOf func(fromAccount Account, toAccount Account) (fx.Rate, error),
+) (TransferTxResult, error) {
 	var result TransferTxResult
 
-	err := store.execTx(ctx, func(q *Queries) error {
+	// serializable to be safe from the anomalies of the concurrent transfers. the aborted ones are retried by execTx
+	err := store.execTx(ctx, name, TxOptions{Isolation: sql.LevelSerializable}, func(q *Queries) error {
 		var err error
+		result = TransferTxResult{}
 
-		result.Transfer, err = q.CreateTransfer(ctx, CreateTransferParams{
-			FromAccountID: arg.FromAccountID,
-			ToAccountID:   arg.ToAccountID,
-			Amount:        arg.Amount,
-		})
+		// lock both accounts in the same order as addMoney to avoid dead lock, then check the sender can afford it
+		fromAccount, toAccount, err := lockAccounts(ctx, q, arg.FromAccountID, arg.ToAccountID)
 		if err != nil {
 			return err
 		}
 
-		result.FromEntry, err = q.CreateEntry(ctx, CreateEntryParams{
-			AccountID: arg.FromAccountID,
-			Amount:    -arg.Amount, // move out from account
-		})
-		if err != nil {
+		if len(arg.IdempotencyKey) > 0 {
+			replayed, err := claimIdempotencyKey(ctx, q, fromAccount.Owner, arg, &result)
+			if err != nil || replayed {
+				return err
+			}
+		}
+
+		if err := checkAccountsActive(fromAccount, toAccount); err != nil {
 			return err
 		}
 
-		result.ToEntry, err = q.CreateEntry(ctx, CreateEntryParams{
-			AccountID: arg.ToAccountID,
-			Amount:    arg.Amount, // move in to account
-		})
+		if fromAccount.Balance-arg.Amount < -fromAccount.OverdraftLimit {
+			return fmt.Errorf("%w: account [%d] has balance %d with overdraft limit %d but %d requested",
+				ErrInsufficientFunds, fromAccount.ID, fromAccount.Balance, fromAccount.OverdraftLimit, arg.Amount)
+		}
+
+		rate, err := rateOf(fromAccount, toAccount)
 		if err != nil {
-			return err
+			return fmt.Errorf("failed to get exchange rate: %w", err)
 		}
 
-		// get account -> update account balance	- need to avoid dead lock
-		if arg.FromAccountID < arg.ToAccountID {
-			result.FromAccount, result.ToAccount, err = addMoney(ctx, q, arg.FromAccountID, -arg.Amount, arg.ToAccountID, arg.Amount)
-		} else {
-			result.ToAccount, result.FromAccount, err = addMoney(ctx, q, arg.ToAccountID, arg.Amount, arg.FromAccountID, -arg.Amount)
+		toAmount, err := rate.Convert(arg.Amount)
+		if err != nil {
+			return err
+		}
+		if toAmount <= 0 {
+			return fmt.Errorf("%w: %d %s is %d %s", ErrTransferTooSmall, arg.Amount, fromAccount.Currency, toAmount, toAccount.Currency)
 		}
 
+		result, err = postTransfer(ctx, q, fromAccount, toAccount, CreateTransferParams{
+			FromAccountID: arg.FromAccountID,
+			ToAccountID:   arg.ToAccountID,
+			Amount:        arg.Amount,
+			ToAmount:      toAmount,
+			ExchangeRate:  rate.StringFor(arg.Amount), // enough digits to give back to_amount
+		})
 		if err != nil {
 			return err
 		}
 
+		if len(arg.IdempotencyKey) > 0 {
+			return saveIdempotencyKeyResponse(ctx, q, fromAccount.Owner, arg.IdempotencyKey, result)
+		}
+
 		return nil
 	})
 
 	return result, err
 }
 
+// postTransfer writes the transfer, its journal and entries, and moves the balances.
+// both accounts must be locked by the caller. the journal id of arg is filled here.
+func postTransfer(ctx context.Context, q *Queries, fromAccount Account, toAccount Account, arg CreateTransferParams) (TransferTxResult, error) {
+	var result TransferTxResult
+
+	// the transfer and its entries are linked by the journal
+	journal, err := q.CreateJournal(ctx)
+	if err != nil {
+		return result, err
+	}
+	arg.JournalID = journal.ID
+
+	result.Transfer, err = q.CreateTransfer(ctx, arg)
+	if err != nil {
+		return result, err
+	}
+
+	result.FromEntry, err = q.CreateEntry(ctx, CreateEntryParams{
+		AccountID: arg.FromAccountID,
+		Amount:    -arg.Amount, // move out from account
+		JournalID: journal.ID,
+	})
+	if err != nil {
+		return result, err
+	}
+
+	result.ToEntry, err = q.CreateEntry(ctx, CreateEntryParams{
+		AccountID: arg.ToAccountID,
+		Amount:    arg.ToAmount, // move in to account
+		JournalID: journal.ID,
+	})
+	if err != nil {
+		return result, err
+	}
+
+	// get account -> update account balance	- need to avoid dead lock
+	if arg.FromAccountID < arg.ToAccountID {
+		result.FromAccount, result.ToAccount, err = addMoney(ctx, q, arg.FromAccountID, -arg.Amount, arg.ToAccountID, arg.ToAmount)
+	} else {
+		result.ToAccount, result.FromAccount, err = addMoney(ctx, q, arg.ToAccountID, arg.ToAmount, arg.FromAccountID, -arg.Amount)
+	}
+
+	if err != nil {
+		// the check constraint is the last line of defence
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Constraint == balanceOverdraftConstraint {
+			return result, fmt.Errorf("%w: %s", ErrInsufficientFunds, pqErr.Message)
+		}
+		return result, err
+	}
+
+	if fromAccount.Currency != toAccount.Currency {
+		err = postExchange(ctx, q, journal.ID, fromAccount.Currency, arg.Amount, toAccount.Currency, arg.ToAmount)
+		if err != nil {
+			return result, err
+		}
+	}
+
+	return result, nil
+}
+
+// lockAccounts acquires row locks on both accounts in the ascending order of id.
+func lockAccounts(ctx context.Context, q *Queries, fromAccountID int64, toAccountID int64) (fromAccount Account, toAccount Account, err error) {
+	if fromAccountID < toAccountID {
+		fromAccount, err = q.GetAccountForUpdate(ctx, fromAccountID)
+		if err != nil {
+			return
+		}
+		toAccount, err = q.GetAccountForUpdate(ctx, toAccountID)
+		return
+	}
+
+	toAccount, err = q.GetAccountForUpdate(ctx, toAccountID)
+	if err != nil {
+		return
+	}
+	fromAccount, err = q.GetAccountForUpdate(ctx, fromAccountID)
+	return
+}
+
+// claimIdempotencyKey reserves the key for this transfer. if the key is already used by the same request,
+// the saved result is loaded into result and replayed is true.
+// a concurrent request with the same key waits on the unique index until the first one commits or rolls back.
+func claimIdempotencyKey(ctx context.Context, q *Queries, owner string, arg TransferTxParams, result *TransferTxResult) (replayed bool, err error) {
+	requestHash := arg.requestHash()
+
+	_, err = q.CreateIdempotencyKey(ctx, CreateIdempotencyKeyParams{
+		Username:       owner,
+		IdempotencyKey: arg.IdempotencyKey,
+		RequestHash:    requestHash,
+	})
+	if err == nil {
+		return false, nil
+	}
+	if err != sql.ErrNoRows { // ErrNoRows means conflict
+		return false, err
+	}
+
+	saved, err := q.GetIdempotencyKey(ctx, GetIdempotencyKeyParams{
+		Username:       owner,
+		IdempotencyKey: arg.IdempotencyKey,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	if saved.RequestHash != requestHash {
+		return false, fmt.Errorf("%w: %s", ErrIdempotencyKeyReused, arg.IdempotencyKey)
+	}
+
+	if err := json.Unmarshal(saved.Response, result); err != nil {
+		return false, fmt.Errorf("failed to unmarshal saved response: %w", err)
+	}
+	result.Replayed = true
+
+	return true, nil
+}
+
+func saveIdempotencyKeyResponse(ctx context.Context, q *Queries, owner string, idempotencyKey string, result TransferTxResult) error {
+	response, err := json.Marshal(result)
+	if err != nil {
+		return fmt.Errorf("failed to marshal response: %w", err)
+	}
+
+	_, err = q.UpdateIdempotencyKeyResponse(ctx, UpdateIdempotencyKeyResponseParams{
+		TransferID: sql.NullInt64{
+			Int64: result.Transfer.ID,
+			Valid: true,
+		},
+		Response:       response,
+		Username:       owner,
+		IdempotencyKey: idempotencyKey,
+	})
+	return err
+}
+
+// postExchange writes the other side of a currency exchange to the exchange accounts of the bank,
+// so that the journal nets to zero in each currency.
+// the bank receives the amount in the from currency and pays toAmount in the to currency.
+func postExchange(ctx context.Context, q *Queries, journalID int64, fromCurrency string, amount int64, toCurrency string, toAmount int64) error {
+	fromExchange, err := exchangeAccount(ctx, q, fromCurrency)
+	if err != nil {
+		return err
+	}
+
+	toExchange, err := exchangeAccount(ctx, q, toCurrency)
+	if err != nil {
+		return err
+	}
+
+	_, err = q.CreateEntry(ctx, CreateEntryParams{
+		AccountID: fromExchange.ID,
+		Amount:    amount,
+		JournalID: journalID,
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = q.CreateEntry(ctx, CreateEntryParams{
+		AccountID: toExchange.ID,
+		Amount:    -toAmount,
+		JournalID: journalID,
+	})
+	if err != nil {
+		return err
+	}
+
+	if fromExchange.ID < toExchange.ID {
+		_, _, err = addMoney(ctx, q, fromExchange.ID, amount, toExchange.ID, -toAmount)
+	} else {
+		_, _, err = addMoney(ctx, q, toExchange.ID, -toAmount, fromExchange.ID, amount)
+	}
+	return err
+}
+
+// exchangeAccount returns the account of the bank in the currency. it's created on the first exchange.
+func exchangeAccount(ctx context.Context, q *Queries, currency string) (Account, error) {
+	account, err := q.GetAccountByCurrency(ctx, GetAccountByCurrencyParams{
+		Owner:    BankUsername,
+		Currency: currency,
+	})
+	if err == sql.ErrNoRows {
+		return q.CreateExchangeAccount(ctx, CreateExchangeAccountParams{
+			Owner:    BankUsername,
+			Currency: currency,
+		})
+	}
+	return account, err
+}
+
 func addMoney(ctx context.Context, q *Queries, accountId1 int64, amount1 int64, accountId2 int64, amount2 int64) (account1 Account, account2 Account, err error) {
 	account1, err = q.AddAccountBalance(ctx, AddAccountBalanceParams{
 		ID:     accountId1,
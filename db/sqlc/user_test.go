@@ -28,6 +28,7 @@ func createRandUser(t *testing.T) User {
 	assert.Equal(t, arg.HashedPassword, user.HashedPassword)
 	assert.Equal(t, arg.FullName, user.FullName)
 	assert.Equal(t, arg.Email, user.Email)
+	assert.Equal(t, util.DepositorRole, user.Role)
 
 	assert.True(t, user.PasswordChangedAt.IsZero())
 	assert.NotZero(t, user.CreatedAt)
@@ -148,3 +149,18 @@ func TestUpdateUserAll(t *testing.T) {
 	assert.Equal(t, newFullName, updated.FullName)
 	assert.Equal(t, newEmail, updated.Email)
 }
+
+func TestUpdateUserRole(t *testing.T) {
+	oldUser := createRandUser(t)
+
+	updatedUser, err := testQueries.UpdateUser(context.Background(), UpdateUserParams{
+		Username: oldUser.Username,
+		Role: sql.NullString{
+			String: util.BankerRole,
+			Valid:  true,
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, util.BankerRole, updatedUser.Role)
+	assert.Equal(t, oldUser.Email, updatedUser.Email)
+}
@@ -9,7 +9,8 @@ CREATE TABLE "users" (
   "email" varchar UNIQUE NOT NULL,
   "is_email_verified" bool NOT NULL DEFAULT false,
   "password_changed_at" timestamptz NOT NULL DEFAULT '0001-01-01',
-  "created_at" timestamptz NOT NULL DEFAULT (now())
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "role" varchar NOT NULL DEFAULT 'depositor'
 );
 
 CREATE TABLE "verify_emails" (
@@ -22,11 +23,31 @@ CREATE TABLE "verify_emails" (
   "expired_at" timestamptz NOT NULL DEFAULT (now() + interval '15 minutes')
 );
 
+CREATE TABLE "sessions" (
+  "id" uuid PRIMARY KEY,
+  "username" varchar NOT NULL,
+  "refresh_token" varchar NOT NULL,
+  "user_agent" varchar NOT NULL,
+  "client_ip" varchar NOT NULL,
+  "is_blocked" boolean NOT NULL DEFAULT false,
+  "expired_at" timestamptz NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "family_id" uuid NOT NULL,
+  "is_rotated" boolean NOT NULL DEFAULT false
+);
+
 CREATE TABLE "accounts" (
   "id" bigserial PRIMARY KEY,
   "owner" varchar NOT NULL,
   "balance" bigint NOT NULL,
   "currency" varchar NOT NULL,
+  "overdraft_limit" bigint NOT NULL DEFAULT 0,
+  "status" varchar NOT NULL DEFAULT 'active',
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "journals" (
+  "id" bigserial PRIMARY KEY,
   "created_at" timestamptz NOT NULL DEFAULT (now())
 );
 
@@ -34,6 +55,7 @@ CREATE TABLE "entries" (
   "id" bigserial PRIMARY KEY,
   "account_id" bigint NOT NULL,
   "amount" bigint NOT NULL,
+  "journal_id" bigint NOT NULL,
   "created_at" timestamptz NOT NULL DEFAULT (now())
 );
 
@@ -42,27 +64,204 @@ CREATE TABLE "transfers" (
   "from_account_id" bigint NOT NULL,
   "to_account_id" bigint NOT NULL,
   "amount" bigint NOT NULL,
+  "to_amount" bigint NOT NULL,
+  "exchange_rate" numeric NOT NULL DEFAULT 1,
+  "journal_id" bigint UNIQUE NOT NULL,
+  "reversal_of" bigint,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "idempotency_keys" (
+  "username" varchar NOT NULL,
+  "idempotency_key" varchar NOT NULL,
+  "request_hash" varchar NOT NULL,
+  "transfer_id" bigint,
+  "response" jsonb NOT NULL DEFAULT '{}',
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  PRIMARY KEY ("username", "idempotency_key")
+);
+
+CREATE TABLE "reconciliation_reports" (
+  "id" bigserial PRIMARY KEY,
+  "account_id" bigint NOT NULL,
+  "balance" bigint NOT NULL,
+  "entries_total" bigint NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "scheduled_transfers" (
+  "id" bigserial PRIMARY KEY,
+  "owner" varchar NOT NULL,
+  "from_account_id" bigint NOT NULL,
+  "to_account_id" bigint NOT NULL,
+  "amount" bigint NOT NULL,
+  "currency" varchar NOT NULL,
+  "schedule" varchar NOT NULL,
+  "status" varchar NOT NULL DEFAULT 'active',
+  "next_run_at" timestamptz NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "scheduled_transfer_runs" (
+  "id" bigserial PRIMARY KEY,
+  "scheduled_transfer_id" bigint NOT NULL,
+  "scheduled_at" timestamptz NOT NULL,
+  "status" varchar NOT NULL,
+  "transfer_id" bigint,
+  "error" varchar NOT NULL DEFAULT '',
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "outbox" (
+  "id" bigserial PRIMARY KEY,
+  "task_type" varchar NOT NULL,
+  "payload" jsonb NOT NULL,
+  "queue" varchar NOT NULL,
+  "max_retry" integer NOT NULL,
+  "process_in_seconds" integer NOT NULL DEFAULT 0,
+  "attempts" integer NOT NULL DEFAULT 0,
+  "last_error" varchar,
+  "next_attempt_at" timestamptz NOT NULL DEFAULT (now()),
+  "sent_at" timestamptz,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "account_status_events" (
+  "id" bigserial PRIMARY KEY,
+  "account_id" bigint NOT NULL,
+  "from_status" varchar NOT NULL,
+  "to_status" varchar NOT NULL,
+  "reason" varchar NOT NULL DEFAULT '',
+  "actor" varchar NOT NULL,
   "created_at" timestamptz NOT NULL DEFAULT (now())
 );
 
+CREATE INDEX ON "sessions" ("family_id");
+
+CREATE INDEX ON "sessions" ("username");
+
 CREATE INDEX ON "accounts" ("owner");
 
+CREATE INDEX ON "accounts" ("owner", "created_at", "id");
+
 CREATE INDEX ON "entries" ("account_id");
 
+CREATE INDEX ON "entries" ("journal_id");
+
+CREATE INDEX ON "entries" ("account_id", "created_at", "id");
+
 CREATE INDEX ON "transfers" ("from_account_id");
 
 CREATE INDEX ON "transfers" ("to_account_id");
 
 CREATE INDEX ON "transfers" ("from_account_id", "to_account_id");
 
+CREATE INDEX ON "transfers" ("reversal_of");
+
+CREATE INDEX ON "transfers" ("from_account_id", "created_at", "id");
+
+CREATE INDEX ON "transfers" ("to_account_id", "created_at", "id");
+
+CREATE INDEX ON "reconciliation_reports" ("account_id");
+
+CREATE INDEX ON "scheduled_transfers" ("owner");
+
+CREATE INDEX ON "scheduled_transfers" ("status", "next_run_at");
+
+CREATE INDEX ON "scheduled_transfers" ("owner", "created_at", "id");
+
+CREATE UNIQUE INDEX ON "scheduled_transfer_runs" ("scheduled_transfer_id", "scheduled_at");
+
+CREATE INDEX ON "scheduled_transfer_runs" ("scheduled_transfer_id", "created_at", "id");
+
+CREATE INDEX ON "outbox" ("next_attempt_at");
+
+CREATE INDEX ON "account_status_events" ("account_id", "id");
+
+COMMENT ON COLUMN "users"."role" IS 'depositor, banker or admin';
+
+COMMENT ON COLUMN "sessions"."family_id" IS 'id of the session created at login. shared by all the sessions rotated from it';
+
+COMMENT ON COLUMN "sessions"."is_rotated" IS 'the refresh token is already exchanged. presenting it again blocks the family';
+
+COMMENT ON COLUMN "accounts"."overdraft_limit" IS 'must be positive. the balance can not be less than -overdraft_limit';
+
+COMMENT ON COLUMN "accounts"."status" IS 'active, frozen or closed. only active accounts can send or receive money';
+
+COMMENT ON TABLE "entries" IS 'immutable. updated or deleted rows are rejected by a trigger in the migration';
+
 COMMENT ON COLUMN "entries"."amount" IS 'can be negative and positive';
 
-COMMENT ON COLUMN "transfers"."amount" IS 'can be negative and positive';
+COMMENT ON COLUMN "entries"."journal_id" IS 'the entries of a journal net to zero in each currency';
+
+COMMENT ON COLUMN "transfers"."amount" IS 'must be positive. in the currency of the from account';
+
+COMMENT ON COLUMN "transfers"."to_amount" IS 'must be positive. in the currency of the to account';
+
+COMMENT ON COLUMN "transfers"."exchange_rate" IS 'to_amount = amount * exchange_rate rounded toward zero';
+
+COMMENT ON COLUMN "transfers"."reversal_of" IS 'the original transfer refunded by this one. the sum of the refunds never exceeds its amount';
+
+COMMENT ON COLUMN "idempotency_keys"."request_hash" IS 'hash of the request to reject the reuse of the key with a different payload';
+
+COMMENT ON COLUMN "idempotency_keys"."response" IS 'the result returned to the first request';
+
+COMMENT ON COLUMN "reconciliation_reports"."balance" IS 'the balance of the account at the reconciliation';
+
+COMMENT ON COLUMN "reconciliation_reports"."entries_total" IS 'the sum of the entries of the account at the reconciliation';
+
+COMMENT ON COLUMN "scheduled_transfers"."amount" IS 'must be positive';
+
+COMMENT ON COLUMN "scheduled_transfers"."currency" IS 'the currency of both accounts';
+
+COMMENT ON COLUMN "scheduled_transfers"."schedule" IS 'cron spec in UTC or interval, e.g. "0 9 1 * *" or "@every 24h"';
+
+COMMENT ON COLUMN "scheduled_transfers"."status" IS 'active, paused or cancelled';
+
+COMMENT ON COLUMN "scheduled_transfer_runs"."scheduled_at" IS 'the due time of the run. a scheduled transfer runs at most once for it';
+
+COMMENT ON COLUMN "scheduled_transfer_runs"."status" IS 'succeeded, insufficient_funds, currency_mismatch or failed';
+
+COMMENT ON COLUMN "outbox"."process_in_seconds" IS 'delay of the task counted from the relay';
+
+COMMENT ON COLUMN "outbox"."next_attempt_at" IS 'the relay backs off when the task queue is unavailable';
+
+COMMENT ON COLUMN "outbox"."sent_at" IS 'null until the task is enqueued';
+
+COMMENT ON COLUMN "account_status_events"."actor" IS 'the user who changed the status';
 
 ALTER TABLE "verify_emails" ADD FOREIGN KEY ("username") REFERENCES "users" ("username");
 
+ALTER TABLE "sessions" ADD FOREIGN KEY ("username") REFERENCES "users" ("username");
+
 ALTER TABLE "entries" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
 
+ALTER TABLE "entries" ADD FOREIGN KEY ("journal_id") REFERENCES "journals" ("id");
+
+ALTER TABLE "transfers" ADD FOREIGN KEY ("journal_id") REFERENCES "journals" ("id");
+
 ALTER TABLE "transfers" ADD FOREIGN KEY ("from_account_id") REFERENCES "accounts" ("id");
 
 ALTER TABLE "transfers" ADD FOREIGN KEY ("to_account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "transfers" ADD FOREIGN KEY ("reversal_of") REFERENCES "transfers" ("id");
+
+ALTER TABLE "idempotency_keys" ADD FOREIGN KEY ("username") REFERENCES "users" ("username");
+
+ALTER TABLE "idempotency_keys" ADD FOREIGN KEY ("transfer_id") REFERENCES "transfers" ("id");
+
+ALTER TABLE "reconciliation_reports" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "scheduled_transfers" ADD FOREIGN KEY ("owner") REFERENCES "users" ("username");
+
+ALTER TABLE "scheduled_transfers" ADD FOREIGN KEY ("from_account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "scheduled_transfers" ADD FOREIGN KEY ("to_account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "scheduled_transfer_runs" ADD FOREIGN KEY ("scheduled_transfer_id") REFERENCES "scheduled_transfers" ("id");
+
+ALTER TABLE "scheduled_transfer_runs" ADD FOREIGN KEY ("transfer_id") REFERENCES "transfers" ("id");
+
+ALTER TABLE "account_status_events" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "account_status_events" ADD FOREIGN KEY ("actor") REFERENCES "users" ("username");
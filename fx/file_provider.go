@@ -0,0 +1,35 @@
+package fx
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// NewFileExchangeRateProvider loads "FROM/TO" keyed rates from a json file like
+//
+//	{"USD/JPY": "149.25", "USD/EUR": "0.92"}
+//
+// the file is read once, so create a new provider to pick up changes.
+func NewFileExchangeRateProvider(path string) (ExchangeRateProvider, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read exchange rate file: %w", err)
+	}
+
+	var table map[string]string
+	if err := json.Unmarshal(data, &table); err != nil {
+		return nil, fmt.Errorf("cannot parse exchange rate file: %w", err)
+	}
+
+	return NewStaticExchangeRateProvider(table)
+}
+
+// NewExchangeRateProvider loads the rates from the file if the path is given, otherwise uses DefaultRates.
+func NewExchangeRateProvider(path string) (ExchangeRateProvider, error) {
+	if len(path) > 0 {
+		return NewFileExchangeRateProvider(path)
+	}
+
+	return NewStaticExchangeRateProvider(DefaultRates)
+}
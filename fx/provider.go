@@ -0,0 +1,108 @@
+package fx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+var (
+	ErrRateNotFound = errors.New("exchange rate not found")
+	ErrInvalidRate  = errors.New("exchange rate is invalid")
+)
+
+// exchange rate source. rates are quoted in the unit the balances are stored in.
+type ExchangeRateProvider interface {
+	GetRate(ctx context.Context, from string, to string) (Rate, error)
+}
+
+// Rate is an exact decimal rate. 1 unit of the from currency is converted to Rate units of the to currency.
+type Rate struct {
+	value *big.Rat
+}
+
+// digits kept when the rate is formatted
+const rateScale = 10
+
+func ParseRate(s string) (Rate, error) {
+	value, ok := new(big.Rat).SetString(strings.TrimSpace(s))
+	if !ok || value.Sign() <= 0 {
+		return Rate{}, fmt.Errorf("%w: %q", ErrInvalidRate, s)
+	}
+
+	return Rate{value: value}, nil
+}
+
+func MustParseRate(s string) Rate {
+	rate, err := ParseRate(s)
+	if err != nil {
+		panic(err)
+	}
+	return rate
+}
+
+// rate between the same currency
+func Identity() Rate {
+	return Rate{value: big.NewRat(1, 1)}
+}
+
+func (rate Rate) Inverse() Rate {
+	return Rate{value: new(big.Rat).Inv(rate.value)}
+}
+
+// Convert converts the amount and rounds it toward zero so the bank never pays more than the rate.
+func (rate Rate) Convert(amount int64) (int64, error) {
+	converted := new(big.Rat).Mul(new(big.Rat).SetInt64(amount), rate.value)
+	quo := new(big.Int).Quo(converted.Num(), converted.Denom())
+	if !quo.IsInt64() {
+		return 0, fmt.Errorf("converted amount overflows: %s", quo)
+	}
+
+	return quo.Int64(), nil
+}
+
+// String formats the rate as a decimal without trailing zeros, e.g. "149.25".
+func (rate Rate) String() string {
+	if rate.value == nil {
+		return "0"
+	}
+
+	s := rate.value.FloatString(rateScale)
+	s = strings.TrimRight(s, "0")
+	return strings.TrimSuffix(s, ".")
+}
+
+// StringFor formats the rate with enough digits to give back the converted amount of the amount,
+// e.g. 1/3 is "0.3333333333334" for 1000000000000 whose converted amount is 333333333333,
+// while 1000000000000 * "0.3333333333" of String would be 333333333300.
+// the digits are rounded up and a rate with a short decimal is kept as it is.
+func (rate Rate) StringFor(amount int64) string {
+	if rate.value == nil {
+		return "0"
+	}
+
+	// amount * rate is a multiple of 1 / denom, so it's at least 1 / denom below the next integer.
+	// rounding up the rate by less than 1 / (amount * denom) doesn't change the converted amount.
+	bound := new(big.Int).Mul(new(big.Int).Abs(big.NewInt(amount)), rate.value.Denom())
+	scale := len(bound.String())
+	if scale < rateScale {
+		scale = rateScale
+	}
+
+	unit := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(scale)), nil)
+	scaled := new(big.Int).Mul(rate.value.Num(), unit)
+	digits, rem := new(big.Int).QuoRem(scaled, rate.value.Denom(), new(big.Int))
+	if rem.Sign() > 0 {
+		digits.Add(digits, big.NewInt(1))
+	}
+
+	s := digits.String()
+	if len(s) <= scale {
+		s = strings.Repeat("0", scale-len(s)+1) + s
+	}
+	s = s[:len(s)-scale] + "." + s[len(s)-scale:]
+	s = strings.TrimRight(s, "0")
+	return strings.TrimSuffix(s, ".")
+}
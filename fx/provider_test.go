@@ -0,0 +1,104 @@
+package fx
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tgfukuda/be-master/util"
+)
+
+func TestRate(t *testing.T) {
+	rate, err := ParseRate("149.25")
+	assert.NoError(t, err)
+	assert.Equal(t, "149.25", rate.String())
+
+	converted, err := rate.Convert(100)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(14925), converted)
+
+	// rounded toward zero
+	converted, err = rate.Inverse().Convert(100)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), converted)
+
+	converted, err = rate.Inverse().Convert(14925)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(100), converted)
+
+	_, err = MustParseRate("10").Convert(1 << 62)
+	assert.Error(t, err)
+
+	// the stored digits give back the converted amount
+	third := MustParseRate("1/3")
+	assert.Equal(t, "0.3333333333", third.String())
+	assert.Equal(t, "0.3333333334", third.StringFor(1000))
+	for _, amount := range []int64{1, 3, 300, 1000, 1_000_000_000_000, 999_999_999_999_999} {
+		converted, err := third.Convert(amount)
+		assert.NoError(t, err)
+
+		stored := MustParseRate(third.StringFor(amount))
+		reproduced, err := stored.Convert(amount)
+		assert.NoError(t, err)
+		assert.Equal(t, converted, reproduced, "amount %d", amount)
+	}
+	assert.Equal(t, "149.25", rate.StringFor(1_000_000_000_000))
+
+	for _, invalid := range []string{"", "abc", "0", "-1"} {
+		_, err := ParseRate(invalid)
+		assert.ErrorIs(t, err, ErrInvalidRate)
+	}
+}
+
+func TestStaticExchangeRateProvider(t *testing.T) {
+	provider, err := NewStaticExchangeRateProvider(map[string]string{
+		"USD/JPY": "150",
+		"JPY/EUR": "0.006",
+		"EUR/JPY": "160",
+	})
+	assert.NoError(t, err)
+
+	rate, err := provider.GetRate(context.Background(), util.USD, util.JPY)
+	assert.NoError(t, err)
+	assert.Equal(t, "150", rate.String())
+
+	// derived inverse
+	rate, err = provider.GetRate(context.Background(), util.JPY, util.USD)
+	assert.NoError(t, err)
+	converted, err := rate.Convert(300)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), converted)
+
+	// explicit inverse is kept
+	rate, err = provider.GetRate(context.Background(), util.EUR, util.JPY)
+	assert.NoError(t, err)
+	assert.Equal(t, "160", rate.String())
+
+	rate, err = provider.GetRate(context.Background(), util.EUR, util.EUR)
+	assert.NoError(t, err)
+	assert.Equal(t, "1", rate.String())
+
+	_, err = provider.GetRate(context.Background(), util.USD, util.EUR)
+	assert.ErrorIs(t, err, ErrRateNotFound)
+
+	_, err = NewStaticExchangeRateProvider(map[string]string{"USD-JPY": "150"})
+	assert.Error(t, err)
+}
+
+func TestFileExchangeRateProvider(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "rates.json")
+	err := os.WriteFile(path, []byte(`{"USD/EUR": "0.5"}`), 0o600)
+	assert.NoError(t, err)
+
+	provider, err := NewFileExchangeRateProvider(path)
+	assert.NoError(t, err)
+
+	rate, err := provider.GetRate(context.Background(), util.EUR, util.USD)
+	assert.NoError(t, err)
+	assert.Equal(t, "2", rate.String())
+
+	_, err = NewFileExchangeRateProvider(filepath.Join(t.TempDir(), "missing.json"))
+	assert.Error(t, err)
+}
@@ -0,0 +1,76 @@
+package fx
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/tgfukuda/be-master/util"
+)
+
+// rates shipped with the server when no rate file is configured
+var DefaultRates = map[string]string{
+	util.USD + "/" + util.EUR: "0.92",
+	util.USD + "/" + util.JPY: "149.25",
+	util.EUR + "/" + util.JPY: "162.5",
+}
+
+type StaticExchangeRateProvider struct {
+	rates map[string]Rate
+}
+
+// NewStaticExchangeRateProvider creates a provider from "FROM/TO" keyed rates.
+// the inverse pair is derived when it is not given explicitly.
+func NewStaticExchangeRateProvider(table map[string]string) (ExchangeRateProvider, error) {
+	rates := make(map[string]Rate, len(table)*2)
+
+	for pair, value := range table {
+		from, to, err := splitPair(pair)
+		if err != nil {
+			return nil, err
+		}
+
+		rate, err := ParseRate(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid rate of %s: %w", pair, err)
+		}
+
+		rates[pairKey(from, to)] = rate
+	}
+
+	// derive inverse after all the explicit ones are loaded so they take precedence
+	for key, rate := range rates {
+		from, to, _ := splitPair(key)
+		if _, ok := rates[pairKey(to, from)]; !ok {
+			rates[pairKey(to, from)] = rate.Inverse()
+		}
+	}
+
+	return &StaticExchangeRateProvider{rates: rates}, nil
+}
+
+func (provider *StaticExchangeRateProvider) GetRate(ctx context.Context, from string, to string) (Rate, error) {
+	if from == to {
+		return Identity(), nil
+	}
+
+	rate, ok := provider.rates[pairKey(from, to)]
+	if !ok {
+		return Rate{}, fmt.Errorf("%w: %s", ErrRateNotFound, pairKey(from, to))
+	}
+
+	return rate, nil
+}
+
+func pairKey(from string, to string) string {
+	return from + "/" + to
+}
+
+func splitPair(pair string) (from string, to string, err error) {
+	currencies := strings.Split(pair, "/")
+	if len(currencies) != 2 || !util.IsSupportedCurrency(currencies[0]) || !util.IsSupportedCurrency(currencies[1]) {
+		return "", "", fmt.Errorf("invalid currency pair: %q", pair)
+	}
+
+	return currencies[0], currencies[1], nil
+}
@@ -5,8 +5,13 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/tgfukuda/be-master/pb"
 	"github.com/tgfukuda/be-master/token"
+	"github.com/tgfukuda/be-master/util"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 )
 
 const (
@@ -14,7 +19,135 @@ const (
 	authorizationBearer = "bearer"
 )
 
-func (server *Server) authorizeUser(ctx context.Context) (*token.Payload, error) {
+// accessPolicy decides who can call a method
+type accessPolicy struct {
+	public bool     // callable without an access token
+	roles  []string // roles of the access token allowed to call the method
+}
+
+var (
+	publicAccess        = accessPolicy{public: true}
+	authenticatedAccess = accessPolicy{roles: util.AllRoles}
+	adminAccess         = accessPolicy{roles: []string{util.AdminRole}}
+	bankerAccess        = accessPolicy{roles: []string{util.BankerRole}}
+)
+
+// methodPolicies has every method registered to the grpc server. a method missing here is rejected.
+var methodPolicies = map[string]accessPolicy{
+	pb.SimpleBank_CreateUser_FullMethodName:                publicAccess,
+	pb.SimpleBank_LoginUser_FullMethodName:                 publicAccess,
+	pb.SimpleBank_UpdateUser_FullMethodName:                authenticatedAccess,
+	pb.SimpleBank_VerifyEmail_FullMethodName:               publicAccess,
+	pb.SimpleBank_CreateAccount_FullMethodName:             authenticatedAccess,
+	pb.SimpleBank_GetAccount_FullMethodName:                authenticatedAccess,
+	pb.SimpleBank_ListAccounts_FullMethodName:              authenticatedAccess,
+	pb.SimpleBank_DeleteAccount_FullMethodName:             authenticatedAccess,
+	pb.SimpleBank_CloseAccount_FullMethodName:              authenticatedAccess,
+	pb.SimpleBank_ReopenAccount_FullMethodName:             authenticatedAccess,
+	pb.SimpleBank_FreezeAccount_FullMethodName:             bankerAccess,
+	pb.SimpleBank_UnfreezeAccount_FullMethodName:           bankerAccess,
+	pb.SimpleBank_CreateTransfer_FullMethodName:            authenticatedAccess,
+	pb.SimpleBank_ReverseTransfer_FullMethodName:           authenticatedAccess,
+	pb.SimpleBank_ListMyTransfers_FullMethodName:           authenticatedAccess,
+	pb.SimpleBank_GetStatement_FullMethodName:              authenticatedAccess,
+	pb.SimpleBank_RenewAccessToken_FullMethodName:          publicAccess, // authenticated by the refresh token in the request
+	pb.SimpleBank_Logout_FullMethodName:                    publicAccess, // authenticated by the refresh token in the request
+	pb.SimpleBank_LogoutAllSessions_FullMethodName:         authenticatedAccess,
+	pb.SimpleBank_ListReconciliationReports_FullMethodName: adminAccess,
+	pb.SimpleBank_CreateScheduledTransfer_FullMethodName:   authenticatedAccess,
+	pb.SimpleBank_ListScheduledTransfers_FullMethodName:    authenticatedAccess,
+	pb.SimpleBank_ListScheduledTransferRuns_FullMethodName: authenticatedAccess,
+	pb.SimpleBank_PauseScheduledTransfer_FullMethodName:    authenticatedAccess,
+	pb.SimpleBank_ResumeScheduledTransfer_FullMethodName:   authenticatedAccess,
+	pb.SimpleBank_CancelScheduledTransfer_FullMethodName:   authenticatedAccess,
+	pb.SimpleBank_ListEntries_FullMethodName:               authenticatedAccess,
+	pb.SimpleBank_ListAccountTransfers_FullMethodName:      authenticatedAccess,
+
+	// probes of the load balancers and kubernetes
+	"/grpc.health.v1.Health/Check": publicAccess,
+	"/grpc.health.v1.Health/Watch": publicAccess,
+
+	// registered by reflection.Register
+	"/grpc.reflection.v1alpha.ServerReflection/ServerReflectionInfo": publicAccess,
+}
+
+type authPayloadKey struct{}
+
+// authPayloadFromContext returns the payload of the access token verified by the auth interceptor
+func authPayloadFromContext(ctx context.Context) (*token.Payload, error) {
+	payload, ok := ctx.Value(authPayloadKey{}).(*token.Payload)
+	if !ok {
+		return nil, fmt.Errorf("missing access token payload")
+	}
+
+	return payload, nil
+}
+
+// AuthInterceptor authorizes unary calls with the policy of the method
+func (server *Server) AuthInterceptor(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (resp interface{}, err error) {
+	ctx, err = server.authorize(ctx, info.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+
+	return handler(ctx, req)
+}
+
+// StreamAuthInterceptor authorizes streaming calls with the policy of the method
+func (server *Server) StreamAuthInterceptor(
+	srv interface{},
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	ctx, err := server.authorize(ss.Context(), info.FullMethod)
+	if err != nil {
+		return err
+	}
+
+	return handler(srv, &authServerStream{ServerStream: ss, ctx: ctx})
+}
+
+// authServerStream replaces the context of the stream with the authorized one
+type authServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (stream *authServerStream) Context() context.Context {
+	return stream.ctx
+}
+
+// authorize checks the access token against the policy of the method and stores the payload in the context
+func (server *Server) authorize(ctx context.Context, method string) (context.Context, error) {
+	policy, ok := methodPolicies[method]
+	if !ok {
+		return nil, status.Errorf(codes.PermissionDenied, "no access policy for %s", method)
+	}
+
+	if policy.public {
+		return ctx, nil
+	}
+
+	payload, err := server.authenticate(ctx)
+	if err != nil {
+		return nil, unauthorizedError(err)
+	}
+
+	if err := payload.CheckRole(policy.roles); err != nil {
+		return nil, unauthorizedError(err)
+	}
+
+	return context.WithValue(ctx, authPayloadKey{}, payload), nil
+}
+
+// authenticate verifies the bearer access token in the metadata
+func (server *Server) authenticate(ctx context.Context) (*token.Payload, error) {
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
 		return nil, fmt.Errorf("missing metadata")
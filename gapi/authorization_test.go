@@ -0,0 +1,258 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/tgfukuda/be-master/mocks"
+	"github.com/tgfukuda/be-master/pb"
+	"github.com/tgfukuda/be-master/token"
+	"github.com/tgfukuda/be-master/util"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	statuspb "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func newTestServer(t *testing.T, store *mocks.Store) *Server {
+	config := util.Config{
+		TokenSymmetricKey:   util.RandomString(32),
+		AccessTokenDuration: time.Minute,
+		DefaultPageSize:     5,
+		MaxPageSize:         10,
+	}
+
+	server, err := NewServer(config, store)
+	assert.NoError(t, err)
+
+	return server
+}
+
+// gatewayStatus decodes the error response of the gateway back to the grpc status with its details
+func gatewayStatus(t *testing.T, recorder *httptest.ResponseRecorder) *status.Status {
+	var st statuspb.Status
+	err := protojson.Unmarshal(recorder.Body.Bytes(), &st)
+	assert.NoError(t, err)
+
+	return status.FromProto(&st)
+}
+
+func assertFieldViolation(t *testing.T, recorder *httptest.ResponseRecorder, field string) {
+	st := gatewayStatus(t, recorder)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+
+	for _, detail := range st.Details() {
+		if badRequest, ok := detail.(*errdetails.BadRequest); ok {
+			for _, violation := range badRequest.FieldViolations {
+				if violation.Field == field {
+					return
+				}
+			}
+		}
+	}
+	t.Errorf("no violation of field %s in %v", field, st.Details())
+}
+
+func assertPreconditionViolation(t *testing.T, recorder *httptest.ResponseRecorder, violationType string, subject string) {
+	st := gatewayStatus(t, recorder)
+	assert.Equal(t, codes.FailedPrecondition, st.Code())
+
+	for _, detail := range st.Details() {
+		if failure, ok := detail.(*errdetails.PreconditionFailure); ok {
+			for _, violation := range failure.Violations {
+				if violation.Type == violationType && violation.Subject == subject {
+					return
+				}
+			}
+		}
+	}
+	t.Errorf("no violation %s of %s in %v", violationType, subject, st.Details())
+}
+
+// assertResourceInfo checks the resource in the details of the not found or permission denied error
+func assertResourceInfo(t *testing.T, recorder *httptest.ResponseRecorder, code codes.Code, resourceType string, resourceName string) {
+	st := gatewayStatus(t, recorder)
+	assert.Equal(t, code, st.Code())
+
+	for _, detail := range st.Details() {
+		if info, ok := detail.(*errdetails.ResourceInfo); ok {
+			assert.Equal(t, resourceType, info.ResourceType)
+			assert.Equal(t, resourceName, info.ResourceName)
+			return
+		}
+	}
+	t.Errorf("no resource info in %v", st.Details())
+}
+
+func newContextWithBearerToken(t *testing.T, tokenMaker token.Maker, username string, role string) context.Context {
+	accessToken, _, err := tokenMaker.CreateToken(username, role, time.Minute)
+	assert.NoError(t, err)
+
+	md := metadata.MD{
+		authorizationHeader: []string{fmt.Sprintf("%s %s", authorizationBearer, accessToken)},
+	}
+	return metadata.NewIncomingContext(context.Background(), md)
+}
+
+// every method registered in main must have its policy
+func TestMethodPolicies(t *testing.T) {
+	grpcServer := grpc.NewServer()
+	RegisterServices(grpcServer, &Server{}, health.NewServer())
+
+	for service, info := range grpcServer.GetServiceInfo() {
+		for _, method := range info.Methods {
+			fullMethod := fmt.Sprintf("/%s/%s", service, method.Name)
+			_, ok := methodPolicies[fullMethod]
+			assert.True(t, ok, "missing access policy for %s", fullMethod)
+		}
+	}
+}
+
+func TestAuthInterceptor(t *testing.T) {
+	server := newTestServer(t, nil)
+	username := util.RandomOwner()
+
+	const adminMethod = "/pb.SimpleBank/AdminOnly"
+	methodPolicies[adminMethod] = accessPolicy{roles: []string{util.AdminRole}}
+	t.Cleanup(func() { delete(methodPolicies, adminMethod) })
+
+	testCases := []struct {
+		name       string
+		method     string
+		ctx        func(t *testing.T) context.Context
+		checkCalls func(t *testing.T, ctx context.Context, err error)
+	}{
+		{
+			name:   "Public",
+			method: pb.SimpleBank_LoginUser_FullMethodName,
+			ctx: func(t *testing.T) context.Context {
+				return context.Background()
+			},
+			checkCalls: func(t *testing.T, ctx context.Context, err error) {
+				assert.NoError(t, err)
+				_, err = authPayloadFromContext(ctx)
+				assert.Error(t, err)
+			},
+		},
+		{
+			name:   "Authenticated",
+			method: pb.SimpleBank_GetAccount_FullMethodName,
+			ctx: func(t *testing.T) context.Context {
+				return newContextWithBearerToken(t, server.tokenMaker, username, util.DepositorRole)
+			},
+			checkCalls: func(t *testing.T, ctx context.Context, err error) {
+				assert.NoError(t, err)
+				payload, err := authPayloadFromContext(ctx)
+				assert.NoError(t, err)
+				assert.Equal(t, username, payload.Username)
+			},
+		},
+		{
+			name:   "NoAuthorization",
+			method: pb.SimpleBank_GetAccount_FullMethodName,
+			ctx: func(t *testing.T) context.Context {
+				return context.Background()
+			},
+			checkCalls: func(t *testing.T, ctx context.Context, err error) {
+				assert.Equal(t, codes.Unauthenticated, status.Code(err))
+			},
+		},
+		{
+			name:   "InvalidToken",
+			method: pb.SimpleBank_GetAccount_FullMethodName,
+			ctx: func(t *testing.T) context.Context {
+				md := metadata.MD{authorizationHeader: []string{authorizationBearer + " invalid"}}
+				return metadata.NewIncomingContext(context.Background(), md)
+			},
+			checkCalls: func(t *testing.T, ctx context.Context, err error) {
+				assert.Equal(t, codes.Unauthenticated, status.Code(err))
+			},
+		},
+		{
+			name:   "Role",
+			method: adminMethod,
+			ctx: func(t *testing.T) context.Context {
+				return newContextWithBearerToken(t, server.tokenMaker, username, util.AdminRole)
+			},
+			checkCalls: func(t *testing.T, ctx context.Context, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name:   "RoleDenied",
+			method: adminMethod,
+			ctx: func(t *testing.T) context.Context {
+				return newContextWithBearerToken(t, server.tokenMaker, username, util.BankerRole)
+			},
+			checkCalls: func(t *testing.T, ctx context.Context, err error) {
+				assert.Equal(t, codes.PermissionDenied, status.Code(err))
+			},
+		},
+		{
+			name:   "UnknownMethod",
+			method: "/pb.SimpleBank/Unknown",
+			ctx: func(t *testing.T) context.Context {
+				return newContextWithBearerToken(t, server.tokenMaker, username, util.AdminRole)
+			},
+			checkCalls: func(t *testing.T, ctx context.Context, err error) {
+				assert.Equal(t, codes.PermissionDenied, status.Code(err))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var handlerCtx context.Context
+			info := &grpc.UnaryServerInfo{FullMethod: tc.method}
+			_, err := server.AuthInterceptor(tc.ctx(t), nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+				handlerCtx = ctx
+				return nil, nil
+			})
+
+			tc.checkCalls(t, handlerCtx, err)
+		})
+	}
+}
+
+// the in-process gateway doesn't run the grpc interceptors, so it has to be wrapped
+func TestGatewayAuthorization(t *testing.T) {
+	store := mocks.NewStore(t)
+	server := newTestServer(t, store)
+	username := util.RandomOwner()
+
+	mux := runtime.NewServeMux()
+	err := pb.RegisterSimpleBankHandlerServer(context.Background(), mux, NewGatewayServer(server))
+	assert.NoError(t, err)
+
+	// without the access token
+	request := httptest.NewRequest(http.MethodPost, "/v1/logout_all_sessions", strings.NewReader("{}"))
+	recorder := httptest.NewRecorder()
+	mux.ServeHTTP(recorder, request)
+	assert.Equal(t, http.StatusUnauthorized, recorder.Code)
+
+	store.EXPECT().
+		BlockUserSessions(mock.Anything, username).
+		Times(1).
+		Return(int64(1), nil)
+
+	accessToken, _, err := server.tokenMaker.CreateToken(username, util.DepositorRole, time.Minute)
+	assert.NoError(t, err)
+
+	request = httptest.NewRequest(http.MethodPost, "/v1/logout_all_sessions", strings.NewReader("{}"))
+	request.Header.Set("Authorization", fmt.Sprintf("%s %s", authorizationBearer, accessToken))
+	recorder = httptest.NewRecorder()
+	mux.ServeHTTP(recorder, request)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+}
@@ -11,7 +11,129 @@ func convertUser(user db.User) *pb.User {
 		Username:          user.Username,
 		FullName:          user.FullName,
 		Email:             user.Email,
+		Role:              user.Role,
 		PasswordChangedAt: timestamppb.New(user.PasswordChangedAt),
 		CreatedAt:         timestamppb.New(user.CreatedAt),
 	}
 }
+
+func convertAccount(account db.Account) *pb.Account {
+	return &pb.Account{
+		Id:             account.ID,
+		Owner:          account.Owner,
+		Balance:        account.Balance,
+		Currency:       account.Currency,
+		CreatedAt:      timestamppb.New(account.CreatedAt),
+		OverdraftLimit: account.OverdraftLimit,
+		Status:         account.Status,
+	}
+}
+
+func convertTransfer(transfer db.Transfer) *pb.Transfer {
+	return &pb.Transfer{
+		Id:            transfer.ID,
+		FromAccountId: transfer.FromAccountID,
+		ToAccountId:   transfer.ToAccountID,
+		Amount:        transfer.Amount,
+		CreatedAt:     timestamppb.New(transfer.CreatedAt),
+		ToAmount:      transfer.ToAmount,
+		ExchangeRate:  transfer.ExchangeRate,
+		JournalId:     transfer.JournalID,
+		ReversalOf:    transfer.ReversalOf.Int64,
+	}
+}
+
+func convertEntry(entry db.Entry) *pb.Entry {
+	return &pb.Entry{
+		Id:        entry.ID,
+		AccountId: entry.AccountID,
+		Amount:    entry.Amount,
+		CreatedAt: timestamppb.New(entry.CreatedAt),
+		JournalId: entry.JournalID,
+	}
+}
+
+func convertReconciliationReport(report db.ReconciliationReport) *pb.ReconciliationReport {
+	return &pb.ReconciliationReport{
+		Id:           report.ID,
+		AccountId:    report.AccountID,
+		Balance:      report.Balance,
+		EntriesTotal: report.EntriesTotal,
+		CreatedAt:    timestamppb.New(report.CreatedAt),
+	}
+}
+
+func convertScheduledTransfer(scheduled db.ScheduledTransfer) *pb.ScheduledTransfer {
+	return &pb.ScheduledTransfer{
+		Id:            scheduled.ID,
+		Owner:         scheduled.Owner,
+		FromAccountId: scheduled.FromAccountID,
+		ToAccountId:   scheduled.ToAccountID,
+		Amount:        scheduled.Amount,
+		Currency:      scheduled.Currency,
+		Schedule:      scheduled.Schedule,
+		Status:        scheduled.Status,
+		NextRunAt:     timestamppb.New(scheduled.NextRunAt),
+		CreatedAt:     timestamppb.New(scheduled.CreatedAt),
+	}
+}
+
+func convertScheduledTransferRun(run db.ScheduledTransferRun) *pb.ScheduledTransferRun {
+	return &pb.ScheduledTransferRun{
+		Id:                  run.ID,
+		ScheduledTransferId: run.ScheduledTransferID,
+		ScheduledAt:         timestamppb.New(run.ScheduledAt),
+		Status:              run.Status,
+		TransferId:          run.TransferID.Int64,
+		Error:               run.Error,
+		CreatedAt:           timestamppb.New(run.CreatedAt),
+	}
+}
+
+func convertStatementLine(line db.StatementLine) *pb.StatementLine {
+	return &pb.StatementLine{
+		EntryId:               line.ID,
+		CreatedAt:             timestamppb.New(line.CreatedAt),
+		JournalId:             line.JournalID,
+		TransferId:            line.TransferID.Int64,
+		CounterpartyAccountId: line.CounterpartyAccountID.Int64,
+		CounterpartyOwner:     line.CounterpartyOwner.String,
+		Amount:                line.Amount,
+		Balance:               line.Balance,
+	}
+}
+
+// convertMyTransfer shows the transfer from the side of the user
+func convertMyTransfer(transfer db.ListTransfersOfOwnerRow, outgoing bool) *pb.MyTransfer {
+	rsp := &pb.MyTransfer{
+		Transfer: convertTransfer(db.Transfer{
+			ID:            transfer.ID,
+			FromAccountID: transfer.FromAccountID,
+			ToAccountID:   transfer.ToAccountID,
+			Amount:        transfer.Amount,
+			CreatedAt:     transfer.CreatedAt,
+			ToAmount:      transfer.ToAmount,
+			ExchangeRate:  transfer.ExchangeRate,
+			JournalID:     transfer.JournalID,
+			ReversalOf:    transfer.ReversalOf,
+		}),
+	}
+
+	if outgoing {
+		rsp.Direction = transferDirectionOutgoing
+		rsp.AccountId = transfer.FromAccountID
+		rsp.Amount = transfer.Amount
+		rsp.Currency = transfer.FromCurrency
+		rsp.CounterpartyAccountId = transfer.ToAccountID
+		rsp.Counterparty = transfer.ToOwner
+	} else {
+		rsp.Direction = transferDirectionIncoming
+		rsp.AccountId = transfer.ToAccountID
+		rsp.Amount = transfer.ToAmount
+		rsp.Currency = transfer.ToCurrency
+		rsp.CounterpartyAccountId = transfer.FromAccountID
+		rsp.Counterparty = transfer.FromOwner
+	}
+
+	return rsp
+}
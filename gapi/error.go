@@ -1,6 +1,9 @@
 package gapi
 
 import (
+	"errors"
+
+	"github.com/tgfukuda/be-master/token"
 	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -26,5 +29,61 @@ func invalidArgumentError(violations []*errdetails.BadRequest_FieldViolation) er
 }
 
 func unauthorizedError(err error) error {
+	if errors.Is(err, token.ErrPermissionDenied) {
+		return status.Errorf(codes.PermissionDenied, "%s", err)
+	}
 	return status.Errorf(codes.Unauthenticated, "unauthorized: %s", err)
 }
+
+func notFoundError(resourceType string, resourceName string, description string) error {
+	resourceInfo := &errdetails.ResourceInfo{
+		ResourceType: resourceType,
+		ResourceName: resourceName,
+		Description:  description,
+	}
+	statusNotFound := status.Newf(codes.NotFound, "%s not found", resourceType)
+
+	statusDetail, err := statusNotFound.WithDetails(resourceInfo)
+	if err != nil {
+		return statusNotFound.Err()
+	}
+
+	return statusDetail.Err()
+}
+
+func permissionDeniedError(resourceType string, resourceName string, owner string, description string) error {
+	resourceInfo := &errdetails.ResourceInfo{
+		ResourceType: resourceType,
+		ResourceName: resourceName,
+		Owner:        owner,
+		Description:  description,
+	}
+	statusDenied := status.New(codes.PermissionDenied, description)
+
+	statusDetail, err := statusDenied.WithDetails(resourceInfo)
+	if err != nil {
+		return statusDenied.Err()
+	}
+
+	return statusDetail.Err()
+}
+
+func preconditionViolation(violationType string, subject string, description string) *errdetails.PreconditionFailure_Violation {
+	return &errdetails.PreconditionFailure_Violation{
+		Type:        violationType,
+		Subject:     subject,
+		Description: description,
+	}
+}
+
+func failedPreconditionError(violations []*errdetails.PreconditionFailure_Violation) error {
+	preconditionFailure := &errdetails.PreconditionFailure{Violations: violations}
+	statusFailed := status.New(codes.FailedPrecondition, "failed preconditions")
+
+	statusDetail, err := statusFailed.WithDetails(preconditionFailure)
+	if err != nil {
+		return statusFailed.Err()
+	}
+
+	return statusDetail.Err()
+}
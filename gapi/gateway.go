@@ -0,0 +1,170 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/tgfukuda/be-master/pb"
+	"google.golang.org/grpc"
+)
+
+// gatewayServer runs the unary interceptors of the server for the in-process gateway.
+// RegisterSimpleBankHandlerServer calls the server methods directly, so the grpc interceptors never run.
+// UnsafeSimpleBankServer is embedded to make a new rpc fail to compile until it's wrapped here.
+type gatewayServer struct {
+	pb.UnsafeSimpleBankServer
+	server *Server
+}
+
+// NewGatewayServer wraps the server to be registered to the gateway
+func NewGatewayServer(server *Server) pb.SimpleBankServer {
+	return &gatewayServer{server: server}
+}
+
+// intercept calls the handler through the interceptors with the method annotated by the gateway
+func intercept[Req, Res any](
+	gateway *gatewayServer,
+	ctx context.Context,
+	req Req,
+	handler func(context.Context, Req) (Res, error),
+) (Res, error) {
+	var res Res
+
+	setHttpRoute(ctx)
+	method, _ := runtime.RPCMethod(ctx)
+	info := &grpc.UnaryServerInfo{
+		Server:     gateway.server,
+		FullMethod: method,
+	}
+
+	// chain from the last one, so the first interceptor is the outermost as grpc.ChainUnaryInterceptor does
+	chained := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return handler(ctx, req.(Req))
+	}
+	interceptors := gateway.server.UnaryInterceptors()
+	for i := len(interceptors) - 1; i >= 0; i-- {
+		interceptor, next := interceptors[i], chained
+		chained = func(ctx context.Context, req interface{}) (interface{}, error) {
+			return interceptor(ctx, req, info, next)
+		}
+	}
+
+	result, err := chained(ctx, req)
+	if err != nil {
+		return res, err
+	}
+
+	return result.(Res), nil
+}
+
+func (gateway *gatewayServer) CreateUser(ctx context.Context, req *pb.CreateUserRequest) (*pb.CreateUserResponse, error) {
+	return intercept(gateway, ctx, req, gateway.server.CreateUser)
+}
+
+func (gateway *gatewayServer) LoginUser(ctx context.Context, req *pb.LoginUserRequest) (*pb.LoginUserResponse, error) {
+	return intercept(gateway, ctx, req, gateway.server.LoginUser)
+}
+
+func (gateway *gatewayServer) UpdateUser(ctx context.Context, req *pb.UpdateUserRequest) (*pb.UpdateUserResponse, error) {
+	return intercept(gateway, ctx, req, gateway.server.UpdateUser)
+}
+
+func (gateway *gatewayServer) VerifyEmail(ctx context.Context, req *pb.VerifyEmailRequest) (*pb.VerifyEmailResponse, error) {
+	return intercept(gateway, ctx, req, gateway.server.VerifyEmail)
+}
+
+func (gateway *gatewayServer) CreateAccount(ctx context.Context, req *pb.CreateAccountRequest) (*pb.CreateAccountResponse, error) {
+	return intercept(gateway, ctx, req, gateway.server.CreateAccount)
+}
+
+func (gateway *gatewayServer) GetAccount(ctx context.Context, req *pb.GetAccountRequest) (*pb.GetAccountResponse, error) {
+	return intercept(gateway, ctx, req, gateway.server.GetAccount)
+}
+
+func (gateway *gatewayServer) ListAccounts(ctx context.Context, req *pb.ListAccountsRequest) (*pb.ListAccountsResponse, error) {
+	return intercept(gateway, ctx, req, gateway.server.ListAccounts)
+}
+
+func (gateway *gatewayServer) DeleteAccount(ctx context.Context, req *pb.DeleteAccountRequest) (*pb.DeleteAccountResponse, error) {
+	return intercept(gateway, ctx, req, gateway.server.DeleteAccount)
+}
+
+func (gateway *gatewayServer) CloseAccount(ctx context.Context, req *pb.CloseAccountRequest) (*pb.CloseAccountResponse, error) {
+	return intercept(gateway, ctx, req, gateway.server.CloseAccount)
+}
+
+func (gateway *gatewayServer) ReopenAccount(ctx context.Context, req *pb.ReopenAccountRequest) (*pb.ReopenAccountResponse, error) {
+	return intercept(gateway, ctx, req, gateway.server.ReopenAccount)
+}
+
+func (gateway *gatewayServer) FreezeAccount(ctx context.Context, req *pb.FreezeAccountRequest) (*pb.FreezeAccountResponse, error) {
+	return intercept(gateway, ctx, req, gateway.server.FreezeAccount)
+}
+
+func (gateway *gatewayServer) UnfreezeAccount(ctx context.Context, req *pb.UnfreezeAccountRequest) (*pb.UnfreezeAccountResponse, error) {
+	return intercept(gateway, ctx, req, gateway.server.UnfreezeAccount)
+}
+
+func (gateway *gatewayServer) CreateTransfer(ctx context.Context, req *pb.CreateTransferRequest) (*pb.CreateTransferResponse, error) {
+	return intercept(gateway, ctx, req, gateway.server.CreateTransfer)
+}
+
+func (gateway *gatewayServer) ReverseTransfer(ctx context.Context, req *pb.ReverseTransferRequest) (*pb.ReverseTransferResponse, error) {
+	return intercept(gateway, ctx, req, gateway.server.ReverseTransfer)
+}
+
+func (gateway *gatewayServer) ListMyTransfers(ctx context.Context, req *pb.ListMyTransfersRequest) (*pb.ListMyTransfersResponse, error) {
+	return intercept(gateway, ctx, req, gateway.server.ListMyTransfers)
+}
+
+func (gateway *gatewayServer) GetStatement(ctx context.Context, req *pb.GetStatementRequest) (*pb.GetStatementResponse, error) {
+	return intercept(gateway, ctx, req, gateway.server.GetStatement)
+}
+
+func (gateway *gatewayServer) RenewAccessToken(ctx context.Context, req *pb.RenewAccessTokenRequest) (*pb.RenewAccessTokenResponse, error) {
+	return intercept(gateway, ctx, req, gateway.server.RenewAccessToken)
+}
+
+func (gateway *gatewayServer) Logout(ctx context.Context, req *pb.LogoutRequest) (*pb.LogoutResponse, error) {
+	return intercept(gateway, ctx, req, gateway.server.Logout)
+}
+
+func (gateway *gatewayServer) LogoutAllSessions(ctx context.Context, req *pb.LogoutAllSessionsRequest) (*pb.LogoutAllSessionsResponse, error) {
+	return intercept(gateway, ctx, req, gateway.server.LogoutAllSessions)
+}
+
+func (gateway *gatewayServer) ListReconciliationReports(ctx context.Context, req *pb.ListReconciliationReportsRequest) (*pb.ListReconciliationReportsResponse, error) {
+	return intercept(gateway, ctx, req, gateway.server.ListReconciliationReports)
+}
+
+func (gateway *gatewayServer) CreateScheduledTransfer(ctx context.Context, req *pb.CreateScheduledTransferRequest) (*pb.CreateScheduledTransferResponse, error) {
+	return intercept(gateway, ctx, req, gateway.server.CreateScheduledTransfer)
+}
+
+func (gateway *gatewayServer) ListScheduledTransfers(ctx context.Context, req *pb.ListScheduledTransfersRequest) (*pb.ListScheduledTransfersResponse, error) {
+	return intercept(gateway, ctx, req, gateway.server.ListScheduledTransfers)
+}
+
+func (gateway *gatewayServer) ListScheduledTransferRuns(ctx context.Context, req *pb.ListScheduledTransferRunsRequest) (*pb.ListScheduledTransferRunsResponse, error) {
+	return intercept(gateway, ctx, req, gateway.server.ListScheduledTransferRuns)
+}
+
+func (gateway *gatewayServer) PauseScheduledTransfer(ctx context.Context, req *pb.PauseScheduledTransferRequest) (*pb.PauseScheduledTransferResponse, error) {
+	return intercept(gateway, ctx, req, gateway.server.PauseScheduledTransfer)
+}
+
+func (gateway *gatewayServer) ResumeScheduledTransfer(ctx context.Context, req *pb.ResumeScheduledTransferRequest) (*pb.ResumeScheduledTransferResponse, error) {
+	return intercept(gateway, ctx, req, gateway.server.ResumeScheduledTransfer)
+}
+
+func (gateway *gatewayServer) CancelScheduledTransfer(ctx context.Context, req *pb.CancelScheduledTransferRequest) (*pb.CancelScheduledTransferResponse, error) {
+	return intercept(gateway, ctx, req, gateway.server.CancelScheduledTransfer)
+}
+
+func (gateway *gatewayServer) ListEntries(ctx context.Context, req *pb.ListEntriesRequest) (*pb.ListEntriesResponse, error) {
+	return intercept(gateway, ctx, req, gateway.server.ListEntries)
+}
+
+func (gateway *gatewayServer) ListAccountTransfers(ctx context.Context, req *pb.ListAccountTransfersRequest) (*pb.ListAccountTransfersResponse, error) {
+	return intercept(gateway, ctx, req, gateway.server.ListAccountTransfers)
+}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/rs/zerolog/log"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -30,6 +31,9 @@ func GrpcLogger(
 	if err != nil {
 		logger = log.Error().Err(err)
 	}
+	if spanContext := trace.SpanContextFromContext(ctx); spanContext.HasTraceID() {
+		logger = logger.Str("trace_id", spanContext.TraceID().String())
+	}
 
 	logger.
 		Str("protocol", "grpc").
@@ -72,6 +76,9 @@ func HttpLogger(handler http.Handler) http.Handler {
 		if rec.StatusCode != http.StatusOK {
 			logger = log.Error().Bytes("body", rec.Body)
 		}
+		if spanContext := trace.SpanContextFromContext(req.Context()); spanContext.HasTraceID() {
+			logger = logger.Str("trace_id", spanContext.TraceID().String())
+		}
 
 		logger.
 			Str("protocol", "http").
@@ -2,7 +2,9 @@ package gapi
 
 import (
 	"context"
+	"net/textproto"
 
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/peer"
 )
@@ -22,11 +24,18 @@ const (
 	authorityKey   = ":authority"
 )
 
+// application keys shared by gateway and raw grpc
+const (
+	idempotencyKeyKey     = "idempotency-key"
+	idempotentReplayedKey = "idempotent-replayed"
+)
+
 type Metadata struct {
-	UserAgent   string
-	ClientIp    string
-	ContentType string
-	Authority   string
+	UserAgent      string
+	ClientIp       string
+	ContentType    string
+	Authority      string
+	IdempotencyKey string
 }
 
 // if its gateway, get the values as gateway.
@@ -47,6 +56,9 @@ func (server *Server) extractMetadata(ctx context.Context) *Metadata {
 		if authorities := md.Get(gatewayAuthorityKey); len(authorities) > 0 {
 			mtdt.Authority = authorities[0]
 		}
+		if idempotencyKeys := md.Get(idempotencyKeyKey); len(idempotencyKeys) > 0 {
+			mtdt.IdempotencyKey = idempotencyKeys[0]
+		}
 
 		if len(mtdt.UserAgent) == 0 || len(mtdt.ClientIp) == 0 {
 			// try native call
@@ -67,3 +79,14 @@ func (server *Server) extractMetadata(ctx context.Context) *Metadata {
 
 	return mtdt
 }
+
+// GatewayHeaderMatcher forwards the application headers (e.g. Idempotency-Key) to grpc metadata as they are.
+// the others are handled by the default matcher of the gateway.
+func GatewayHeaderMatcher(key string) (string, bool) {
+	switch textproto.CanonicalMIMEHeaderKey(key) {
+	case textproto.CanonicalMIMEHeaderKey(idempotencyKeyKey):
+		return idempotencyKeyKey, true
+	}
+
+	return runtime.DefaultHeaderMatcher(key)
+}
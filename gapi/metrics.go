@@ -0,0 +1,63 @@
+package gapi
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/tgfukuda/be-master/metrics"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// GrpcMetrics counts the calls and observes the latency by method and status code
+func GrpcMetrics(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (resp interface{}, err error) {
+	startTime := time.Now()
+	result, err := handler(ctx, req)
+
+	metrics.ObserveGRPC(info.FullMethod, status.Code(err).String(), time.Since(startTime))
+
+	return result, err
+}
+
+// httpRouteKey carries the route of the request from HttpMetrics to the gateway, which knows the path pattern
+type httpRouteKey struct{}
+
+// HttpMetrics counts the requests and observes the latency by method, route and status code.
+// the route is the pattern of the gateway annotated on the call, otherwise the pattern of the handler if it's a ServeMux.
+func HttpMetrics(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		startTime := time.Now()
+		rec := &ResponseRecorder{
+			ResponseWriter: res,
+			StatusCode:     http.StatusOK,
+		}
+
+		var route string
+		if mux, ok := handler.(*http.ServeMux); ok {
+			_, route = mux.Handler(req)
+		}
+		req = req.WithContext(context.WithValue(req.Context(), httpRouteKey{}, &route))
+		handler.ServeHTTP(rec, req)
+
+		metrics.ObserveHTTP(req.Method, route, rec.StatusCode, time.Since(startTime))
+	})
+}
+
+// setHttpRoute reports the path pattern annotated by the gateway to HttpMetrics
+func setHttpRoute(ctx context.Context) {
+	route, ok := ctx.Value(httpRouteKey{}).(*string)
+	if !ok {
+		return
+	}
+
+	if pattern, ok := runtime.HTTPPathPattern(ctx); ok {
+		*route = pattern
+	}
+}
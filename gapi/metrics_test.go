@@ -0,0 +1,116 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/tgfukuda/be-master/metrics"
+	"github.com/tgfukuda/be-master/mocks"
+	"github.com/tgfukuda/be-master/pb"
+	"github.com/tgfukuda/be-master/util"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func scrapeMetrics(t *testing.T) string {
+	server := httptest.NewServer(metrics.Handler())
+	defer server.Close()
+
+	res, err := http.Get(server.URL)
+	assert.NoError(t, err)
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	assert.NoError(t, err)
+
+	return string(body)
+}
+
+// metricValue reads the value of the series from the scraped body. zero if it's not observed yet
+func metricValue(t *testing.T, body string, series string) float64 {
+	for _, line := range strings.Split(body, "\n") {
+		if value := strings.TrimPrefix(line, series+" "); value != line {
+			v, err := strconv.ParseFloat(value, 64)
+			assert.NoError(t, err)
+			return v
+		}
+	}
+	return 0
+}
+
+func TestGrpcMetrics(t *testing.T) {
+	method := "/pb.SimpleBank/" + util.RandomString(10)
+	info := &grpc.UnaryServerInfo{FullMethod: method}
+
+	_, err := GrpcMetrics(context.Background(), nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, nil
+	})
+	assert.NoError(t, err)
+
+	_, err = GrpcMetrics(context.Background(), nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, status.Errorf(codes.NotFound, "not found")
+	})
+	assert.Error(t, err)
+
+	body := scrapeMetrics(t)
+	assert.Contains(t, body, fmt.Sprintf(`simple_bank_grpc_requests_total{code="OK",method="%s"} 1`, method))
+	assert.Contains(t, body, fmt.Sprintf(`simple_bank_grpc_requests_total{code="NotFound",method="%s"} 1`, method))
+	assert.Contains(t, body, fmt.Sprintf(`simple_bank_grpc_request_duration_seconds_count{code="OK",method="%s"} 1`, method))
+}
+
+func TestHttpMetrics(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/teapot", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTeapot)
+	})
+	mux.Handle("/metrics", metrics.Handler())
+
+	server := httptest.NewServer(HttpMetrics(mux))
+	defer server.Close()
+
+	res, err := http.Post(server.URL+"/teapot", "application/json", nil)
+	assert.NoError(t, err)
+	res.Body.Close()
+	assert.Equal(t, http.StatusTeapot, res.StatusCode)
+
+	// scrape through the same server as the gateway does
+	res, err = http.Get(server.URL + "/metrics")
+	assert.NoError(t, err)
+	defer res.Body.Close()
+	body, err := io.ReadAll(res.Body)
+	assert.NoError(t, err)
+
+	assert.Contains(t, string(body), `simple_bank_http_requests_total{code="418",method="POST",route="/teapot"} 1`)
+	assert.Contains(t, string(body), `simple_bank_http_request_duration_seconds_count{code="418",method="POST",route="/teapot"} 1`)
+}
+
+// the gateway calls the server in process, so it must run the metrics interceptor by itself
+func TestGatewayMetrics(t *testing.T) {
+	server := newTestServer(t, mocks.NewStore(t))
+	mux := runtime.NewServeMux()
+	err := pb.RegisterSimpleBankHandlerServer(context.Background(), mux, NewGatewayServer(server))
+	assert.NoError(t, err)
+
+	series := `simple_bank_grpc_requests_total{code="Unauthenticated",method="/pb.SimpleBank/GetAccount"}`
+	// labeled by the pattern of the gateway, not by the path with the query
+	httpSeries := `simple_bank_http_requests_total{code="401",method="GET",route="/v1/get_account"}`
+	body := scrapeMetrics(t)
+	before, httpBefore := metricValue(t, body, series), metricValue(t, body, httpSeries)
+
+	recorder := httptest.NewRecorder()
+	HttpMetrics(mux).ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/v1/get_account?id=1", nil))
+	assert.Equal(t, http.StatusUnauthorized, recorder.Code)
+
+	body = scrapeMetrics(t)
+	assert.Equal(t, before+1, metricValue(t, body, series))
+	assert.Equal(t, httpBefore+1, metricValue(t, body, httpSeries))
+}
@@ -0,0 +1,125 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	db "github.com/tgfukuda/be-master/db/sqlc"
+	"github.com/tgfukuda/be-master/mocks"
+	"github.com/tgfukuda/be-master/pb"
+	"github.com/tgfukuda/be-master/util"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func TestCreateAccount(t *testing.T) {
+	owner := util.RandomOwner()
+	account := db.Account{ID: util.RandomInt(1, 1000), Owner: owner, Currency: util.USD, Status: db.AccountActive}
+
+	testCases := []struct {
+		name          string
+		body          string
+		buildStubs    func(store *mocks.Store)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			body: fmt.Sprintf(`{"currency": %q}`, util.USD),
+			buildStubs: func(store *mocks.Store) {
+				// the owner is always the authenticated user
+				store.EXPECT().
+					CreateAccount(mock.Anything, db.CreateAccountParams{Owner: owner, Currency: util.USD, Balance: 0}).
+					Times(1).
+					Return(account, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+
+				var rsp pb.CreateAccountResponse
+				err := protojson.Unmarshal(recorder.Body.Bytes(), &rsp)
+				assert.NoError(t, err)
+				assert.Equal(t, account.ID, rsp.Account.Id)
+				assert.Equal(t, owner, rsp.Account.Owner)
+			},
+		},
+		{
+			name:       "InvalidCurrency",
+			body:       `{"currency": "XYZ"}`,
+			buildStubs: func(store *mocks.Store) {},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+				assertFieldViolation(t, recorder, "currency")
+			},
+		},
+		{
+			name: "DuplicateCurrency",
+			body: fmt.Sprintf(`{"currency": %q}`, util.USD),
+			buildStubs: func(store *mocks.Store) {
+				store.EXPECT().
+					CreateAccount(mock.Anything, mock.Anything).
+					Times(1).
+					Return(db.Account{}, &pq.Error{Code: "23505"})
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
+		{
+			name: "OwnerNotFound",
+			body: fmt.Sprintf(`{"currency": %q}`, util.USD),
+			buildStubs: func(store *mocks.Store) {
+				store.EXPECT().
+					CreateAccount(mock.Anything, mock.Anything).
+					Times(1).
+					Return(db.Account{}, &pq.Error{Code: "23503"})
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name: "InternalError",
+			body: fmt.Sprintf(`{"currency": %q}`, util.USD),
+			buildStubs: func(store *mocks.Store) {
+				store.EXPECT().
+					CreateAccount(mock.Anything, mock.Anything).
+					Times(1).
+					Return(db.Account{}, errors.New("connection is closed"))
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := mocks.NewStore(t)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			mux := runtime.NewServeMux()
+			err := pb.RegisterSimpleBankHandlerServer(context.Background(), mux, NewGatewayServer(server))
+			assert.NoError(t, err)
+
+			accessToken, _, err := server.tokenMaker.CreateToken(owner, util.DepositorRole, time.Minute)
+			assert.NoError(t, err)
+
+			request := httptest.NewRequest(http.MethodPost, "/v1/create_account", strings.NewReader(tc.body))
+			request.Header.Set("Authorization", fmt.Sprintf("%s %s", authorizationBearer, accessToken))
+			recorder := httptest.NewRecorder()
+			mux.ServeHTTP(recorder, request)
+
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
@@ -0,0 +1,188 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+
+	db "github.com/tgfukuda/be-master/db/sqlc"
+	"github.com/tgfukuda/be-master/fx"
+	"github.com/tgfukuda/be-master/pb"
+	"github.com/tgfukuda/be-master/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const resourceTypeAccount = "account"
+
+func (server *Server) CreateTransfer(ctx context.Context, req *pb.CreateTransferRequest) (*pb.CreateTransferResponse, error) {
+	authPayload, err := authPayloadFromContext(ctx)
+	if err != nil {
+		return nil, unauthorizedError(err)
+	}
+
+	mtdt := server.extractMetadata(ctx)
+
+	violations := validateCreateTransferRequest(req)
+	if len(mtdt.IdempotencyKey) > 0 {
+		if err := val.ValidateIdempotencyKey(mtdt.IdempotencyKey); err != nil {
+			violations = append(violations, fieldViolation(idempotencyKeyKey, err))
+		}
+	}
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	fromAccount, err := server.validateAccount(ctx, req.GetFromAccountId(), req.GetCurrency())
+	if err != nil {
+		return nil, err
+	}
+
+	if fromAccount.Owner != authPayload.Username {
+		return nil, permissionDeniedError(
+			resourceTypeAccount,
+			strconv.FormatInt(fromAccount.ID, 10),
+			fromAccount.Owner,
+			"from account doesn't belong to the authenticated user",
+		)
+	}
+
+	toCurrency := req.GetToCurrency()
+	if len(toCurrency) == 0 {
+		toCurrency = req.GetCurrency()
+	}
+
+	_, err = server.validateAccount(ctx, req.GetToAccountId(), toCurrency)
+	if err != nil {
+		return nil, err
+	}
+
+	arg := db.TransferTxParams{
+		FromAccountID:  req.GetFromAccountId(),
+		ToAccountID:    req.GetToAccountId(),
+		Amount:         req.GetAmount(),
+		IdempotencyKey: mtdt.IdempotencyKey,
+	}
+
+	var result db.TransferTxResult
+	if toCurrency == req.GetCurrency() {
+		result, err = server.store.TransferTx(ctx, arg)
+	} else {
+		result, err = server.store.CrossCurrencyTransferTx(ctx, db.CrossCurrencyTransferTxParams{
+			TransferTxParams:     arg,
+			ExchangeRateProvider: server.exchangeRateProvider,
+		})
+	}
+	if err != nil {
+		if errors.Is(err, db.ErrTransferTooSmall) {
+			return nil, invalidArgumentError([]*errdetails.BadRequest_FieldViolation{fieldViolation("amount", err)})
+		}
+
+		if errors.Is(err, db.ErrInsufficientFunds) {
+			return nil, failedPreconditionError([]*errdetails.PreconditionFailure_Violation{
+				preconditionViolation(
+					"INSUFFICIENT_FUNDS",
+					fmt.Sprintf("%s/%d", resourceTypeAccount, req.GetFromAccountId()),
+					err.Error(),
+				),
+			})
+		}
+
+		if errors.Is(err, fx.ErrRateNotFound) {
+			return nil, failedPreconditionError([]*errdetails.PreconditionFailure_Violation{
+				preconditionViolation(
+					"EXCHANGE_RATE_NOT_FOUND",
+					fmt.Sprintf("%s/%s", req.GetCurrency(), toCurrency),
+					err.Error(),
+				),
+			})
+		}
+
+		if errors.Is(err, db.ErrIdempotencyKeyReused) {
+			return nil, failedPreconditionError([]*errdetails.PreconditionFailure_Violation{
+				preconditionViolation("IDEMPOTENCY_KEY_REUSED", idempotencyKeyKey, err.Error()),
+			})
+		}
+
+		if violation := accountStatusViolation(err); violation != nil {
+			return nil, failedPreconditionError([]*errdetails.PreconditionFailure_Violation{violation})
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to transfer: %s", err)
+	}
+
+	if result.Replayed {
+		// tell the client the response is the saved one. ignore the error since it's just a hint.
+		_ = grpc.SetHeader(ctx, metadata.Pairs(idempotentReplayedKey, "true"))
+	}
+
+	rsp := &pb.CreateTransferResponse{
+		Transfer:    convertTransfer(result.Transfer),
+		FromAccount: convertAccount(result.FromAccount),
+		ToAccount:   convertAccount(result.ToAccount),
+		FromEntry:   convertEntry(result.FromEntry),
+		ToEntry:     convertEntry(result.ToEntry),
+	}
+	return rsp, nil
+}
+
+// validateAccount checks the account exists and has the given currency. the returned error is already a gRPC status.
+func (server *Server) validateAccount(ctx context.Context, accountID int64, currency string) (db.Account, error) {
+	account, err := server.store.GetAccount(ctx, accountID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return account, notFoundError(
+				resourceTypeAccount,
+				strconv.FormatInt(accountID, 10),
+				fmt.Sprintf("account [%d] doesn't exist", accountID),
+			)
+		}
+
+		return account, status.Errorf(codes.Internal, "failed to get account: %s", err)
+	}
+
+	if account.Currency != currency {
+		return account, failedPreconditionError([]*errdetails.PreconditionFailure_Violation{
+			preconditionViolation(
+				"CURRENCY_MISMATCH",
+				fmt.Sprintf("%s/%d", resourceTypeAccount, accountID),
+				fmt.Sprintf("account [%d] currency mismatch: %s and %s", accountID, account.Currency, currency),
+			),
+		})
+	}
+
+	return account, nil
+}
+
+func validateCreateTransferRequest(req *pb.CreateTransferRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateAccountId(req.GetFromAccountId()); err != nil {
+		violations = append(violations, fieldViolation("from_account_id", err))
+	}
+
+	if err := val.ValidateAccountId(req.GetToAccountId()); err != nil {
+		violations = append(violations, fieldViolation("to_account_id", err))
+	} else if req.GetToAccountId() == req.GetFromAccountId() {
+		violations = append(violations, fieldViolation("to_account_id", fmt.Errorf("must be different from from_account_id")))
+	}
+
+	if err := val.ValidateAmount(req.GetAmount()); err != nil {
+		violations = append(violations, fieldViolation("amount", err))
+	}
+
+	if err := val.ValidateCurrency(req.GetCurrency()); err != nil {
+		violations = append(violations, fieldViolation("currency", err))
+	}
+
+	if len(req.GetToCurrency()) > 0 {
+		if err := val.ValidateCurrency(req.GetToCurrency()); err != nil {
+			violations = append(violations, fieldViolation("to_currency", err))
+		}
+	}
+
+	return violations
+}
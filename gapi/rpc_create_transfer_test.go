@@ -0,0 +1,285 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	db "github.com/tgfukuda/be-master/db/sqlc"
+	"github.com/tgfukuda/be-master/fx"
+	"github.com/tgfukuda/be-master/mocks"
+	"github.com/tgfukuda/be-master/pb"
+	"github.com/tgfukuda/be-master/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func TestCreateTransfer(t *testing.T) {
+	sender := db.Account{ID: 1, Owner: util.RandomOwner(), Currency: util.USD, Balance: 1000, Status: db.AccountActive}
+	receiver := db.Account{ID: 2, Owner: util.RandomOwner(), Currency: util.USD, Status: db.AccountActive}
+	foreign := db.Account{ID: 3, Owner: util.RandomOwner(), Currency: util.JPY, Status: db.AccountActive}
+	amount := int64(100)
+	idempotencyKey := util.RandomString(32)
+
+	transferArg := db.TransferTxParams{FromAccountID: sender.ID, ToAccountID: receiver.ID, Amount: amount}
+	result := db.TransferTxResult{
+		Transfer: db.Transfer{
+			ID:            util.RandomInt(1, 1000),
+			FromAccountID: sender.ID,
+			ToAccountID:   receiver.ID,
+			Amount:        amount,
+			ToAmount:      amount,
+			ExchangeRate:  "1",
+		},
+		FromAccount: sender,
+		ToAccount:   receiver,
+	}
+	matchFxTransferParams := mock.MatchedBy(func(arg db.CrossCurrencyTransferTxParams) bool {
+		return arg.TransferTxParams == db.TransferTxParams{FromAccountID: sender.ID, ToAccountID: foreign.ID, Amount: amount} &&
+			arg.ExchangeRateProvider != nil
+	})
+
+	body := fmt.Sprintf(`{"from_account_id": %d, "to_account_id": %d, "amount": %d, "currency": %q}`,
+		sender.ID, receiver.ID, amount, util.USD)
+	fxBody := fmt.Sprintf(`{"from_account_id": %d, "to_account_id": %d, "amount": %d, "currency": %q, "to_currency": %q}`,
+		sender.ID, foreign.ID, amount, util.USD, util.JPY)
+	accountSubject := func(id int64) string {
+		return fmt.Sprintf("%s/%d", resourceTypeAccount, id)
+	}
+
+	testCases := []struct {
+		name           string
+		username       string
+		body           string
+		idempotencyKey string
+		buildStubs     func(store *mocks.Store)
+		checkResponse  func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:     "OK",
+			username: sender.Owner,
+			body:     body,
+			buildStubs: func(store *mocks.Store) {
+				store.EXPECT().GetAccount(mock.Anything, sender.ID).Times(1).Return(sender, nil)
+				store.EXPECT().GetAccount(mock.Anything, receiver.ID).Times(1).Return(receiver, nil)
+				store.EXPECT().TransferTx(mock.Anything, transferArg).Times(1).Return(result, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+
+				var rsp pb.CreateTransferResponse
+				err := protojson.Unmarshal(recorder.Body.Bytes(), &rsp)
+				assert.NoError(t, err)
+				assert.Equal(t, result.Transfer.ID, rsp.Transfer.Id)
+			},
+		},
+		{
+			name:           "IdempotentReplay",
+			username:       sender.Owner,
+			body:           body,
+			idempotencyKey: idempotencyKey,
+			buildStubs: func(store *mocks.Store) {
+				arg := transferArg
+				arg.IdempotencyKey = idempotencyKey
+				replayed := result
+				replayed.Replayed = true
+
+				store.EXPECT().GetAccount(mock.Anything, sender.ID).Times(1).Return(sender, nil)
+				store.EXPECT().GetAccount(mock.Anything, receiver.ID).Times(1).Return(receiver, nil)
+				store.EXPECT().TransferTx(mock.Anything, arg).Times(1).Return(replayed, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+				assert.Equal(t, "true", recorder.Header().Get(runtime.MetadataHeaderPrefix+idempotentReplayedKey))
+			},
+		},
+		{
+			name:           "InvalidIdempotencyKey",
+			username:       sender.Owner,
+			body:           body,
+			idempotencyKey: strings.Repeat("k", 256),
+			buildStubs:     func(store *mocks.Store) {},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+				assertFieldViolation(t, recorder, idempotencyKeyKey)
+			},
+		},
+		{
+			name:           "IdempotencyKeyReused",
+			username:       sender.Owner,
+			body:           body,
+			idempotencyKey: idempotencyKey,
+			buildStubs: func(store *mocks.Store) {
+				arg := transferArg
+				arg.IdempotencyKey = idempotencyKey
+
+				store.EXPECT().GetAccount(mock.Anything, sender.ID).Times(1).Return(sender, nil)
+				store.EXPECT().GetAccount(mock.Anything, receiver.ID).Times(1).Return(receiver, nil)
+				store.EXPECT().TransferTx(mock.Anything, arg).Times(1).Return(db.TransferTxResult{}, db.ErrIdempotencyKeyReused)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code) // failed precondition
+				assertPreconditionViolation(t, recorder, "IDEMPOTENCY_KEY_REUSED", idempotencyKeyKey)
+			},
+		},
+		{
+			name:       "InvalidAmount",
+			username:   sender.Owner,
+			body:       fmt.Sprintf(`{"from_account_id": %d, "to_account_id": %d, "amount": 0, "currency": %q}`, sender.ID, receiver.ID, util.USD),
+			buildStubs: func(store *mocks.Store) {},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+				assertFieldViolation(t, recorder, "amount")
+			},
+		},
+		{
+			name:     "FromAccountNotFound",
+			username: sender.Owner,
+			body:     body,
+			buildStubs: func(store *mocks.Store) {
+				store.EXPECT().GetAccount(mock.Anything, sender.ID).Times(1).Return(db.Account{}, sql.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusNotFound, recorder.Code)
+				assertResourceInfo(t, recorder, codes.NotFound, resourceTypeAccount, strconv.FormatInt(sender.ID, 10))
+			},
+		},
+		{
+			name:     "ToAccountNotFound",
+			username: sender.Owner,
+			body:     body,
+			buildStubs: func(store *mocks.Store) {
+				store.EXPECT().GetAccount(mock.Anything, sender.ID).Times(1).Return(sender, nil)
+				store.EXPECT().GetAccount(mock.Anything, receiver.ID).Times(1).Return(db.Account{}, sql.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusNotFound, recorder.Code)
+				assertResourceInfo(t, recorder, codes.NotFound, resourceTypeAccount, strconv.FormatInt(receiver.ID, 10))
+			},
+		},
+		{
+			name:     "CurrencyMismatch",
+			username: sender.Owner,
+			body:     fmt.Sprintf(`{"from_account_id": %d, "to_account_id": %d, "amount": %d, "currency": %q}`, sender.ID, foreign.ID, amount, util.USD),
+			buildStubs: func(store *mocks.Store) {
+				store.EXPECT().GetAccount(mock.Anything, sender.ID).Times(1).Return(sender, nil)
+				store.EXPECT().GetAccount(mock.Anything, foreign.ID).Times(1).Return(foreign, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code) // failed precondition
+				assertPreconditionViolation(t, recorder, "CURRENCY_MISMATCH", accountSubject(foreign.ID))
+			},
+		},
+		{
+			name:     "NotOwner",
+			username: receiver.Owner,
+			body:     body,
+			buildStubs: func(store *mocks.Store) {
+				store.EXPECT().GetAccount(mock.Anything, sender.ID).Times(1).Return(sender, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusForbidden, recorder.Code)
+				assertResourceInfo(t, recorder, codes.PermissionDenied, resourceTypeAccount, strconv.FormatInt(sender.ID, 10))
+			},
+		},
+		{
+			name:     "InsufficientFunds",
+			username: sender.Owner,
+			body:     body,
+			buildStubs: func(store *mocks.Store) {
+				store.EXPECT().GetAccount(mock.Anything, sender.ID).Times(1).Return(sender, nil)
+				store.EXPECT().GetAccount(mock.Anything, receiver.ID).Times(1).Return(receiver, nil)
+				store.EXPECT().TransferTx(mock.Anything, transferArg).Times(1).Return(db.TransferTxResult{}, db.ErrInsufficientFunds)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code) // failed precondition
+				assertPreconditionViolation(t, recorder, "INSUFFICIENT_FUNDS", accountSubject(sender.ID))
+			},
+		},
+		{
+			name:     "ReceiverFrozen",
+			username: sender.Owner,
+			body:     body,
+			buildStubs: func(store *mocks.Store) {
+				store.EXPECT().GetAccount(mock.Anything, sender.ID).Times(1).Return(sender, nil)
+				store.EXPECT().GetAccount(mock.Anything, receiver.ID).Times(1).Return(receiver, nil)
+				store.EXPECT().
+					TransferTx(mock.Anything, transferArg).
+					Times(1).
+					Return(db.TransferTxResult{}, &db.AccountStatusError{AccountID: receiver.ID, Status: db.AccountFrozen})
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code) // failed precondition
+				assertPreconditionViolation(t, recorder, "ACCOUNT_FROZEN", accountSubject(receiver.ID))
+			},
+		},
+		{
+			name:     "RateNotFound",
+			username: sender.Owner,
+			body:     fxBody,
+			buildStubs: func(store *mocks.Store) {
+				store.EXPECT().GetAccount(mock.Anything, sender.ID).Times(1).Return(sender, nil)
+				store.EXPECT().GetAccount(mock.Anything, foreign.ID).Times(1).Return(foreign, nil)
+				store.EXPECT().
+					CrossCurrencyTransferTx(mock.Anything, matchFxTransferParams).
+					Times(1).
+					Return(db.TransferTxResult{}, fx.ErrRateNotFound)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code) // failed precondition
+				assertPreconditionViolation(t, recorder, "EXCHANGE_RATE_NOT_FOUND", fmt.Sprintf("%s/%s", util.USD, util.JPY))
+			},
+		},
+		{
+			name:     "TooSmallToConvert",
+			username: sender.Owner,
+			body:     fxBody,
+			buildStubs: func(store *mocks.Store) {
+				store.EXPECT().GetAccount(mock.Anything, sender.ID).Times(1).Return(sender, nil)
+				store.EXPECT().GetAccount(mock.Anything, foreign.ID).Times(1).Return(foreign, nil)
+				store.EXPECT().
+					CrossCurrencyTransferTx(mock.Anything, matchFxTransferParams).
+					Times(1).
+					Return(db.TransferTxResult{}, db.ErrTransferTooSmall)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+				assertFieldViolation(t, recorder, "amount")
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := mocks.NewStore(t)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			mux := runtime.NewServeMux(runtime.WithIncomingHeaderMatcher(GatewayHeaderMatcher))
+			err := pb.RegisterSimpleBankHandlerServer(context.Background(), mux, NewGatewayServer(server))
+			assert.NoError(t, err)
+
+			accessToken, _, err := server.tokenMaker.CreateToken(tc.username, util.DepositorRole, time.Minute)
+			assert.NoError(t, err)
+
+			request := httptest.NewRequest(http.MethodPost, "/v1/create_transfer", strings.NewReader(tc.body))
+			request.Header.Set("Authorization", fmt.Sprintf("%s %s", authorizationBearer, accessToken))
+			if len(tc.idempotencyKey) > 0 {
+				request.Header.Set("Idempotency-Key", tc.idempotencyKey)
+			}
+			recorder := httptest.NewRecorder()
+			mux.ServeHTTP(recorder, request)
+
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
@@ -34,7 +34,7 @@ func (server *Server) CreateUser(ctx context.Context, req *pb.CreateUserRequest)
 			FullName:       req.GetFullName(),
 			Email:          req.GetEmail(),
 		},
-		AfterCreate: func(user db.User) error {
+		AfterCreate: func(user db.User) ([]db.CreateOutboxParams, error) {
 			taskPayload := &worker.PayloadSendVerifyEmail{
 				Username: user.Username,
 			}
@@ -43,7 +43,12 @@ func (server *Server) CreateUser(ctx context.Context, req *pb.CreateUserRequest)
 				asynq.ProcessIn(10 * time.Second), // 10 seconds delay
 				asynq.Queue(worker.QueueCritical), // add critical instead of default
 			}
-			return server.taskDistributor.DistributeTaskSendVerifyEmail(ctx, taskPayload, opts...)
+			// enqueued by the outbox relay once the user is committed
+			task, err := worker.NewTaskSendVerifyEmail(ctx, taskPayload, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return []db.CreateOutboxParams{task}, nil
 		},
 	}
 
@@ -0,0 +1,21 @@
+package gapi
+
+import (
+	"context"
+
+	db "github.com/tgfukuda/be-master/db/sqlc"
+	"github.com/tgfukuda/be-master/pb"
+)
+
+// DeleteAccount closes the account. the row is kept since its entries and transfers refer to it
+func (server *Server) DeleteAccount(ctx context.Context, req *pb.DeleteAccountRequest) (*pb.DeleteAccountResponse, error) {
+	account, err := server.updateAccountStatus(ctx, req.GetId(), db.AccountActive, db.AccountClosed, "", false)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := &pb.DeleteAccountResponse{
+		Account: account,
+	}
+	return rsp, nil
+}
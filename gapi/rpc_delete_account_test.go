@@ -0,0 +1,138 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	db "github.com/tgfukuda/be-master/db/sqlc"
+	"github.com/tgfukuda/be-master/mocks"
+	"github.com/tgfukuda/be-master/pb"
+	"github.com/tgfukuda/be-master/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func TestDeleteAccount(t *testing.T) {
+	account := db.Account{ID: util.RandomInt(1, 1000), Owner: util.RandomOwner(), Currency: util.USD, Status: db.AccountActive}
+	closed := account
+	closed.Status = db.AccountClosed
+	body := fmt.Sprintf(`{"id": %d}`, account.ID)
+
+	testCases := []struct {
+		name          string
+		username      string
+		role          string
+		body          string
+		buildStubs    func(store *mocks.Store)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:     "OK",
+			username: account.Owner,
+			role:     util.DepositorRole,
+			body:     body,
+			buildStubs: func(store *mocks.Store) {
+				store.EXPECT().GetAccount(mock.Anything, account.ID).Times(1).Return(account, nil)
+				store.EXPECT().
+					UpdateAccountStatusTx(mock.Anything, db.UpdateAccountStatusTxParams{
+						AccountID:  account.ID,
+						FromStatus: db.AccountActive,
+						Status:     db.AccountClosed,
+						Actor:      account.Owner,
+					}).
+					Times(1).
+					Return(db.UpdateAccountStatusTxResult{Account: closed}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+
+				var rsp pb.DeleteAccountResponse
+				err := protojson.Unmarshal(recorder.Body.Bytes(), &rsp)
+				assert.NoError(t, err)
+				assert.Equal(t, db.AccountClosed, rsp.Account.Status)
+			},
+		},
+		{
+			name:     "OtherUser",
+			username: util.RandomOwner(),
+			role:     util.DepositorRole,
+			body:     body,
+			buildStubs: func(store *mocks.Store) {
+				store.EXPECT().GetAccount(mock.Anything, account.ID).Times(1).Return(account, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusForbidden, recorder.Code)
+				assertResourceInfo(t, recorder, codes.PermissionDenied, resourceTypeAccount, strconv.FormatInt(account.ID, 10))
+			},
+		},
+		{
+			// staff can freeze the account of others but only the owner can close it
+			name:     "Banker",
+			username: util.RandomOwner(),
+			role:     util.BankerRole,
+			body:     body,
+			buildStubs: func(store *mocks.Store) {
+				store.EXPECT().GetAccount(mock.Anything, account.ID).Times(1).Return(account, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name:     "NotFound",
+			username: account.Owner,
+			role:     util.DepositorRole,
+			body:     body,
+			buildStubs: func(store *mocks.Store) {
+				store.EXPECT().GetAccount(mock.Anything, account.ID).Times(1).Return(db.Account{}, sql.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusNotFound, recorder.Code)
+				assertResourceInfo(t, recorder, codes.NotFound, resourceTypeAccount, strconv.FormatInt(account.ID, 10))
+			},
+		},
+		{
+			name:       "InvalidID",
+			username:   account.Owner,
+			role:       util.DepositorRole,
+			body:       `{"id": -1}`,
+			buildStubs: func(store *mocks.Store) {},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+				assertFieldViolation(t, recorder, "id")
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := mocks.NewStore(t)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			mux := runtime.NewServeMux()
+			err := pb.RegisterSimpleBankHandlerServer(context.Background(), mux, NewGatewayServer(server))
+			assert.NoError(t, err)
+
+			accessToken, _, err := server.tokenMaker.CreateToken(tc.username, tc.role, time.Minute)
+			assert.NoError(t, err)
+
+			request := httptest.NewRequest(http.MethodPost, "/v1/delete_account", strings.NewReader(tc.body))
+			request.Header.Set("Authorization", fmt.Sprintf("%s %s", authorizationBearer, accessToken))
+			recorder := httptest.NewRecorder()
+			mux.ServeHTTP(recorder, request)
+
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
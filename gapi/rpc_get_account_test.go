@@ -0,0 +1,121 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	db "github.com/tgfukuda/be-master/db/sqlc"
+	"github.com/tgfukuda/be-master/mocks"
+	"github.com/tgfukuda/be-master/pb"
+	"github.com/tgfukuda/be-master/util"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func TestGetAccount(t *testing.T) {
+	account := db.Account{ID: util.RandomInt(1, 1000), Owner: util.RandomOwner(), Currency: util.USD, Status: db.AccountActive}
+	path := fmt.Sprintf("/v1/get_account?id=%d", account.ID)
+
+	testCases := []struct {
+		name          string
+		username      string
+		role          string
+		path          string
+		buildStubs    func(store *mocks.Store)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:     "OK",
+			username: account.Owner,
+			role:     util.DepositorRole,
+			path:     path,
+			buildStubs: func(store *mocks.Store) {
+				store.EXPECT().GetAccount(mock.Anything, account.ID).Times(1).Return(account, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+
+				var rsp pb.GetAccountResponse
+				err := protojson.Unmarshal(recorder.Body.Bytes(), &rsp)
+				assert.NoError(t, err)
+				assert.Equal(t, account.ID, rsp.Account.Id)
+			},
+		},
+		{
+			name:     "OtherUser",
+			username: util.RandomOwner(),
+			role:     util.DepositorRole,
+			path:     path,
+			buildStubs: func(store *mocks.Store) {
+				store.EXPECT().GetAccount(mock.Anything, account.ID).Times(1).Return(account, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name:     "Banker",
+			username: util.RandomOwner(),
+			role:     util.BankerRole,
+			path:     path,
+			buildStubs: func(store *mocks.Store) {
+				store.EXPECT().GetAccount(mock.Anything, account.ID).Times(1).Return(account, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:     "NotFound",
+			username: account.Owner,
+			role:     util.DepositorRole,
+			path:     path,
+			buildStubs: func(store *mocks.Store) {
+				store.EXPECT().GetAccount(mock.Anything, account.ID).Times(1).Return(db.Account{}, sql.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name:       "InvalidID",
+			username:   account.Owner,
+			role:       util.DepositorRole,
+			path:       "/v1/get_account?id=0",
+			buildStubs: func(store *mocks.Store) {},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+				assertFieldViolation(t, recorder, "id")
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := mocks.NewStore(t)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			mux := runtime.NewServeMux()
+			err := pb.RegisterSimpleBankHandlerServer(context.Background(), mux, NewGatewayServer(server))
+			assert.NoError(t, err)
+
+			accessToken, _, err := server.tokenMaker.CreateToken(tc.username, tc.role, time.Minute)
+			assert.NoError(t, err)
+
+			request := httptest.NewRequest(http.MethodGet, tc.path, nil)
+			request.Header.Set("Authorization", fmt.Sprintf("%s %s", authorizationBearer, accessToken))
+			recorder := httptest.NewRecorder()
+			mux.ServeHTTP(recorder, request)
+
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
@@ -0,0 +1,133 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	db "github.com/tgfukuda/be-master/db/sqlc"
+	"github.com/tgfukuda/be-master/pb"
+	"github.com/tgfukuda/be-master/token"
+	"github.com/tgfukuda/be-master/util"
+	"github.com/tgfukuda/be-master/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	maxStatementPeriod = 366 * 24 * time.Hour
+	// a longer statement must be split into shorter ranges
+	maxStatementLines = 10000
+)
+
+func (server *Server) GetStatement(ctx context.Context, req *pb.GetStatementRequest) (*pb.GetStatementResponse, error) {
+	authPayload, err := authPayloadFromContext(ctx)
+	if err != nil {
+		return nil, unauthorizedError(err)
+	}
+
+	violations := validateGetStatementRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	account, err := server.getReadableAccount(ctx, authPayload, req.GetAccountId())
+	if err != nil {
+		return nil, err
+	}
+
+	statement, err := server.store.GetStatementTx(ctx, db.GetStatementTxParams{
+		AccountID: account.ID,
+		StartTime: req.GetStartTime().AsTime(),
+		EndTime:   req.GetEndTime().AsTime(),
+		MaxLines:  maxStatementLines,
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrStatementTooLong) {
+			return nil, invalidArgumentError([]*errdetails.BadRequest_FieldViolation{
+				fieldViolation("end_time", fmt.Errorf("must be earlier to have at most %d entries", maxStatementLines)),
+			})
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to get statement: %s", err)
+	}
+
+	rsp := &pb.GetStatementResponse{
+		AccountId:      statement.Account.ID,
+		Owner:          statement.Account.Owner,
+		Currency:       statement.Account.Currency,
+		StartTime:      req.GetStartTime(),
+		EndTime:        req.GetEndTime(),
+		OpeningBalance: statement.OpeningBalance,
+		ClosingBalance: statement.ClosingBalance,
+		Lines:          make([]*pb.StatementLine, 0, len(statement.Lines)),
+	}
+	for _, line := range statement.Lines {
+		rsp.Lines = append(rsp.Lines, convertStatementLine(line))
+	}
+	return rsp, nil
+}
+
+func validateGetStatementRequest(req *pb.GetStatementRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateAccountId(req.GetAccountId()); err != nil {
+		violations = append(violations, fieldViolation("account_id", err))
+	}
+
+	if err := validateTimestamp(req.GetStartTime()); err != nil {
+		violations = append(violations, fieldViolation("start_time", err))
+	}
+
+	if err := validateTimestamp(req.GetEndTime()); err != nil {
+		violations = append(violations, fieldViolation("end_time", err))
+	} else if violations == nil {
+		period := req.GetEndTime().AsTime().Sub(req.GetStartTime().AsTime())
+		if period <= 0 {
+			violations = append(violations, fieldViolation("end_time", errors.New("must be later than start_time")))
+		} else if period > maxStatementPeriod {
+			violations = append(violations, fieldViolation("end_time", fmt.Errorf("must be within %s from start_time", maxStatementPeriod)))
+		}
+	}
+
+	return violations
+}
+
+func validateTimestamp(value *timestamppb.Timestamp) error {
+	if value == nil {
+		return errors.New("is required")
+	}
+
+	return value.CheckValid()
+}
+
+// getReadableAccount returns the account owned by the user. staff can read any account.
+// the returned error is already a gRPC status.
+func (server *Server) getReadableAccount(ctx context.Context, authPayload *token.Payload, id int64) (db.Account, error) {
+	account, err := server.store.GetAccount(ctx, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return account, notFoundError(
+				resourceTypeAccount,
+				strconv.FormatInt(id, 10),
+				fmt.Sprintf("account [%d] doesn't exist", id),
+			)
+		}
+
+		return account, status.Errorf(codes.Internal, "failed to get account: %s", err)
+	}
+
+	if account.Owner != authPayload.Username && authPayload.CheckRole(util.StaffRoles) != nil {
+		return account, permissionDeniedError(
+			resourceTypeAccount,
+			strconv.FormatInt(account.ID, 10),
+			account.Owner,
+			"account doesn't belong to the authenticated user",
+		)
+	}
+
+	return account, nil
+}
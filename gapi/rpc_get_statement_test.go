@@ -0,0 +1,264 @@
+package gapi
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/csv"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	db "github.com/tgfukuda/be-master/db/sqlc"
+	"github.com/tgfukuda/be-master/mocks"
+	"github.com/tgfukuda/be-master/pb"
+	"github.com/tgfukuda/be-master/util"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func TestGetStatement(t *testing.T) {
+	account := db.Account{ID: util.RandomInt(1, 1000), Owner: util.RandomOwner(), Currency: util.USD}
+	startTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	endTime := startTime.AddDate(0, 1, 0)
+
+	counterparty := util.RandomOwner()
+	statement := db.GetStatementTxResult{
+		Account:        account,
+		OpeningBalance: 100,
+		ClosingBalance: 70,
+		Lines: []db.StatementLine{
+			{
+				ListStatementEntriesRow: db.ListStatementEntriesRow{
+					ID:                    1,
+					AccountID:             account.ID,
+					Amount:                -50,
+					CreatedAt:             startTime.Add(time.Hour),
+					JournalID:             1,
+					TransferID:            sql.NullInt64{Int64: 1, Valid: true},
+					CounterpartyAccountID: sql.NullInt64{Int64: account.ID + 1, Valid: true},
+					CounterpartyOwner:     sql.NullString{String: counterparty, Valid: true},
+				},
+				Balance: 50,
+			},
+			{
+				ListStatementEntriesRow: db.ListStatementEntriesRow{
+					ID:        2,
+					AccountID: account.ID,
+					Amount:    20,
+					CreatedAt: startTime.Add(2 * time.Hour),
+					JournalID: 2,
+				},
+				Balance: 70,
+			},
+		},
+	}
+	arg := db.GetStatementTxParams{
+		AccountID: account.ID,
+		StartTime: startTime,
+		EndTime:   endTime,
+		MaxLines:  maxStatementLines,
+	}
+
+	query := func(start, end time.Time) url.Values {
+		return url.Values{
+			"account_id": {fmt.Sprint(account.ID)},
+			"start_time": {start.Format(time.RFC3339)},
+			"end_time":   {end.Format(time.RFC3339)},
+		}
+	}
+
+	testCases := []struct {
+		name          string
+		username      string
+		role          string
+		query         url.Values
+		accept        string
+		buildStubs    func(store *mocks.Store)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:     "OK",
+			username: account.Owner,
+			role:     util.DepositorRole,
+			query:    query(startTime, endTime),
+			buildStubs: func(store *mocks.Store) {
+				store.EXPECT().GetAccount(mock.Anything, account.ID).Times(1).Return(account, nil)
+				store.EXPECT().GetStatementTx(mock.Anything, arg).Times(1).Return(statement, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+				assert.Empty(t, recorder.Header().Get("Content-Disposition"))
+
+				var rsp pb.GetStatementResponse
+				err := protojson.Unmarshal(recorder.Body.Bytes(), &rsp)
+				assert.NoError(t, err)
+				assert.Equal(t, account.ID, rsp.AccountId)
+				assert.EqualValues(t, 100, rsp.OpeningBalance)
+				assert.EqualValues(t, 70, rsp.ClosingBalance)
+				assert.Len(t, rsp.Lines, 2)
+				assert.Equal(t, counterparty, rsp.Lines[0].CounterpartyOwner)
+				assert.EqualValues(t, 50, rsp.Lines[0].Balance)
+				assert.Zero(t, rsp.Lines[1].TransferId)
+			},
+		},
+		{
+			name:     "CSV",
+			username: account.Owner,
+			role:     util.DepositorRole,
+			query:    query(startTime, endTime),
+			accept:   MIMEStatementCSV,
+			buildStubs: func(store *mocks.Store) {
+				store.EXPECT().GetAccount(mock.Anything, account.ID).Times(1).Return(account, nil)
+				store.EXPECT().GetStatementTx(mock.Anything, arg).Times(1).Return(statement, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+				assert.Equal(t, MIMEStatementCSV, recorder.Header().Get("Content-Type"))
+				assert.Contains(t, recorder.Header().Get("Content-Disposition"), ".csv")
+
+				records, err := csv.NewReader(recorder.Body).ReadAll()
+				assert.NoError(t, err)
+				assert.Len(t, records, 5)
+				assert.Equal(t, statementCSVHeader, records[0])
+				assert.Equal(t, []string{"opening", "2024-01-01T00:00:00Z", "", "", "", "", "", "", "100"}, records[1])
+				assert.Equal(t, []string{"entry", "2024-01-01T01:00:00Z", "1", "1", "1", fmt.Sprint(account.ID + 1), counterparty, "-50", "50"}, records[2])
+				assert.Equal(t, []string{"entry", "2024-01-01T02:00:00Z", "2", "2", "0", "0", "", "20", "70"}, records[3])
+				assert.Equal(t, []string{"closing", "2024-02-01T00:00:00Z", "", "", "", "", "", "", "70"}, records[4])
+			},
+		},
+		{
+			name:     "JSONLines",
+			username: account.Owner,
+			role:     util.DepositorRole,
+			query:    query(startTime, endTime),
+			accept:   MIMEStatementJSONLines,
+			buildStubs: func(store *mocks.Store) {
+				store.EXPECT().GetAccount(mock.Anything, account.ID).Times(1).Return(account, nil)
+				store.EXPECT().GetStatementTx(mock.Anything, arg).Times(1).Return(statement, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+				assert.Equal(t, MIMEStatementJSONLines, recorder.Header().Get("Content-Type"))
+				assert.Contains(t, recorder.Header().Get("Content-Disposition"), ".jsonl")
+
+				scanner := bufio.NewScanner(bytes.NewReader(recorder.Body.Bytes()))
+				assert.True(t, scanner.Scan())
+
+				var summary pb.GetStatementResponse
+				err := protojson.Unmarshal(scanner.Bytes(), &summary)
+				assert.NoError(t, err)
+				assert.EqualValues(t, 100, summary.OpeningBalance)
+				assert.EqualValues(t, 70, summary.ClosingBalance)
+				assert.Empty(t, summary.Lines)
+
+				var balances []int64
+				for scanner.Scan() {
+					var line pb.StatementLine
+					err := protojson.Unmarshal(scanner.Bytes(), &line)
+					assert.NoError(t, err)
+					balances = append(balances, line.Balance)
+				}
+				assert.Equal(t, []int64{50, 70}, balances)
+			},
+		},
+		{
+			name:     "Banker",
+			username: util.RandomOwner(),
+			role:     util.BankerRole,
+			query:    query(startTime, endTime),
+			buildStubs: func(store *mocks.Store) {
+				store.EXPECT().GetAccount(mock.Anything, account.ID).Times(1).Return(account, nil)
+				store.EXPECT().GetStatementTx(mock.Anything, arg).Times(1).Return(statement, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:     "OtherUser",
+			username: util.RandomOwner(),
+			role:     util.DepositorRole,
+			query:    query(startTime, endTime),
+			accept:   MIMEStatementCSV,
+			buildStubs: func(store *mocks.Store) {
+				store.EXPECT().GetAccount(mock.Anything, account.ID).Times(1).Return(account, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				// the errors are still in json
+				assert.Equal(t, http.StatusForbidden, recorder.Code)
+				assert.Equal(t, "application/json", recorder.Header().Get("Content-Type"))
+			},
+		},
+		{
+			name:       "EndBeforeStart",
+			username:   account.Owner,
+			role:       util.DepositorRole,
+			query:      query(endTime, startTime),
+			buildStubs: func(store *mocks.Store) {},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:       "PeriodTooLong",
+			username:   account.Owner,
+			role:       util.DepositorRole,
+			query:      query(startTime, startTime.AddDate(2, 0, 0)),
+			buildStubs: func(store *mocks.Store) {},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:     "TooManyEntries",
+			username: account.Owner,
+			role:     util.DepositorRole,
+			query:    query(startTime, endTime),
+			buildStubs: func(store *mocks.Store) {
+				store.EXPECT().GetAccount(mock.Anything, account.ID).Times(1).Return(account, nil)
+				store.EXPECT().GetStatementTx(mock.Anything, arg).Times(1).Return(db.GetStatementTxResult{}, db.ErrStatementTooLong)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := mocks.NewStore(t)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			jsonMarshaler := &runtime.JSONPb{}
+			mux := runtime.NewServeMux(
+				runtime.WithMarshalerOption(runtime.MIMEWildcard, jsonMarshaler),
+				runtime.WithMarshalerOption(MIMEStatementCSV, NewStatementCSVMarshaler(jsonMarshaler)),
+				runtime.WithMarshalerOption(MIMEStatementJSONLines, NewStatementJSONLinesMarshaler(jsonMarshaler)),
+				runtime.WithForwardResponseOption(StatementAttachment),
+			)
+			err := pb.RegisterSimpleBankHandlerServer(context.Background(), mux, NewGatewayServer(server))
+			assert.NoError(t, err)
+
+			accessToken, _, err := server.tokenMaker.CreateToken(tc.username, tc.role, time.Minute)
+			assert.NoError(t, err)
+
+			request := httptest.NewRequest(http.MethodGet, "/v1/get_statement?"+tc.query.Encode(), nil)
+			request.Header.Set("Authorization", fmt.Sprintf("%s %s", authorizationBearer, accessToken))
+			if tc.accept != "" {
+				request.Header.Set("Accept", tc.accept)
+			}
+			recorder := httptest.NewRecorder()
+			mux.ServeHTTP(recorder, request)
+
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
@@ -0,0 +1,89 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+
+	db "github.com/tgfukuda/be-master/db/sqlc"
+	"github.com/tgfukuda/be-master/pb"
+	"github.com/tgfukuda/be-master/util"
+	"github.com/tgfukuda/be-master/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) ListAccountTransfers(ctx context.Context, req *pb.ListAccountTransfersRequest) (*pb.ListAccountTransfersResponse, error) {
+	authPayload, err := authPayloadFromContext(ctx)
+	if err != nil {
+		return nil, unauthorizedError(err)
+	}
+
+	violations := validateListAccountTransfersRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	account, err := server.getReadableAccount(ctx, authPayload, req.GetAccountId())
+	if err != nil {
+		return nil, err
+	}
+
+	// the token of another account or direction is rejected
+	query := fmt.Sprintf("account_transfers:%d:%s", account.ID, req.GetDirection())
+	cursor, err := util.DecodePageToken(req.GetPageToken(), query)
+	if err != nil {
+		return nil, invalidArgumentError([]*errdetails.BadRequest_FieldViolation{fieldViolation("page_token", err)})
+	}
+
+	pageSize := server.pageSize(req.GetPageSize())
+	// one more row tells whether there is the next page
+	var transfers []db.Transfer
+	if req.GetDirection() == transferDirectionOutgoing {
+		transfers, err = server.store.ListTransfersFrom(ctx, db.ListTransfersFromParams{
+			FromAccountID:  account.ID,
+			AfterCreatedAt: cursor.CreatedAt,
+			AfterID:        cursor.ID,
+			LimitCount:     pageSize + 1,
+		})
+	} else {
+		transfers, err = server.store.ListTransfersTo(ctx, db.ListTransfersToParams{
+			ToAccountID:    account.ID,
+			AfterCreatedAt: cursor.CreatedAt,
+			AfterID:        cursor.ID,
+			LimitCount:     pageSize + 1,
+		})
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list transfers: %s", err)
+	}
+
+	rsp := &pb.ListAccountTransfersResponse{}
+	transfers, rsp.NextPageToken = util.NextPage(transfers, pageSize, func(transfer db.Transfer) util.PageCursor {
+		return util.PageCursor{CreatedAt: transfer.CreatedAt, ID: transfer.ID}
+	}, query)
+
+	rsp.Transfers = make([]*pb.Transfer, 0, len(transfers))
+	for _, transfer := range transfers {
+		rsp.Transfers = append(rsp.Transfers, convertTransfer(transfer))
+	}
+	return rsp, nil
+}
+
+func validateListAccountTransfersRequest(req *pb.ListAccountTransfersRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateAccountId(req.GetAccountId()); err != nil {
+		violations = append(violations, fieldViolation("account_id", err))
+	}
+
+	switch req.GetDirection() {
+	case transferDirectionOutgoing, transferDirectionIncoming:
+	default:
+		violations = append(violations, fieldViolation("direction", fmt.Errorf("must be %s or %s", transferDirectionOutgoing, transferDirectionIncoming)))
+	}
+
+	if err := val.ValidateListPageSize(req.GetPageSize()); err != nil {
+		violations = append(violations, fieldViolation("page_size", err))
+	}
+
+	return violations
+}
@@ -0,0 +1,144 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	db "github.com/tgfukuda/be-master/db/sqlc"
+	"github.com/tgfukuda/be-master/mocks"
+	"github.com/tgfukuda/be-master/pb"
+	"github.com/tgfukuda/be-master/util"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func TestListAccountTransfers(t *testing.T) {
+	account := db.Account{ID: util.RandomInt(1, 1000), Owner: util.RandomOwner(), Currency: util.USD, Status: db.AccountActive}
+	transfers := make([]db.Transfer, 3)
+	for i := range transfers {
+		transfers[i] = db.Transfer{
+			ID:            int64(i + 1),
+			FromAccountID: account.ID,
+			ToAccountID:   account.ID + 1,
+			Amount:        10,
+			ToAmount:      10,
+			CreatedAt:     time.Date(2023, 6, 1, 0, 0, i, 0, time.UTC),
+		}
+	}
+
+	outgoingQuery := fmt.Sprintf("account_transfers:%d:%s", account.ID, transferDirectionOutgoing)
+	pageToken := util.EncodePageToken(util.PageCursor{CreatedAt: transfers[1].CreatedAt, ID: transfers[1].ID}, outgoingQuery)
+
+	testCases := []struct {
+		name          string
+		path          string
+		buildStubs    func(store *mocks.Store)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "Outgoing",
+			path: fmt.Sprintf("/v1/list_account_transfers?account_id=%d&direction=outgoing&page_size=2", account.ID),
+			buildStubs: func(store *mocks.Store) {
+				store.EXPECT().GetAccount(mock.Anything, account.ID).Times(1).Return(account, nil)
+				store.EXPECT().
+					ListTransfersFrom(mock.Anything, db.ListTransfersFromParams{FromAccountID: account.ID, LimitCount: 3}).
+					Times(1).
+					Return(transfers, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+
+				var rsp pb.ListAccountTransfersResponse
+				err := protojson.Unmarshal(recorder.Body.Bytes(), &rsp)
+				assert.NoError(t, err)
+				assert.Len(t, rsp.Transfers, 2)
+				assert.Equal(t, pageToken, rsp.NextPageToken)
+			},
+		},
+		{
+			name: "OutgoingLastPage",
+			path: fmt.Sprintf("/v1/list_account_transfers?account_id=%d&direction=outgoing&page_size=2&page_token=%s", account.ID, pageToken),
+			buildStubs: func(store *mocks.Store) {
+				store.EXPECT().GetAccount(mock.Anything, account.ID).Times(1).Return(account, nil)
+				store.EXPECT().
+					ListTransfersFrom(mock.Anything, mock.MatchedBy(func(arg db.ListTransfersFromParams) bool {
+						return arg.AfterCreatedAt.Equal(transfers[1].CreatedAt) && arg.AfterID == transfers[1].ID && arg.LimitCount == 3
+					})).
+					Times(1).
+					Return(transfers[2:], nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+
+				var rsp pb.ListAccountTransfersResponse
+				err := protojson.Unmarshal(recorder.Body.Bytes(), &rsp)
+				assert.NoError(t, err)
+				assert.Len(t, rsp.Transfers, 1)
+				assert.Empty(t, rsp.NextPageToken)
+			},
+		},
+		{
+			name: "Incoming",
+			path: fmt.Sprintf("/v1/list_account_transfers?account_id=%d&direction=incoming", account.ID),
+			buildStubs: func(store *mocks.Store) {
+				store.EXPECT().GetAccount(mock.Anything, account.ID).Times(1).Return(account, nil)
+				store.EXPECT().
+					ListTransfersTo(mock.Anything, db.ListTransfersToParams{ToAccountID: account.ID, LimitCount: 6}).
+					Times(1).
+					Return([]db.Transfer{}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			// the token is bound to the direction
+			name: "PageTokenOfAnotherDirection",
+			path: fmt.Sprintf("/v1/list_account_transfers?account_id=%d&direction=incoming&page_token=%s", account.ID, pageToken),
+			buildStubs: func(store *mocks.Store) {
+				store.EXPECT().GetAccount(mock.Anything, account.ID).Times(1).Return(account, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+				assertFieldViolation(t, recorder, "page_token")
+			},
+		},
+		{
+			name:       "NoDirection",
+			path:       fmt.Sprintf("/v1/list_account_transfers?account_id=%d", account.ID),
+			buildStubs: func(store *mocks.Store) {},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+				assertFieldViolation(t, recorder, "direction")
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := mocks.NewStore(t)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			mux := runtime.NewServeMux()
+			err := pb.RegisterSimpleBankHandlerServer(context.Background(), mux, NewGatewayServer(server))
+			assert.NoError(t, err)
+
+			accessToken, _, err := server.tokenMaker.CreateToken(account.Owner, util.DepositorRole, time.Minute)
+			assert.NoError(t, err)
+
+			request := httptest.NewRequest(http.MethodGet, tc.path, nil)
+			request.Header.Set("Authorization", fmt.Sprintf("%s %s", authorizationBearer, accessToken))
+			recorder := httptest.NewRecorder()
+			mux.ServeHTTP(recorder, request)
+
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
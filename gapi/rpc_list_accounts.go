@@ -0,0 +1,68 @@
+package gapi
+
+import (
+	"context"
+
+	db "github.com/tgfukuda/be-master/db/sqlc"
+	"github.com/tgfukuda/be-master/pb"
+	"github.com/tgfukuda/be-master/util"
+	"github.com/tgfukuda/be-master/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) ListAccounts(ctx context.Context, req *pb.ListAccountsRequest) (*pb.ListAccountsResponse, error) {
+	authPayload, err := authPayloadFromContext(ctx)
+	if err != nil {
+		return nil, unauthorizedError(err)
+	}
+
+	violations := validateListAccountsRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	// the token of another user's list is rejected
+	query := "accounts:" + authPayload.Username
+	cursor, err := util.DecodePageToken(req.GetPageToken(), query)
+	if err != nil {
+		return nil, invalidArgumentError([]*errdetails.BadRequest_FieldViolation{fieldViolation("page_token", err)})
+	}
+
+	pageSize := server.pageSize(req.GetPageSize())
+	// one more row tells whether there is the next page
+	accounts, err := server.store.ListAccounts(ctx, db.ListAccountsParams{
+		Owner:          authPayload.Username,
+		AfterCreatedAt: cursor.CreatedAt,
+		AfterID:        cursor.ID,
+		LimitCount:     pageSize + 1,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list accounts: %s", err)
+	}
+
+	rsp := &pb.ListAccountsResponse{}
+	accounts, rsp.NextPageToken = util.NextPage(accounts, pageSize, func(account db.Account) util.PageCursor {
+		return util.PageCursor{CreatedAt: account.CreatedAt, ID: account.ID}
+	}, query)
+
+	rsp.Accounts = make([]*pb.Account, 0, len(accounts))
+	for _, account := range accounts {
+		rsp.Accounts = append(rsp.Accounts, convertAccount(account))
+	}
+	return rsp, nil
+}
+
+func validateListAccountsRequest(req *pb.ListAccountsRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateListPageSize(req.GetPageSize()); err != nil {
+		violations = append(violations, fieldViolation("page_size", err))
+	}
+
+	return violations
+}
+
+// pageSize of the lists paged by the token
+func (server *Server) pageSize(requested int32) int32 {
+	return util.PageSize(requested, server.config.DefaultPageSize, server.config.MaxPageSize)
+}
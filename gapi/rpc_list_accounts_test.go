@@ -0,0 +1,162 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	db "github.com/tgfukuda/be-master/db/sqlc"
+	"github.com/tgfukuda/be-master/mocks"
+	"github.com/tgfukuda/be-master/pb"
+	"github.com/tgfukuda/be-master/util"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func TestListAccounts(t *testing.T) {
+	owner := util.RandomOwner()
+	accounts := make([]db.Account, 3)
+	for i := range accounts {
+		accounts[i] = db.Account{
+			ID:        int64(i + 1),
+			Owner:     owner,
+			Currency:  util.RandomCurrency(),
+			CreatedAt: time.Date(2023, 6, 1, 0, 0, i, 0, time.UTC),
+		}
+	}
+
+	query := "accounts:" + owner
+	pageToken := util.EncodePageToken(util.PageCursor{CreatedAt: accounts[1].CreatedAt, ID: accounts[1].ID}, query)
+
+	testCases := []struct {
+		name          string
+		username      string
+		path          string
+		buildStubs    func(store *mocks.Store)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:     "FirstPage",
+			username: owner,
+			path:     "/v1/list_accounts?page_size=2",
+			buildStubs: func(store *mocks.Store) {
+				store.EXPECT().
+					ListAccounts(mock.Anything, db.ListAccountsParams{Owner: owner, LimitCount: 3}).
+					Times(1).
+					Return(accounts, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+
+				var rsp pb.ListAccountsResponse
+				err := protojson.Unmarshal(recorder.Body.Bytes(), &rsp)
+				assert.NoError(t, err)
+				assert.Len(t, rsp.Accounts, 2)
+				assert.Equal(t, pageToken, rsp.NextPageToken)
+			},
+		},
+		{
+			name:     "LastPage",
+			username: owner,
+			path:     "/v1/list_accounts?page_size=2&page_token=" + pageToken,
+			buildStubs: func(store *mocks.Store) {
+				store.EXPECT().
+					ListAccounts(mock.Anything, mock.MatchedBy(func(arg db.ListAccountsParams) bool {
+						return arg.AfterCreatedAt.Equal(accounts[1].CreatedAt) && arg.AfterID == accounts[1].ID && arg.LimitCount == 3
+					})).
+					Times(1).
+					Return(accounts[2:], nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+
+				var rsp pb.ListAccountsResponse
+				err := protojson.Unmarshal(recorder.Body.Bytes(), &rsp)
+				assert.NoError(t, err)
+				assert.Len(t, rsp.Accounts, 1)
+				assert.Empty(t, rsp.NextPageToken)
+			},
+		},
+		{
+			name:       "PageTokenOfAnotherUser",
+			username:   util.RandomOwner(),
+			path:       "/v1/list_accounts?page_token=" + pageToken,
+			buildStubs: func(store *mocks.Store) {},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+				assertFieldViolation(t, recorder, "page_token")
+			},
+		},
+		{
+			name:       "NegativePageSize",
+			username:   owner,
+			path:       "/v1/list_accounts?page_size=-1",
+			buildStubs: func(store *mocks.Store) {},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+				assertFieldViolation(t, recorder, "page_size")
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := mocks.NewStore(t)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			mux := runtime.NewServeMux()
+			err := pb.RegisterSimpleBankHandlerServer(context.Background(), mux, NewGatewayServer(server))
+			assert.NoError(t, err)
+
+			accessToken, _, err := server.tokenMaker.CreateToken(tc.username, util.DepositorRole, time.Minute)
+			assert.NoError(t, err)
+
+			request := httptest.NewRequest(http.MethodGet, tc.path, nil)
+			request.Header.Set("Authorization", fmt.Sprintf("%s %s", authorizationBearer, accessToken))
+			recorder := httptest.NewRecorder()
+			mux.ServeHTTP(recorder, request)
+
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+// the config built from the secrets may not have the page sizes
+func TestListAccountsZeroPageSizeConfig(t *testing.T) {
+	owner := util.RandomOwner()
+	account := db.Account{ID: 1, Owner: owner, Currency: util.USD, CreatedAt: time.Now().Truncate(time.Second)}
+
+	store := mocks.NewStore(t)
+	store.EXPECT().
+		ListAccounts(mock.Anything, db.ListAccountsParams{Owner: owner, LimitCount: util.DefaultPageSize + 1}).
+		Times(1).
+		Return([]db.Account{account}, nil)
+
+	server, err := NewServer(util.Config{TokenSymmetricKey: util.RandomString(32), AccessTokenDuration: time.Minute}, store)
+	assert.NoError(t, err)
+	mux := runtime.NewServeMux()
+	err = pb.RegisterSimpleBankHandlerServer(context.Background(), mux, NewGatewayServer(server))
+	assert.NoError(t, err)
+
+	accessToken, _, err := server.tokenMaker.CreateToken(owner, util.DepositorRole, time.Minute)
+	assert.NoError(t, err)
+
+	request := httptest.NewRequest(http.MethodGet, "/v1/list_accounts", nil)
+	request.Header.Set("Authorization", fmt.Sprintf("%s %s", authorizationBearer, accessToken))
+	recorder := httptest.NewRecorder()
+	mux.ServeHTTP(recorder, request)
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+
+	var rsp pb.ListAccountsResponse
+	err = protojson.Unmarshal(recorder.Body.Bytes(), &rsp)
+	assert.NoError(t, err)
+	assert.Len(t, rsp.Accounts, 1)
+	assert.Empty(t, rsp.NextPageToken)
+}
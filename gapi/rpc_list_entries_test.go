@@ -0,0 +1,181 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	db "github.com/tgfukuda/be-master/db/sqlc"
+	"github.com/tgfukuda/be-master/mocks"
+	"github.com/tgfukuda/be-master/pb"
+	"github.com/tgfukuda/be-master/util"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func TestListEntries(t *testing.T) {
+	account := db.Account{ID: util.RandomInt(1, 1000), Owner: util.RandomOwner(), Currency: util.USD, Status: db.AccountActive}
+	entries := make([]db.Entry, 3)
+	for i := range entries {
+		entries[i] = db.Entry{
+			ID:        int64(i + 1),
+			AccountID: account.ID,
+			Amount:    10,
+			CreatedAt: time.Date(2023, 6, 1, 0, 0, i, 0, time.UTC),
+		}
+	}
+
+	query := fmt.Sprintf("entries:%d", account.ID)
+	pageToken := util.EncodePageToken(util.PageCursor{CreatedAt: entries[1].CreatedAt, ID: entries[1].ID}, query)
+
+	testCases := []struct {
+		name          string
+		username      string
+		role          string
+		path          string
+		buildStubs    func(store *mocks.Store)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:     "FirstPage",
+			username: account.Owner,
+			role:     util.DepositorRole,
+			path:     fmt.Sprintf("/v1/list_entries?account_id=%d&page_size=2", account.ID),
+			buildStubs: func(store *mocks.Store) {
+				store.EXPECT().GetAccount(mock.Anything, account.ID).Times(1).Return(account, nil)
+				store.EXPECT().
+					ListEntriesOf(mock.Anything, db.ListEntriesOfParams{AccountID: account.ID, LimitCount: 3}).
+					Times(1).
+					Return(entries, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+
+				var rsp pb.ListEntriesResponse
+				err := protojson.Unmarshal(recorder.Body.Bytes(), &rsp)
+				assert.NoError(t, err)
+				assert.Len(t, rsp.Entries, 2)
+				assert.Equal(t, pageToken, rsp.NextPageToken)
+			},
+		},
+		{
+			name:     "LastPage",
+			username: account.Owner,
+			role:     util.DepositorRole,
+			path:     fmt.Sprintf("/v1/list_entries?account_id=%d&page_size=2&page_token=%s", account.ID, pageToken),
+			buildStubs: func(store *mocks.Store) {
+				store.EXPECT().GetAccount(mock.Anything, account.ID).Times(1).Return(account, nil)
+				store.EXPECT().
+					ListEntriesOf(mock.Anything, mock.MatchedBy(func(arg db.ListEntriesOfParams) bool {
+						return arg.AfterCreatedAt.Equal(entries[1].CreatedAt) && arg.AfterID == entries[1].ID && arg.LimitCount == 3
+					})).
+					Times(1).
+					Return(entries[2:], nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+
+				var rsp pb.ListEntriesResponse
+				err := protojson.Unmarshal(recorder.Body.Bytes(), &rsp)
+				assert.NoError(t, err)
+				assert.Len(t, rsp.Entries, 1)
+				assert.Empty(t, rsp.NextPageToken)
+			},
+		},
+		{
+			name:     "Banker",
+			username: util.RandomOwner(),
+			role:     util.BankerRole,
+			path:     fmt.Sprintf("/v1/list_entries?account_id=%d", account.ID),
+			buildStubs: func(store *mocks.Store) {
+				store.EXPECT().GetAccount(mock.Anything, account.ID).Times(1).Return(account, nil)
+				store.EXPECT().
+					ListEntriesOf(mock.Anything, db.ListEntriesOfParams{AccountID: account.ID, LimitCount: 6}).
+					Times(1).
+					Return(entries, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:     "OtherUser",
+			username: util.RandomOwner(),
+			role:     util.DepositorRole,
+			path:     fmt.Sprintf("/v1/list_entries?account_id=%d", account.ID),
+			buildStubs: func(store *mocks.Store) {
+				store.EXPECT().GetAccount(mock.Anything, account.ID).Times(1).Return(account, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name:     "AccountNotFound",
+			username: account.Owner,
+			role:     util.DepositorRole,
+			path:     fmt.Sprintf("/v1/list_entries?account_id=%d", account.ID),
+			buildStubs: func(store *mocks.Store) {
+				store.EXPECT().GetAccount(mock.Anything, account.ID).Times(1).Return(db.Account{}, sql.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			// the token of another account
+			name:     "InvalidPageToken",
+			username: account.Owner,
+			role:     util.DepositorRole,
+			path: fmt.Sprintf("/v1/list_entries?account_id=%d&page_token=%s", account.ID,
+				util.EncodePageToken(util.PageCursor{ID: 1}, fmt.Sprintf("entries:%d", account.ID+1))),
+			buildStubs: func(store *mocks.Store) {
+				store.EXPECT().GetAccount(mock.Anything, account.ID).Times(1).Return(account, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+				assertFieldViolation(t, recorder, "page_token")
+			},
+		},
+		{
+			name:       "InvalidAccountID",
+			username:   account.Owner,
+			role:       util.DepositorRole,
+			path:       "/v1/list_entries?account_id=0&page_size=-1",
+			buildStubs: func(store *mocks.Store) {},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+				assertFieldViolation(t, recorder, "account_id")
+				assertFieldViolation(t, recorder, "page_size")
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := mocks.NewStore(t)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			mux := runtime.NewServeMux()
+			err := pb.RegisterSimpleBankHandlerServer(context.Background(), mux, NewGatewayServer(server))
+			assert.NoError(t, err)
+
+			accessToken, _, err := server.tokenMaker.CreateToken(tc.username, tc.role, time.Minute)
+			assert.NoError(t, err)
+
+			request := httptest.NewRequest(http.MethodGet, tc.path, nil)
+			request.Header.Set("Authorization", fmt.Sprintf("%s %s", authorizationBearer, accessToken))
+			recorder := httptest.NewRecorder()
+			mux.ServeHTTP(recorder, request)
+
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
@@ -0,0 +1,146 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	db "github.com/tgfukuda/be-master/db/sqlc"
+	"github.com/tgfukuda/be-master/pb"
+	"github.com/tgfukuda/be-master/util"
+	"github.com/tgfukuda/be-master/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	transferDirectionOutgoing = "outgoing"
+	transferDirectionIncoming = "incoming"
+)
+
+// the upper bound of the time window if unset
+var maxTransferTime = time.Date(9999, 12, 31, 0, 0, 0, 0, time.UTC)
+
+func (server *Server) ListMyTransfers(ctx context.Context, req *pb.ListMyTransfersRequest) (*pb.ListMyTransfersResponse, error) {
+	authPayload, err := authPayloadFromContext(ctx)
+	if err != nil {
+		return nil, unauthorizedError(err)
+	}
+
+	violations := validateListMyTransfersRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	// the token is bound to the user and the filters. only the page size can change between the pages
+	filter := proto.Clone(req).(*pb.ListMyTransfersRequest)
+	filter.PageSize = 0
+	filter.PageToken = ""
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(filter)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to marshal filter: %s", err)
+	}
+	query := fmt.Sprintf("my_transfers:%s:%x", authPayload.Username, data)
+
+	cursor, err := util.DecodePageToken(req.GetPageToken(), query)
+	if err != nil {
+		return nil, invalidArgumentError([]*errdetails.BadRequest_FieldViolation{fieldViolation("page_token", err)})
+	}
+
+	arg := db.ListTransfersOfOwnerParams{
+		Owner:     authPayload.Username,
+		Outgoing:  req.GetDirection() != transferDirectionIncoming,
+		Incoming:  req.GetDirection() != transferDirectionOutgoing,
+		Currency:  req.GetCurrency(),
+		MinAmount: req.GetMinAmount(),
+		MaxAmount: req.GetMaxAmount(),
+		EndTime:   maxTransferTime,
+	}
+	if arg.MaxAmount == 0 {
+		arg.MaxAmount = math.MaxInt64
+	}
+	if req.GetStartTime() != nil {
+		arg.StartTime = req.GetStartTime().AsTime()
+	}
+	if req.GetEndTime() != nil {
+		arg.EndTime = req.GetEndTime().AsTime()
+	}
+
+	// the newest first. the first page starts from the end of the window
+	arg.BeforeCreatedAt, arg.BeforeID = cursor.CreatedAt, cursor.ID
+	if cursor == (util.PageCursor{}) {
+		arg.BeforeCreatedAt = arg.EndTime
+	}
+
+	pageSize := server.pageSize(req.GetPageSize())
+	// one more row tells whether there is the next page
+	arg.LimitCount = pageSize + 1
+
+	transfers, err := server.store.ListTransfersOfOwner(ctx, arg)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list transfers: %s", err)
+	}
+
+	rsp := &pb.ListMyTransfersResponse{}
+	transfers, rsp.NextPageToken = util.NextPage(transfers, pageSize, func(transfer db.ListTransfersOfOwnerRow) util.PageCursor {
+		return util.PageCursor{CreatedAt: transfer.CreatedAt, ID: transfer.ID}
+	}, query)
+
+	rsp.Transfers = make([]*pb.MyTransfer, 0, len(transfers))
+	for _, transfer := range transfers {
+		// the same condition as the query. a transfer between the own accounts is outgoing if it matches both sides
+		outgoing := arg.Outgoing && transfer.FromOwner == arg.Owner &&
+			(arg.Currency == "" || transfer.FromCurrency == arg.Currency) &&
+			arg.MinAmount <= transfer.Amount && transfer.Amount <= arg.MaxAmount
+		rsp.Transfers = append(rsp.Transfers, convertMyTransfer(transfer, outgoing))
+	}
+	return rsp, nil
+}
+
+func validateListMyTransfersRequest(req *pb.ListMyTransfersRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateListPageSize(req.GetPageSize()); err != nil {
+		violations = append(violations, fieldViolation("page_size", err))
+	}
+
+	switch req.GetDirection() {
+	case "", transferDirectionOutgoing, transferDirectionIncoming:
+	default:
+		violations = append(violations, fieldViolation("direction", fmt.Errorf("must be %s or %s", transferDirectionOutgoing, transferDirectionIncoming)))
+	}
+
+	if req.GetCurrency() != "" {
+		if err := val.ValidateCurrency(req.GetCurrency()); err != nil {
+			violations = append(violations, fieldViolation("currency", err))
+		}
+	}
+
+	if req.GetMinAmount() < 0 {
+		violations = append(violations, fieldViolation("min_amount", errors.New("must not be negative")))
+	}
+	if req.GetMaxAmount() < 0 {
+		violations = append(violations, fieldViolation("max_amount", errors.New("must not be negative")))
+	} else if req.GetMaxAmount() != 0 && req.GetMaxAmount() < req.GetMinAmount() {
+		violations = append(violations, fieldViolation("max_amount", errors.New("must not be less than min_amount")))
+	}
+
+	if req.GetStartTime() != nil {
+		if err := req.GetStartTime().CheckValid(); err != nil {
+			violations = append(violations, fieldViolation("start_time", err))
+		}
+	}
+	if req.GetEndTime() != nil {
+		if err := req.GetEndTime().CheckValid(); err != nil {
+			violations = append(violations, fieldViolation("end_time", err))
+		}
+	}
+	if violations == nil && req.GetStartTime() != nil && req.GetEndTime() != nil &&
+		!req.GetEndTime().AsTime().After(req.GetStartTime().AsTime()) {
+		violations = append(violations, fieldViolation("end_time", errors.New("must be later than start_time")))
+	}
+
+	return violations
+}
@@ -0,0 +1,212 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	db "github.com/tgfukuda/be-master/db/sqlc"
+	"github.com/tgfukuda/be-master/mocks"
+	"github.com/tgfukuda/be-master/pb"
+	"github.com/tgfukuda/be-master/util"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func TestListMyTransfers(t *testing.T) {
+	user := util.RandomOwner()
+	other := util.RandomOwner()
+	createdAt := time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	outgoing := db.ListTransfersOfOwnerRow{
+		ID:            3,
+		FromAccountID: 1,
+		ToAccountID:   2,
+		Amount:        10,
+		ToAmount:      1492,
+		ExchangeRate:  "149.25",
+		CreatedAt:     createdAt.Add(2 * time.Minute),
+		FromOwner:     user,
+		FromCurrency:  util.USD,
+		ToOwner:       other,
+		ToCurrency:    util.JPY,
+	}
+	incoming := db.ListTransfersOfOwnerRow{
+		ID:            2,
+		FromAccountID: 2,
+		ToAccountID:   1,
+		Amount:        20,
+		ToAmount:      20,
+		ExchangeRate:  "1",
+		CreatedAt:     createdAt.Add(time.Minute),
+		FromOwner:     other,
+		FromCurrency:  util.USD,
+		ToOwner:       user,
+		ToCurrency:    util.USD,
+	}
+	older := incoming
+	older.ID = 1
+	older.CreatedAt = createdAt
+
+	firstPage := func(arg db.ListTransfersOfOwnerParams) bool {
+		return arg.Owner == user && arg.Outgoing && arg.Incoming && arg.Currency == "" &&
+			arg.MinAmount == 0 && arg.MaxAmount == math.MaxInt64 &&
+			arg.StartTime.IsZero() && arg.EndTime.Equal(maxTransferTime) &&
+			arg.BeforeCreatedAt.Equal(maxTransferTime) && arg.BeforeID == 0 &&
+			arg.LimitCount == 3
+	}
+
+	var nextPageToken string
+
+	testCases := []struct {
+		name          string
+		username      string
+		query         url.Values
+		nextPage      bool // with the token of the first case
+		buildStubs    func(store *mocks.Store)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:     "OK",
+			username: user,
+			query:    url.Values{"page_size": {"2"}},
+			buildStubs: func(store *mocks.Store) {
+				store.EXPECT().
+					ListTransfersOfOwner(mock.Anything, mock.MatchedBy(firstPage)).
+					Times(1).
+					Return([]db.ListTransfersOfOwnerRow{outgoing, incoming, older}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+
+				var rsp pb.ListMyTransfersResponse
+				err := protojson.Unmarshal(recorder.Body.Bytes(), &rsp)
+				assert.NoError(t, err)
+				assert.Len(t, rsp.Transfers, 2)
+				assert.NotEmpty(t, rsp.NextPageToken)
+				nextPageToken = rsp.NextPageToken
+
+				assert.Equal(t, transferDirectionOutgoing, rsp.Transfers[0].Direction)
+				assert.Equal(t, outgoing.FromAccountID, rsp.Transfers[0].AccountId)
+				assert.Equal(t, outgoing.Amount, rsp.Transfers[0].Amount)
+				assert.Equal(t, util.USD, rsp.Transfers[0].Currency)
+				assert.Equal(t, other, rsp.Transfers[0].Counterparty)
+				assert.Equal(t, outgoing.ToAccountID, rsp.Transfers[0].CounterpartyAccountId)
+
+				assert.Equal(t, transferDirectionIncoming, rsp.Transfers[1].Direction)
+				assert.Equal(t, incoming.ToAccountID, rsp.Transfers[1].AccountId)
+				assert.Equal(t, other, rsp.Transfers[1].Counterparty)
+			},
+		},
+		{
+			name:     "NextPage",
+			username: user,
+			query:    url.Values{"page_size": {"2"}},
+			nextPage: true,
+			buildStubs: func(store *mocks.Store) {
+				store.EXPECT().
+					ListTransfersOfOwner(mock.Anything, mock.MatchedBy(func(arg db.ListTransfersOfOwnerParams) bool {
+						return arg.BeforeCreatedAt.Equal(incoming.CreatedAt) && arg.BeforeID == incoming.ID
+					})).
+					Times(1).
+					Return([]db.ListTransfersOfOwnerRow{older}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+
+				var rsp pb.ListMyTransfersResponse
+				err := protojson.Unmarshal(recorder.Body.Bytes(), &rsp)
+				assert.NoError(t, err)
+				assert.Len(t, rsp.Transfers, 1)
+				assert.Empty(t, rsp.NextPageToken)
+			},
+		},
+		{
+			name:     "Filters",
+			username: user,
+			query: url.Values{
+				"direction":  {transferDirectionIncoming},
+				"currency":   {util.JPY},
+				"min_amount": {"100"},
+				"max_amount": {"2000"},
+				"start_time": {createdAt.Format(time.RFC3339)},
+				"end_time":   {createdAt.Add(time.Hour).Format(time.RFC3339)},
+			},
+			buildStubs: func(store *mocks.Store) {
+				store.EXPECT().
+					ListTransfersOfOwner(mock.Anything, mock.MatchedBy(func(arg db.ListTransfersOfOwnerParams) bool {
+						return !arg.Outgoing && arg.Incoming && arg.Currency == util.JPY &&
+							arg.MinAmount == 100 && arg.MaxAmount == 2000 &&
+							arg.StartTime.Equal(createdAt) && arg.EndTime.Equal(createdAt.Add(time.Hour)) &&
+							arg.BeforeCreatedAt.Equal(createdAt.Add(time.Hour))
+					})).
+					Times(1).
+					Return([]db.ListTransfersOfOwnerRow{}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:       "PageTokenOfOtherFilters",
+			username:   user,
+			query:      url.Values{"direction": {transferDirectionOutgoing}},
+			nextPage:   true,
+			buildStubs: func(store *mocks.Store) {},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:       "InvalidDirection",
+			username:   user,
+			query:      url.Values{"direction": {"sideways"}},
+			buildStubs: func(store *mocks.Store) {},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:       "InvalidAmountRange",
+			username:   user,
+			query:      url.Values{"min_amount": {"100"}, "max_amount": {"10"}},
+			buildStubs: func(store *mocks.Store) {},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := mocks.NewStore(t)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			mux := runtime.NewServeMux()
+			err := pb.RegisterSimpleBankHandlerServer(context.Background(), mux, NewGatewayServer(server))
+			assert.NoError(t, err)
+
+			accessToken, _, err := server.tokenMaker.CreateToken(tc.username, util.DepositorRole, time.Minute)
+			assert.NoError(t, err)
+
+			if tc.nextPage {
+				tc.query.Set("page_token", nextPageToken)
+			}
+
+			request := httptest.NewRequest(http.MethodGet, "/v1/list_my_transfers?"+tc.query.Encode(), nil)
+			request.Header.Set("Authorization", fmt.Sprintf("%s %s", authorizationBearer, accessToken))
+			recorder := httptest.NewRecorder()
+			mux.ServeHTTP(recorder, request)
+
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package gapi
+
+import (
+	"context"
+	"math"
+
+	db "github.com/tgfukuda/be-master/db/sqlc"
+	"github.com/tgfukuda/be-master/pb"
+	"github.com/tgfukuda/be-master/util"
+	"github.com/tgfukuda/be-master/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// the reports are not filtered. the token is only bound to this listing
+const reconciliationReportsQuery = "reconciliation_reports"
+
+// ListReconciliationReports lists the drifts found by the reconciliation task. only admin is allowed by the policy.
+func (server *Server) ListReconciliationReports(ctx context.Context, req *pb.ListReconciliationReportsRequest) (*pb.ListReconciliationReportsResponse, error) {
+	violations := validateListReconciliationReportsRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	cursor, err := util.DecodePageToken(req.GetPageToken(), reconciliationReportsQuery)
+	if err != nil {
+		return nil, invalidArgumentError([]*errdetails.BadRequest_FieldViolation{fieldViolation("page_token", err)})
+	}
+
+	// the newest first by id. the first page starts from the largest id
+	arg := db.ListReconciliationReportsParams{BeforeID: cursor.ID}
+	if cursor == (util.PageCursor{}) {
+		arg.BeforeID = math.MaxInt64
+	}
+
+	pageSize := server.pageSize(req.GetPageSize())
+	// one more row tells whether there is the next page
+	arg.LimitCount = pageSize + 1
+
+	reports, err := server.store.ListReconciliationReports(ctx, arg)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list reconciliation reports: %s", err)
+	}
+
+	rsp := &pb.ListReconciliationReportsResponse{}
+	reports, rsp.NextPageToken = util.NextPage(reports, pageSize, func(report db.ReconciliationReport) util.PageCursor {
+		return util.PageCursor{CreatedAt: report.CreatedAt, ID: report.ID}
+	}, reconciliationReportsQuery)
+
+	rsp.Reports = make([]*pb.ReconciliationReport, 0, len(reports))
+	for _, report := range reports {
+		rsp.Reports = append(rsp.Reports, convertReconciliationReport(report))
+	}
+	return rsp, nil
+}
+
+func validateListReconciliationReportsRequest(req *pb.ListReconciliationReportsRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateListPageSize(req.GetPageSize()); err != nil {
+		violations = append(violations, fieldViolation("page_size", err))
+	}
+
+	return violations
+}
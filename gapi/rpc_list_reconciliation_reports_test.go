@@ -0,0 +1,156 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	db "github.com/tgfukuda/be-master/db/sqlc"
+	"github.com/tgfukuda/be-master/mocks"
+	"github.com/tgfukuda/be-master/pb"
+	"github.com/tgfukuda/be-master/util"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func TestListReconciliationReports(t *testing.T) {
+	// the newest first
+	reports := make([]db.ReconciliationReport, 6)
+	for i := range reports {
+		reports[i] = db.ReconciliationReport{
+			ID:           int64(len(reports) - i),
+			AccountID:    util.RandomInt(1, 1000),
+			Balance:      100,
+			EntriesTotal: 90,
+			CreatedAt:    time.Now().Truncate(time.Second),
+		}
+	}
+	last := reports[4]
+	pageToken := util.EncodePageToken(util.PageCursor{CreatedAt: last.CreatedAt, ID: last.ID}, reconciliationReportsQuery)
+
+	testCases := []struct {
+		name          string
+		role          string
+		query         string
+		buildStubs    func(store *mocks.Store)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			// the default size of the server
+			name:  "FirstPage",
+			role:  util.AdminRole,
+			query: "",
+			buildStubs: func(store *mocks.Store) {
+				store.EXPECT().
+					ListReconciliationReports(mock.Anything, db.ListReconciliationReportsParams{BeforeID: math.MaxInt64, LimitCount: 6}).
+					Times(1).
+					Return(reports, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+
+				var rsp pb.ListReconciliationReportsResponse
+				err := protojson.Unmarshal(recorder.Body.Bytes(), &rsp)
+				assert.NoError(t, err)
+				require.Len(t, rsp.Reports, 5)
+				assert.Equal(t, reports[0].AccountID, rsp.Reports[0].AccountId)
+				assert.Equal(t, reports[0].EntriesTotal, rsp.Reports[0].EntriesTotal)
+				assert.Equal(t, pageToken, rsp.NextPageToken)
+			},
+		},
+		{
+			name:  "LastPage",
+			role:  util.AdminRole,
+			query: "page_token=" + pageToken,
+			buildStubs: func(store *mocks.Store) {
+				store.EXPECT().
+					ListReconciliationReports(mock.Anything, db.ListReconciliationReportsParams{BeforeID: last.ID, LimitCount: 6}).
+					Times(1).
+					Return(reports[5:], nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+
+				var rsp pb.ListReconciliationReportsResponse
+				err := protojson.Unmarshal(recorder.Body.Bytes(), &rsp)
+				assert.NoError(t, err)
+				require.Len(t, rsp.Reports, 1)
+				assert.Empty(t, rsp.NextPageToken)
+			},
+		},
+		{
+			// capped to the max size of the server
+			name:  "LargePageSize",
+			role:  util.AdminRole,
+			query: "page_size=1000",
+			buildStubs: func(store *mocks.Store) {
+				store.EXPECT().
+					ListReconciliationReports(mock.Anything, db.ListReconciliationReportsParams{BeforeID: math.MaxInt64, LimitCount: 11}).
+					Times(1).
+					Return(reports, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:       "NotAdmin",
+			role:       util.BankerRole,
+			query:      "page_size=5",
+			buildStubs: func(store *mocks.Store) {},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name:       "NegativePageSize",
+			role:       util.AdminRole,
+			query:      "page_size=-1",
+			buildStubs: func(store *mocks.Store) {},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+				assertFieldViolation(t, recorder, "page_size")
+			},
+		},
+		{
+			// the token of another listing
+			name:       "InvalidPageToken",
+			role:       util.AdminRole,
+			query:      "page_token=" + util.EncodePageToken(util.PageCursor{ID: last.ID}, "accounts:"+util.RandomOwner()),
+			buildStubs: func(store *mocks.Store) {},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+				assertFieldViolation(t, recorder, "page_token")
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := mocks.NewStore(t)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			mux := runtime.NewServeMux()
+			err := pb.RegisterSimpleBankHandlerServer(context.Background(), mux, NewGatewayServer(server))
+			assert.NoError(t, err)
+
+			accessToken, _, err := server.tokenMaker.CreateToken(util.RandomOwner(), tc.role, time.Minute)
+			assert.NoError(t, err)
+
+			request := httptest.NewRequest(http.MethodGet, "/v1/list_reconciliation_reports?"+tc.query, nil)
+			request.Header.Set("Authorization", fmt.Sprintf("%s %s", authorizationBearer, accessToken))
+			recorder := httptest.NewRecorder()
+			mux.ServeHTTP(recorder, request)
+
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
@@ -0,0 +1,160 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strconv"
+
+	db "github.com/tgfukuda/be-master/db/sqlc"
+	"github.com/tgfukuda/be-master/pb"
+	"github.com/tgfukuda/be-master/util"
+	"github.com/tgfukuda/be-master/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) ListScheduledTransfers(ctx context.Context, req *pb.ListScheduledTransfersRequest) (*pb.ListScheduledTransfersResponse, error) {
+	authPayload, err := authPayloadFromContext(ctx)
+	if err != nil {
+		return nil, unauthorizedError(err)
+	}
+
+	violations := validateListScheduledTransfersRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	// the token of another user's list is rejected
+	query := "scheduled_transfers:" + authPayload.Username
+	cursor, err := util.DecodePageToken(req.GetPageToken(), query)
+	if err != nil {
+		return nil, invalidArgumentError([]*errdetails.BadRequest_FieldViolation{fieldViolation("page_token", err)})
+	}
+
+	pageSize := server.pageSize(req.GetPageSize())
+	// one more row tells whether there is the next page
+	scheduledTransfers, err := server.store.ListScheduledTransfers(ctx, db.ListScheduledTransfersParams{
+		Owner:          authPayload.Username,
+		AfterCreatedAt: cursor.CreatedAt,
+		AfterID:        cursor.ID,
+		LimitCount:     pageSize + 1,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list scheduled transfers: %s", err)
+	}
+
+	rsp := &pb.ListScheduledTransfersResponse{}
+	scheduledTransfers, rsp.NextPageToken = util.NextPage(scheduledTransfers, pageSize, func(scheduled db.ScheduledTransfer) util.PageCursor {
+		return util.PageCursor{CreatedAt: scheduled.CreatedAt, ID: scheduled.ID}
+	}, query)
+
+	rsp.ScheduledTransfers = make([]*pb.ScheduledTransfer, 0, len(scheduledTransfers))
+	for _, scheduled := range scheduledTransfers {
+		rsp.ScheduledTransfers = append(rsp.ScheduledTransfers, convertScheduledTransfer(scheduled))
+	}
+	return rsp, nil
+}
+
+func validateListScheduledTransfersRequest(req *pb.ListScheduledTransfersRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateListPageSize(req.GetPageSize()); err != nil {
+		violations = append(violations, fieldViolation("page_size", err))
+	}
+
+	return violations
+}
+
+func (server *Server) ListScheduledTransferRuns(ctx context.Context, req *pb.ListScheduledTransferRunsRequest) (*pb.ListScheduledTransferRunsResponse, error) {
+	authPayload, err := authPayloadFromContext(ctx)
+	if err != nil {
+		return nil, unauthorizedError(err)
+	}
+
+	violations := validateListScheduledTransferRunsRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	scheduled, err := server.getScheduledTransfer(ctx, req.GetScheduledTransferId())
+	if err != nil {
+		return nil, err
+	}
+
+	// staff can read the runs of any scheduled transfer
+	if scheduled.Owner != authPayload.Username && authPayload.CheckRole(util.StaffRoles) != nil {
+		return nil, permissionDeniedError(
+			resourceTypeScheduledTransfer,
+			strconv.FormatInt(scheduled.ID, 10),
+			scheduled.Owner,
+			"scheduled transfer doesn't belong to the authenticated user",
+		)
+	}
+
+	// the token of the runs of another scheduled transfer is rejected
+	query := fmt.Sprintf("scheduled_transfer_runs:%d", scheduled.ID)
+	cursor, err := util.DecodePageToken(req.GetPageToken(), query)
+	if err != nil {
+		return nil, invalidArgumentError([]*errdetails.BadRequest_FieldViolation{fieldViolation("page_token", err)})
+	}
+
+	// the newest first. the first page starts from the end of the time
+	arg := db.ListScheduledTransferRunsParams{
+		ScheduledTransferID: scheduled.ID,
+		BeforeCreatedAt:     cursor.CreatedAt,
+		BeforeID:            cursor.ID,
+	}
+	if cursor == (util.PageCursor{}) {
+		arg.BeforeCreatedAt = maxTransferTime
+	}
+
+	pageSize := server.pageSize(req.GetPageSize())
+	// one more row tells whether there is the next page
+	arg.LimitCount = pageSize + 1
+
+	runs, err := server.store.ListScheduledTransferRuns(ctx, arg)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list scheduled transfer runs: %s", err)
+	}
+
+	rsp := &pb.ListScheduledTransferRunsResponse{}
+	runs, rsp.NextPageToken = util.NextPage(runs, pageSize, func(run db.ScheduledTransferRun) util.PageCursor {
+		return util.PageCursor{CreatedAt: run.CreatedAt, ID: run.ID}
+	}, query)
+
+	rsp.Runs = make([]*pb.ScheduledTransferRun, 0, len(runs))
+	for _, run := range runs {
+		rsp.Runs = append(rsp.Runs, convertScheduledTransferRun(run))
+	}
+	return rsp, nil
+}
+
+func validateListScheduledTransferRunsRequest(req *pb.ListScheduledTransferRunsRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateScheduledTransferId(req.GetScheduledTransferId()); err != nil {
+		violations = append(violations, fieldViolation("scheduled_transfer_id", err))
+	}
+
+	if err := val.ValidateListPageSize(req.GetPageSize()); err != nil {
+		violations = append(violations, fieldViolation("page_size", err))
+	}
+
+	return violations
+}
+
+// getScheduledTransfer returns the scheduled transfer. the returned error is already a gRPC status.
+func (server *Server) getScheduledTransfer(ctx context.Context, id int64) (db.ScheduledTransfer, error) {
+	scheduled, err := server.store.GetScheduledTransfer(ctx, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return scheduled, notFoundError(
+				resourceTypeScheduledTransfer,
+				strconv.FormatInt(id, 10),
+				fmt.Sprintf("scheduled transfer [%d] doesn't exist", id),
+			)
+		}
+
+		return scheduled, status.Errorf(codes.Internal, "failed to get scheduled transfer: %s", err)
+	}
+
+	return scheduled, nil
+}
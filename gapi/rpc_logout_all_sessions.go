@@ -0,0 +1,27 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/tgfukuda/be-master/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// LogoutAllSessions blocks every session of the user. issued access tokens are valid until they expire.
+func (server *Server) LogoutAllSessions(ctx context.Context, req *pb.LogoutAllSessionsRequest) (*pb.LogoutAllSessionsResponse, error) {
+	authPayload, err := authPayloadFromContext(ctx)
+	if err != nil {
+		return nil, unauthorizedError(err)
+	}
+
+	blocked, err := server.store.BlockUserSessions(ctx, authPayload.Username)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to block sessions: %s", err)
+	}
+
+	rsp := &pb.LogoutAllSessionsResponse{
+		BlockedSessions: blocked,
+	}
+	return rsp, nil
+}
@@ -0,0 +1,107 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	db "github.com/tgfukuda/be-master/db/sqlc"
+	"github.com/tgfukuda/be-master/mocks"
+	"github.com/tgfukuda/be-master/pb"
+	"github.com/tgfukuda/be-master/token"
+	"github.com/tgfukuda/be-master/util"
+)
+
+func TestLogout(t *testing.T) {
+	username := util.RandomOwner()
+	familyID := uuid.New()
+	sessionOf := func(refreshToken string, refreshPayload *token.Payload) db.Session {
+		return db.Session{
+			ID:           refreshPayload.ID,
+			Username:     username,
+			RefreshToken: refreshToken,
+			FamilyID:     familyID,
+		}
+	}
+
+	testCases := []struct {
+		name          string
+		buildStubs    func(store *mocks.Store, refreshToken string, refreshPayload *token.Payload)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(store *mocks.Store, refreshToken string, refreshPayload *token.Payload) {
+				store.EXPECT().GetSession(mock.Anything, refreshPayload.ID).Times(1).Return(sessionOf(refreshToken, refreshPayload), nil)
+				// the sessions rotated from the same login are blocked together
+				store.EXPECT().BlockSessionFamily(mock.Anything, familyID).Times(1).Return(int64(2), nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			// the session has been renewed with another refresh token
+			name: "MismatchedRefreshToken",
+			buildStubs: func(store *mocks.Store, refreshToken string, refreshPayload *token.Payload) {
+				session := sessionOf(refreshToken, refreshPayload)
+				session.RefreshToken = "rotated"
+				store.EXPECT().GetSession(mock.Anything, refreshPayload.ID).Times(1).Return(session, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name: "MismatchedUsername",
+			buildStubs: func(store *mocks.Store, refreshToken string, refreshPayload *token.Payload) {
+				session := sessionOf(refreshToken, refreshPayload)
+				session.Username = util.RandomOwner()
+				store.EXPECT().GetSession(mock.Anything, refreshPayload.ID).Times(1).Return(session, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name: "SessionNotFound",
+			buildStubs: func(store *mocks.Store, refreshToken string, refreshPayload *token.Payload) {
+				store.EXPECT().GetSession(mock.Anything, refreshPayload.ID).Times(1).Return(db.Session{}, sql.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := mocks.NewStore(t)
+			server := newTestServer(t, store)
+
+			refreshToken, refreshPayload, err := server.tokenMaker.CreateToken(username, util.DepositorRole, time.Minute)
+			assert.NoError(t, err)
+			tc.buildStubs(store, refreshToken, refreshPayload)
+
+			mux := runtime.NewServeMux()
+			err = pb.RegisterSimpleBankHandlerServer(context.Background(), mux, NewGatewayServer(server))
+			assert.NoError(t, err)
+
+			body := fmt.Sprintf(`{"refresh_token": %q}`, refreshToken)
+			request := httptest.NewRequest(http.MethodPost, "/v1/logout", strings.NewReader(body))
+			recorder := httptest.NewRecorder()
+			mux.ServeHTTP(recorder, request)
+
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
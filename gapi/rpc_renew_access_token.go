@@ -0,0 +1,100 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	db "github.com/tgfukuda/be-master/db/sqlc"
+	"github.com/tgfukuda/be-master/pb"
+	"github.com/tgfukuda/be-master/token"
+	"github.com/tgfukuda/be-master/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (server *Server) RenewAccessToken(ctx context.Context, req *pb.RenewAccessTokenRequest) (*pb.RenewAccessTokenResponse, error) {
+	violations := validateRenewAccessTokenRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	refreshPayload, err := server.tokenMaker.VerifyToken(req.GetRefreshToken())
+	if err != nil {
+		return nil, unauthorizedError(err)
+	}
+
+	var accessToken, refreshToken string
+	var accessPayload, newRefreshPayload *token.Payload
+
+	mtdt := server.extractMetadata(ctx)
+	result, err := server.store.RenewSessionTx(ctx, db.RenewSessionTxParams{
+		ID:           refreshPayload.ID,
+		Username:     refreshPayload.Username,
+		RefreshToken: req.GetRefreshToken(),
+		NewSession: func(old db.Session) (db.CreateNewSessionParams, error) {
+			// read the role again so the change of the role takes effect on the renewal
+			user, err := server.store.GetUser(ctx, old.Username)
+			if err != nil {
+				return db.CreateNewSessionParams{}, err
+			}
+
+			accessToken, accessPayload, err = server.tokenMaker.CreateToken(user.Username, user.Role, server.config.AccessTokenDuration)
+			if err != nil {
+				return db.CreateNewSessionParams{}, err
+			}
+
+			refreshToken, newRefreshPayload, err = server.tokenMaker.CreateToken(user.Username, user.Role, server.config.RefreshTokenDuration)
+			if err != nil {
+				return db.CreateNewSessionParams{}, err
+			}
+
+			return db.CreateNewSessionParams{
+				ID:           newRefreshPayload.ID,
+				Username:     old.Username,
+				RefreshToken: refreshToken,
+				UserAgent:    mtdt.UserAgent,
+				ClientIp:     mtdt.ClientIp,
+				IsBlocked:    false,
+				ExpiredAt:    newRefreshPayload.ExpiredAt,
+			}, nil
+		},
+	})
+	if err != nil {
+		return nil, sessionError(err)
+	}
+
+	rsp := &pb.RenewAccessTokenResponse{
+		SessionId:             result.Session.ID.String(),
+		AccessToken:           accessToken,
+		AccessTokenExpiredAt:  timestamppb.New(accessPayload.ExpiredAt),
+		RefreshToken:          refreshToken,
+		RefreshTokenExpiredAt: timestamppb.New(newRefreshPayload.ExpiredAt),
+	}
+	return rsp, nil
+}
+
+// sessionError converts the error of the session lookup into a gRPC status
+func sessionError(err error) error {
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return unauthorizedError(errors.New("session not found"))
+	case errors.Is(err, db.ErrSessionBlocked),
+		errors.Is(err, db.ErrSessionExpired),
+		errors.Is(err, db.ErrSessionMismatch),
+		errors.Is(err, db.ErrRefreshTokenReused):
+		return unauthorizedError(err)
+	default:
+		return status.Errorf(codes.Internal, "failed to renew session: %s", err)
+	}
+}
+
+func validateRenewAccessTokenRequest(req *pb.RenewAccessTokenRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateToken(req.GetRefreshToken()); err != nil {
+		violations = append(violations, fieldViolation("refresh_token", err))
+	}
+
+	return violations
+}
@@ -0,0 +1,162 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	db "github.com/tgfukuda/be-master/db/sqlc"
+	"github.com/tgfukuda/be-master/mocks"
+	"github.com/tgfukuda/be-master/pb"
+	"github.com/tgfukuda/be-master/token"
+	"github.com/tgfukuda/be-master/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func TestRenewAccessToken(t *testing.T) {
+	user := db.User{Username: util.RandomOwner(), Role: util.DepositorRole}
+	refreshTokenBody := func(refreshToken string) string {
+		return fmt.Sprintf(`{"refresh_token": %q}`, refreshToken)
+	}
+
+	testCases := []struct {
+		name          string
+		body          func(refreshToken string) string
+		buildStubs    func(store *mocks.Store, refreshToken string, refreshPayload *token.Payload)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder, tokenMaker token.Maker)
+	}{
+		{
+			name: "OK",
+			body: refreshTokenBody,
+			buildStubs: func(store *mocks.Store, refreshToken string, refreshPayload *token.Payload) {
+				store.EXPECT().GetUser(mock.Anything, user.Username).Times(1).Return(user, nil)
+				store.EXPECT().
+					RenewSessionTx(mock.Anything, mock.MatchedBy(func(arg db.RenewSessionTxParams) bool {
+						return arg.ID == refreshPayload.ID && arg.Username == user.Username && arg.RefreshToken == refreshToken
+					})).
+					RunAndReturn(func(ctx context.Context, arg db.RenewSessionTxParams) (db.RenewSessionTxResult, error) {
+						old := db.Session{ID: arg.ID, Username: arg.Username, RefreshToken: arg.RefreshToken, FamilyID: arg.ID}
+						newSession, err := arg.NewSession(old)
+						assert.NoError(t, err)
+
+						return db.RenewSessionTxResult{
+							OldSession: old,
+							Session:    db.Session{ID: newSession.ID, Username: newSession.Username, FamilyID: old.FamilyID},
+						}, nil
+					}).
+					Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder, tokenMaker token.Maker) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+
+				var rsp pb.RenewAccessTokenResponse
+				err := protojson.Unmarshal(recorder.Body.Bytes(), &rsp)
+				assert.NoError(t, err)
+
+				payload, err := tokenMaker.VerifyToken(rsp.AccessToken)
+				assert.NoError(t, err)
+				assert.Equal(t, user.Username, payload.Username)
+				assert.Equal(t, user.Role, payload.Role)
+			},
+		},
+		{
+			// the retired refresh token may be stolen. the store blocks the family and the client must log in again
+			name: "RefreshTokenReused",
+			body: refreshTokenBody,
+			buildStubs: func(store *mocks.Store, refreshToken string, refreshPayload *token.Payload) {
+				store.EXPECT().
+					RenewSessionTx(mock.Anything, mock.Anything).
+					Times(1).
+					Return(db.RenewSessionTxResult{}, db.ErrRefreshTokenReused)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder, tokenMaker token.Maker) {
+				assert.Equal(t, http.StatusUnauthorized, recorder.Code)
+				assert.Equal(t, codes.Unauthenticated, gatewayStatus(t, recorder).Code())
+			},
+		},
+		{
+			name: "SessionNotFound",
+			body: refreshTokenBody,
+			buildStubs: func(store *mocks.Store, refreshToken string, refreshPayload *token.Payload) {
+				store.EXPECT().
+					RenewSessionTx(mock.Anything, mock.Anything).
+					Times(1).
+					Return(db.RenewSessionTxResult{}, sql.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder, tokenMaker token.Maker) {
+				assert.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name: "InvalidRefreshToken",
+			body: func(refreshToken string) string {
+				return refreshTokenBody("invalid")
+			},
+			buildStubs: func(store *mocks.Store, refreshToken string, refreshPayload *token.Payload) {},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder, tokenMaker token.Maker) {
+				assert.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name: "NoRefreshToken",
+			body: func(refreshToken string) string {
+				return `{}`
+			},
+			buildStubs: func(store *mocks.Store, refreshToken string, refreshPayload *token.Payload) {},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder, tokenMaker token.Maker) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+				assertFieldViolation(t, recorder, "refresh_token")
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := mocks.NewStore(t)
+			server := newTestServer(t, store)
+
+			refreshToken, refreshPayload, err := server.tokenMaker.CreateToken(user.Username, user.Role, time.Minute)
+			assert.NoError(t, err)
+			tc.buildStubs(store, refreshToken, refreshPayload)
+
+			mux := runtime.NewServeMux()
+			err = pb.RegisterSimpleBankHandlerServer(context.Background(), mux, NewGatewayServer(server))
+			assert.NoError(t, err)
+
+			request := httptest.NewRequest(http.MethodPost, "/v1/renew_access_token", strings.NewReader(tc.body(refreshToken)))
+			recorder := httptest.NewRecorder()
+			mux.ServeHTTP(recorder, request)
+
+			tc.checkResponse(t, recorder, server.tokenMaker)
+		})
+	}
+}
+
+func TestSessionError(t *testing.T) {
+	testCases := []struct {
+		err  error
+		code codes.Code
+	}{
+		{err: sql.ErrNoRows, code: codes.Unauthenticated},
+		{err: db.ErrSessionBlocked, code: codes.Unauthenticated},
+		{err: db.ErrSessionExpired, code: codes.Unauthenticated},
+		{err: db.ErrSessionMismatch, code: codes.Unauthenticated},
+		{err: fmt.Errorf("session [1]: %w", db.ErrRefreshTokenReused), code: codes.Unauthenticated},
+		{err: errors.New("connection is closed"), code: codes.Internal},
+	}
+
+	for _, tc := range testCases {
+		assert.Equal(t, tc.code, status.Code(sessionError(tc.err)), tc.err.Error())
+	}
+}
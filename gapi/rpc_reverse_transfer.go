@@ -0,0 +1,124 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+
+	db "github.com/tgfukuda/be-master/db/sqlc"
+	"github.com/tgfukuda/be-master/pb"
+	"github.com/tgfukuda/be-master/util"
+	"github.com/tgfukuda/be-master/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const resourceTypeTransfer = "transfer"
+
+func (server *Server) ReverseTransfer(ctx context.Context, req *pb.ReverseTransferRequest) (*pb.ReverseTransferResponse, error) {
+	authPayload, err := authPayloadFromContext(ctx)
+	if err != nil {
+		return nil, unauthorizedError(err)
+	}
+
+	violations := validateReverseTransferRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	transfer, err := server.store.GetTransfer(ctx, req.GetTransferId())
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, notFoundError(
+				resourceTypeTransfer,
+				strconv.FormatInt(req.GetTransferId(), 10),
+				fmt.Sprintf("transfer [%d] doesn't exist", req.GetTransferId()),
+			)
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to get transfer: %s", err)
+	}
+
+	// the receiver refunds what it received. staff can reverse any transfer, e.g. a mistaken one
+	receiver, err := server.store.GetAccount(ctx, transfer.ToAccountID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get account: %s", err)
+	}
+
+	if receiver.Owner != authPayload.Username && authPayload.CheckRole(util.StaffRoles) != nil {
+		return nil, permissionDeniedError(
+			resourceTypeTransfer,
+			strconv.FormatInt(transfer.ID, 10),
+			receiver.Owner,
+			"transfer wasn't received by the authenticated user",
+		)
+	}
+
+	result, err := server.store.ReverseTransferTx(ctx, db.ReverseTransferTxParams{
+		TransferID: transfer.ID,
+		Amount:     req.GetAmount(),
+	})
+	if err != nil {
+		subject := fmt.Sprintf("%s/%d", resourceTypeTransfer, transfer.ID)
+
+		switch {
+		case errors.Is(err, db.ErrReversalTooSmall):
+			return nil, invalidArgumentError([]*errdetails.BadRequest_FieldViolation{fieldViolation("amount", err)})
+		case errors.Is(err, db.ErrInsufficientFunds):
+			return nil, failedPreconditionError([]*errdetails.PreconditionFailure_Violation{
+				preconditionViolation(
+					"INSUFFICIENT_FUNDS",
+					fmt.Sprintf("%s/%d", resourceTypeAccount, transfer.ToAccountID),
+					err.Error(),
+				),
+			})
+		case errors.Is(err, db.ErrTransferAlreadyReversed):
+			return nil, failedPreconditionError([]*errdetails.PreconditionFailure_Violation{
+				preconditionViolation("ALREADY_REVERSED", subject, err.Error()),
+			})
+		case errors.Is(err, db.ErrReversalExceedsTransfer):
+			return nil, failedPreconditionError([]*errdetails.PreconditionFailure_Violation{
+				preconditionViolation("REVERSAL_EXCEEDS_TRANSFER", subject, err.Error()),
+			})
+		case errors.Is(err, db.ErrReversalNotReversible):
+			return nil, failedPreconditionError([]*errdetails.PreconditionFailure_Violation{
+				preconditionViolation("REVERSAL_NOT_REVERSIBLE", subject, err.Error()),
+			})
+		}
+
+		if violation := accountStatusViolation(err); violation != nil {
+			return nil, failedPreconditionError([]*errdetails.PreconditionFailure_Violation{violation})
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to reverse transfer: %s", err)
+	}
+
+	rsp := &pb.ReverseTransferResponse{
+		Transfer:       convertTransfer(result.Transfer),
+		FromAccount:    convertAccount(result.FromAccount),
+		ToAccount:      convertAccount(result.ToAccount),
+		FromEntry:      convertEntry(result.FromEntry),
+		ToEntry:        convertEntry(result.ToEntry),
+		Original:       convertTransfer(result.Original),
+		ReversedAmount: result.ReversedAmount,
+	}
+	return rsp, nil
+}
+
+func validateReverseTransferRequest(req *pb.ReverseTransferRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateTransferId(req.GetTransferId()); err != nil {
+		violations = append(violations, fieldViolation("transfer_id", err))
+	}
+
+	// zero refunds the whole rest
+	if req.GetAmount() != 0 {
+		if err := val.ValidateAmount(req.GetAmount()); err != nil {
+			violations = append(violations, fieldViolation("amount", err))
+		}
+	}
+
+	return violations
+}
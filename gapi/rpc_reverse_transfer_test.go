@@ -0,0 +1,195 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	db "github.com/tgfukuda/be-master/db/sqlc"
+	"github.com/tgfukuda/be-master/mocks"
+	"github.com/tgfukuda/be-master/pb"
+	"github.com/tgfukuda/be-master/util"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func TestReverseTransfer(t *testing.T) {
+	sender := db.Account{ID: 1, Owner: util.RandomOwner(), Currency: util.USD}
+	receiver := db.Account{ID: 2, Owner: util.RandomOwner(), Currency: util.USD}
+	original := db.Transfer{
+		ID:            util.RandomInt(1, 1000),
+		FromAccountID: sender.ID,
+		ToAccountID:   receiver.ID,
+		Amount:        100,
+		ToAmount:      100,
+		ExchangeRate:  "1",
+	}
+	result := db.ReverseTransferTxResult{
+		TransferTxResult: db.TransferTxResult{
+			Transfer: db.Transfer{
+				ID:            original.ID + 1,
+				FromAccountID: receiver.ID,
+				ToAccountID:   sender.ID,
+				Amount:        40,
+				ToAmount:      40,
+				ExchangeRate:  "1",
+				ReversalOf:    sql.NullInt64{Int64: original.ID, Valid: true},
+			},
+			FromAccount: receiver,
+			ToAccount:   sender,
+		},
+		Original:       original,
+		ReversedAmount: 40,
+	}
+
+	testCases := []struct {
+		name          string
+		username      string
+		role          string
+		body          string
+		buildStubs    func(store *mocks.Store)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:     "OK",
+			username: receiver.Owner,
+			role:     util.DepositorRole,
+			body:     fmt.Sprintf(`{"transfer_id": %d, "amount": 40}`, original.ID),
+			buildStubs: func(store *mocks.Store) {
+				store.EXPECT().GetTransfer(mock.Anything, original.ID).Times(1).Return(original, nil)
+				store.EXPECT().GetAccount(mock.Anything, receiver.ID).Times(1).Return(receiver, nil)
+				store.EXPECT().
+					ReverseTransferTx(mock.Anything, db.ReverseTransferTxParams{TransferID: original.ID, Amount: 40}).
+					Times(1).
+					Return(result, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+
+				var rsp pb.ReverseTransferResponse
+				err := protojson.Unmarshal(recorder.Body.Bytes(), &rsp)
+				assert.NoError(t, err)
+				assert.Equal(t, original.ID, rsp.Transfer.ReversalOf)
+				assert.Equal(t, original.ID, rsp.Original.Id)
+				assert.EqualValues(t, 40, rsp.ReversedAmount)
+			},
+		},
+		{
+			name:     "Banker",
+			username: util.RandomOwner(),
+			role:     util.BankerRole,
+			body:     fmt.Sprintf(`{"transfer_id": %d}`, original.ID),
+			buildStubs: func(store *mocks.Store) {
+				store.EXPECT().GetTransfer(mock.Anything, original.ID).Times(1).Return(original, nil)
+				store.EXPECT().GetAccount(mock.Anything, receiver.ID).Times(1).Return(receiver, nil)
+				store.EXPECT().
+					ReverseTransferTx(mock.Anything, db.ReverseTransferTxParams{TransferID: original.ID}).
+					Times(1).
+					Return(result, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:     "Sender",
+			username: sender.Owner,
+			role:     util.DepositorRole,
+			body:     fmt.Sprintf(`{"transfer_id": %d}`, original.ID),
+			buildStubs: func(store *mocks.Store) {
+				store.EXPECT().GetTransfer(mock.Anything, original.ID).Times(1).Return(original, nil)
+				store.EXPECT().GetAccount(mock.Anything, receiver.ID).Times(1).Return(receiver, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name:       "InvalidAmount",
+			username:   receiver.Owner,
+			role:       util.DepositorRole,
+			body:       fmt.Sprintf(`{"transfer_id": %d, "amount": -1}`, original.ID),
+			buildStubs: func(store *mocks.Store) {},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:     "NotFound",
+			username: receiver.Owner,
+			role:     util.DepositorRole,
+			body:     fmt.Sprintf(`{"transfer_id": %d}`, original.ID),
+			buildStubs: func(store *mocks.Store) {
+				store.EXPECT().GetTransfer(mock.Anything, original.ID).Times(1).Return(db.Transfer{}, sql.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name:     "AlreadyReversed",
+			username: receiver.Owner,
+			role:     util.DepositorRole,
+			body:     fmt.Sprintf(`{"transfer_id": %d}`, original.ID),
+			buildStubs: func(store *mocks.Store) {
+				store.EXPECT().GetTransfer(mock.Anything, original.ID).Times(1).Return(original, nil)
+				store.EXPECT().GetAccount(mock.Anything, receiver.ID).Times(1).Return(receiver, nil)
+				store.EXPECT().
+					ReverseTransferTx(mock.Anything, mock.Anything).
+					Times(1).
+					Return(db.ReverseTransferTxResult{}, db.ErrTransferAlreadyReversed)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code) // failed precondition
+			},
+		},
+		{
+			name:     "SenderClosed",
+			username: receiver.Owner,
+			role:     util.DepositorRole,
+			body:     fmt.Sprintf(`{"transfer_id": %d}`, original.ID),
+			buildStubs: func(store *mocks.Store) {
+				store.EXPECT().GetTransfer(mock.Anything, original.ID).Times(1).Return(original, nil)
+				store.EXPECT().GetAccount(mock.Anything, receiver.ID).Times(1).Return(receiver, nil)
+				store.EXPECT().
+					ReverseTransferTx(mock.Anything, mock.Anything).
+					Times(1).
+					Return(db.ReverseTransferTxResult{}, &db.AccountStatusError{AccountID: sender.ID, Status: db.AccountClosed})
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code) // failed precondition
+				assert.Contains(t, recorder.Body.String(), "ACCOUNT_CLOSED")
+				assert.Contains(t, recorder.Body.String(), fmt.Sprintf("%s/%d", resourceTypeAccount, sender.ID))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := mocks.NewStore(t)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			mux := runtime.NewServeMux()
+			err := pb.RegisterSimpleBankHandlerServer(context.Background(), mux, NewGatewayServer(server))
+			assert.NoError(t, err)
+
+			accessToken, _, err := server.tokenMaker.CreateToken(tc.username, tc.role, time.Minute)
+			assert.NoError(t, err)
+
+			request := httptest.NewRequest(http.MethodPost, "/v1/reverse_transfer", strings.NewReader(tc.body))
+			request.Header.Set("Authorization", fmt.Sprintf("%s %s", authorizationBearer, accessToken))
+			recorder := httptest.NewRecorder()
+			mux.ServeHTTP(recorder, request)
+
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
@@ -0,0 +1,394 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	db "github.com/tgfukuda/be-master/db/sqlc"
+	"github.com/tgfukuda/be-master/mocks"
+	"github.com/tgfukuda/be-master/pb"
+	"github.com/tgfukuda/be-master/util"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func TestScheduledTransfer(t *testing.T) {
+	owner := util.RandomOwner()
+	from := db.Account{ID: 1, Owner: owner, Currency: util.USD}
+	to := db.Account{ID: 2, Owner: util.RandomOwner(), Currency: util.USD}
+	scheduled := db.ScheduledTransfer{
+		ID:            util.RandomInt(1, 1000),
+		Owner:         owner,
+		FromAccountID: from.ID,
+		ToAccountID:   to.ID,
+		Amount:        10,
+		Currency:      util.USD,
+		Schedule:      "0 9 1 * *",
+		Status:        db.ScheduledTransferActive,
+		NextRunAt:     time.Now().Add(time.Hour).Truncate(time.Second),
+	}
+	paused := scheduled
+	paused.Status = db.ScheduledTransferPaused
+
+	createBody := func(schedule string) string {
+		return fmt.Sprintf(`{"from_account_id": %d, "to_account_id": %d, "amount": 10, "currency": "USD", "schedule": %q}`,
+			from.ID, to.ID, schedule)
+	}
+
+	testCases := []struct {
+		name          string
+		username      string
+		path          string
+		body          string
+		buildStubs    func(store *mocks.Store)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:     "Create",
+			username: owner,
+			path:     "/v1/create_scheduled_transfer",
+			body:     createBody("0 9 1 * *"),
+			buildStubs: func(store *mocks.Store) {
+				store.EXPECT().GetAccount(mock.Anything, from.ID).Times(1).Return(from, nil)
+				store.EXPECT().GetAccount(mock.Anything, to.ID).Times(1).Return(to, nil)
+				store.EXPECT().
+					CreateScheduledTransfer(mock.Anything, mock.MatchedBy(func(arg db.CreateScheduledTransferParams) bool {
+						// the 1st of the next month at 9:00 in UTC
+						return arg.Owner == owner &&
+							arg.Schedule == "0 9 1 * *" &&
+							arg.NextRunAt.Day() == 1 && arg.NextRunAt.Hour() == 9 && arg.NextRunAt.After(time.Now())
+					})).
+					Times(1).
+					Return(scheduled, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+
+				var rsp pb.CreateScheduledTransferResponse
+				err := protojson.Unmarshal(recorder.Body.Bytes(), &rsp)
+				assert.NoError(t, err)
+				assert.Equal(t, scheduled.ID, rsp.ScheduledTransfer.Id)
+				assert.Equal(t, db.ScheduledTransferActive, rsp.ScheduledTransfer.Status)
+			},
+		},
+		{
+			name:       "CreateInvalidSchedule",
+			username:   owner,
+			path:       "/v1/create_scheduled_transfer",
+			body:       createBody("@every 1s"),
+			buildStubs: func(store *mocks.Store) {},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:     "CreateNotOwner",
+			username: to.Owner,
+			path:     "/v1/create_scheduled_transfer",
+			body:     createBody("@monthly"),
+			buildStubs: func(store *mocks.Store) {
+				store.EXPECT().GetAccount(mock.Anything, from.ID).Times(1).Return(from, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name:     "Pause",
+			username: owner,
+			path:     "/v1/pause_scheduled_transfer",
+			body:     fmt.Sprintf(`{"id": %d}`, scheduled.ID),
+			buildStubs: func(store *mocks.Store) {
+				store.EXPECT().GetScheduledTransfer(mock.Anything, scheduled.ID).Times(1).Return(scheduled, nil)
+				store.EXPECT().
+					UpdateScheduledTransferStatus(mock.Anything, db.UpdateScheduledTransferStatusParams{
+						Status:       db.ScheduledTransferPaused,
+						NextRunAt:    scheduled.NextRunAt,
+						ID:           scheduled.ID,
+						FromStatuses: []string{db.ScheduledTransferActive},
+					}).
+					Times(1).
+					Return(paused, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:     "PauseNotOwner",
+			username: to.Owner,
+			path:     "/v1/pause_scheduled_transfer",
+			body:     fmt.Sprintf(`{"id": %d}`, scheduled.ID),
+			buildStubs: func(store *mocks.Store) {
+				store.EXPECT().GetScheduledTransfer(mock.Anything, scheduled.ID).Times(1).Return(scheduled, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name:     "ResumeNotPaused",
+			username: owner,
+			path:     "/v1/resume_scheduled_transfer",
+			body:     fmt.Sprintf(`{"id": %d}`, scheduled.ID),
+			buildStubs: func(store *mocks.Store) {
+				store.EXPECT().GetScheduledTransfer(mock.Anything, scheduled.ID).Times(1).Return(scheduled, nil)
+				store.EXPECT().
+					UpdateScheduledTransferStatus(mock.Anything, mock.MatchedBy(func(arg db.UpdateScheduledTransferStatusParams) bool {
+						return arg.Status == db.ScheduledTransferActive && arg.NextRunAt.After(time.Now())
+					})).
+					Times(1).
+					Return(db.ScheduledTransfer{}, sql.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code) // failed precondition
+			},
+		},
+		{
+			name:     "Cancel",
+			username: owner,
+			path:     "/v1/cancel_scheduled_transfer",
+			body:     fmt.Sprintf(`{"id": %d}`, scheduled.ID),
+			buildStubs: func(store *mocks.Store) {
+				cancelled := scheduled
+				cancelled.Status = db.ScheduledTransferCancelled
+
+				store.EXPECT().GetScheduledTransfer(mock.Anything, scheduled.ID).Times(1).Return(paused, nil)
+				store.EXPECT().
+					UpdateScheduledTransferStatus(mock.Anything, mock.MatchedBy(func(arg db.UpdateScheduledTransferStatusParams) bool {
+						return arg.Status == db.ScheduledTransferCancelled && len(arg.FromStatuses) == 2
+					})).
+					Times(1).
+					Return(cancelled, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := mocks.NewStore(t)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			mux := runtime.NewServeMux()
+			err := pb.RegisterSimpleBankHandlerServer(context.Background(), mux, NewGatewayServer(server))
+			assert.NoError(t, err)
+
+			accessToken, _, err := server.tokenMaker.CreateToken(tc.username, util.DepositorRole, time.Minute)
+			assert.NoError(t, err)
+
+			request := httptest.NewRequest(http.MethodPost, tc.path, strings.NewReader(tc.body))
+			request.Header.Set("Authorization", fmt.Sprintf("%s %s", authorizationBearer, accessToken))
+			recorder := httptest.NewRecorder()
+			mux.ServeHTTP(recorder, request)
+
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestListScheduledTransfers(t *testing.T) {
+	owner := util.RandomOwner()
+	scheduledTransfers := make([]db.ScheduledTransfer, 3)
+	for i := range scheduledTransfers {
+		scheduledTransfers[i] = db.ScheduledTransfer{
+			ID:        int64(i + 1),
+			Owner:     owner,
+			Amount:    10,
+			Currency:  util.USD,
+			Schedule:  "@daily",
+			Status:    db.ScheduledTransferActive,
+			CreatedAt: time.Date(2023, 6, 1, 0, 0, i, 0, time.UTC),
+		}
+	}
+	scheduled := scheduledTransfers[0]
+
+	// the newest first
+	runs := make([]db.ScheduledTransferRun, 3)
+	for i := range runs {
+		runs[i] = db.ScheduledTransferRun{
+			ID:                  int64(len(runs) - i),
+			ScheduledTransferID: scheduled.ID,
+			Status:              db.ScheduledTransferRunSucceeded,
+			CreatedAt:           time.Date(2023, 6, 1, 0, 0, len(runs)-i, 0, time.UTC),
+		}
+	}
+
+	transfersQuery := "scheduled_transfers:" + owner
+	runsQuery := fmt.Sprintf("scheduled_transfer_runs:%d", scheduled.ID)
+
+	testCases := []struct {
+		name          string
+		username      string
+		path          string
+		buildStubs    func(store *mocks.Store)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:     "ListFirstPage",
+			username: owner,
+			path:     "/v1/list_scheduled_transfers?page_size=2",
+			buildStubs: func(store *mocks.Store) {
+				store.EXPECT().
+					ListScheduledTransfers(mock.Anything, db.ListScheduledTransfersParams{Owner: owner, LimitCount: 3}).
+					Times(1).
+					Return(scheduledTransfers, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+
+				var rsp pb.ListScheduledTransfersResponse
+				err := protojson.Unmarshal(recorder.Body.Bytes(), &rsp)
+				assert.NoError(t, err)
+				assert.Len(t, rsp.ScheduledTransfers, 2)
+
+				cursor, err := util.DecodePageToken(rsp.NextPageToken, transfersQuery)
+				assert.NoError(t, err)
+				assert.Equal(t, scheduledTransfers[1].ID, cursor.ID)
+			},
+		},
+		{
+			name:     "ListLastPage",
+			username: owner,
+			path: "/v1/list_scheduled_transfers?page_size=2&page_token=" +
+				util.EncodePageToken(util.PageCursor{CreatedAt: scheduledTransfers[1].CreatedAt, ID: scheduledTransfers[1].ID}, transfersQuery),
+			buildStubs: func(store *mocks.Store) {
+				store.EXPECT().
+					ListScheduledTransfers(mock.Anything, mock.MatchedBy(func(arg db.ListScheduledTransfersParams) bool {
+						return arg.AfterCreatedAt.Equal(scheduledTransfers[1].CreatedAt) && arg.AfterID == scheduledTransfers[1].ID && arg.LimitCount == 3
+					})).
+					Times(1).
+					Return(scheduledTransfers[2:], nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+
+				var rsp pb.ListScheduledTransfersResponse
+				err := protojson.Unmarshal(recorder.Body.Bytes(), &rsp)
+				assert.NoError(t, err)
+				assert.Len(t, rsp.ScheduledTransfers, 1)
+				assert.Empty(t, rsp.NextPageToken)
+			},
+		},
+		{
+			name:       "ListPageTokenOfAnotherUser",
+			username:   util.RandomOwner(),
+			path:       "/v1/list_scheduled_transfers?page_token=" + util.EncodePageToken(util.PageCursor{ID: 1}, transfersQuery),
+			buildStubs: func(store *mocks.Store) {},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+				assertFieldViolation(t, recorder, "page_token")
+			},
+		},
+		{
+			name:       "ListNegativePageSize",
+			username:   owner,
+			path:       "/v1/list_scheduled_transfers?page_size=-1",
+			buildStubs: func(store *mocks.Store) {},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+				assertFieldViolation(t, recorder, "page_size")
+			},
+		},
+		{
+			// the default size of the server
+			name:     "ListRunsFirstPage",
+			username: owner,
+			path:     fmt.Sprintf("/v1/list_scheduled_transfer_runs?scheduled_transfer_id=%d", scheduled.ID),
+			buildStubs: func(store *mocks.Store) {
+				store.EXPECT().GetScheduledTransfer(mock.Anything, scheduled.ID).Times(1).Return(scheduled, nil)
+				store.EXPECT().
+					ListScheduledTransferRuns(mock.Anything, db.ListScheduledTransferRunsParams{
+						ScheduledTransferID: scheduled.ID,
+						BeforeCreatedAt:     maxTransferTime,
+						LimitCount:          6,
+					}).
+					Times(1).
+					Return(runs, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+
+				var rsp pb.ListScheduledTransferRunsResponse
+				err := protojson.Unmarshal(recorder.Body.Bytes(), &rsp)
+				assert.NoError(t, err)
+				assert.Len(t, rsp.Runs, 3)
+				assert.Empty(t, rsp.NextPageToken)
+			},
+		},
+		{
+			name:     "ListRunsNextPage",
+			username: owner,
+			path: fmt.Sprintf("/v1/list_scheduled_transfer_runs?scheduled_transfer_id=%d&page_size=1&page_token=%s", scheduled.ID,
+				util.EncodePageToken(util.PageCursor{CreatedAt: runs[0].CreatedAt, ID: runs[0].ID}, runsQuery)),
+			buildStubs: func(store *mocks.Store) {
+				store.EXPECT().GetScheduledTransfer(mock.Anything, scheduled.ID).Times(1).Return(scheduled, nil)
+				store.EXPECT().
+					ListScheduledTransferRuns(mock.Anything, mock.MatchedBy(func(arg db.ListScheduledTransferRunsParams) bool {
+						return arg.BeforeCreatedAt.Equal(runs[0].CreatedAt) && arg.BeforeID == runs[0].ID && arg.LimitCount == 2
+					})).
+					Times(1).
+					Return(runs[1:], nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+
+				var rsp pb.ListScheduledTransferRunsResponse
+				err := protojson.Unmarshal(recorder.Body.Bytes(), &rsp)
+				assert.NoError(t, err)
+				assert.Len(t, rsp.Runs, 1)
+
+				cursor, err := util.DecodePageToken(rsp.NextPageToken, runsQuery)
+				assert.NoError(t, err)
+				assert.Equal(t, runs[1].ID, cursor.ID)
+			},
+		},
+		{
+			// the token of the runs of another scheduled transfer
+			name:     "ListRunsPageTokenOfAnotherScheduledTransfer",
+			username: owner,
+			path: fmt.Sprintf("/v1/list_scheduled_transfer_runs?scheduled_transfer_id=%d&page_token=%s", scheduled.ID,
+				util.EncodePageToken(util.PageCursor{ID: 1}, "scheduled_transfer_runs:0")),
+			buildStubs: func(store *mocks.Store) {
+				store.EXPECT().GetScheduledTransfer(mock.Anything, scheduled.ID).Times(1).Return(scheduled, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+				assertFieldViolation(t, recorder, "page_token")
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := mocks.NewStore(t)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			mux := runtime.NewServeMux()
+			err := pb.RegisterSimpleBankHandlerServer(context.Background(), mux, NewGatewayServer(server))
+			assert.NoError(t, err)
+
+			accessToken, _, err := server.tokenMaker.CreateToken(tc.username, util.DepositorRole, time.Minute)
+			assert.NoError(t, err)
+
+			request := httptest.NewRequest(http.MethodGet, tc.path, nil)
+			request.Header.Set("Authorization", fmt.Sprintf("%s %s", authorizationBearer, accessToken))
+			recorder := httptest.NewRecorder()
+			mux.ServeHTTP(recorder, request)
+
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
@@ -0,0 +1,141 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	db "github.com/tgfukuda/be-master/db/sqlc"
+	"github.com/tgfukuda/be-master/pb"
+	"github.com/tgfukuda/be-master/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) CloseAccount(ctx context.Context, req *pb.CloseAccountRequest) (*pb.CloseAccountResponse, error) {
+	account, err := server.updateAccountStatus(ctx, req.GetId(), db.AccountActive, db.AccountClosed, req.GetReason(), false)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CloseAccountResponse{Account: account}, nil
+}
+
+func (server *Server) ReopenAccount(ctx context.Context, req *pb.ReopenAccountRequest) (*pb.ReopenAccountResponse, error) {
+	account, err := server.updateAccountStatus(ctx, req.GetId(), db.AccountClosed, db.AccountActive, req.GetReason(), false)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ReopenAccountResponse{Account: account}, nil
+}
+
+// FreezeAccount is only for bankers, e.g. on a fraud report. the reason is required for the audit
+func (server *Server) FreezeAccount(ctx context.Context, req *pb.FreezeAccountRequest) (*pb.FreezeAccountResponse, error) {
+	account, err := server.updateAccountStatus(ctx, req.GetId(), db.AccountActive, db.AccountFrozen, req.GetReason(), true)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.FreezeAccountResponse{Account: account}, nil
+}
+
+func (server *Server) UnfreezeAccount(ctx context.Context, req *pb.UnfreezeAccountRequest) (*pb.UnfreezeAccountResponse, error) {
+	account, err := server.updateAccountStatus(ctx, req.GetId(), db.AccountFrozen, db.AccountActive, req.GetReason(), true)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.UnfreezeAccountResponse{Account: account}, nil
+}
+
+// updateAccountStatus moves the account from fromStatus to newStatus on behalf of the authenticated user.
+// the staff methods are authorized by their policy instead of the owner, and must give the reason.
+// the returned error is already a gRPC status.
+func (server *Server) updateAccountStatus(ctx context.Context, id int64, fromStatus string, newStatus string, reason string, byStaff bool) (*pb.Account, error) {
+	authPayload, err := authPayloadFromContext(ctx)
+	if err != nil {
+		return nil, unauthorizedError(err)
+	}
+
+	var violations []*errdetails.BadRequest_FieldViolation
+	if err := val.ValidateAccountId(id); err != nil {
+		violations = append(violations, fieldViolation("id", err))
+	}
+	if byStaff || reason != "" {
+		if err := val.ValidateAccountStatusReason(reason); err != nil {
+			violations = append(violations, fieldViolation("reason", err))
+		}
+	}
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	notFound := notFoundError(resourceTypeAccount, strconv.FormatInt(id, 10), fmt.Sprintf("account [%d] doesn't exist", id))
+
+	if !byStaff {
+		account, err := server.store.GetAccount(ctx, id)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return nil, notFound
+			}
+
+			return nil, status.Errorf(codes.Internal, "failed to get account: %s", err)
+		}
+
+		if account.Owner != authPayload.Username {
+			return nil, permissionDeniedError(
+				resourceTypeAccount,
+				strconv.FormatInt(account.ID, 10),
+				account.Owner,
+				"account doesn't belong to the authenticated user",
+			)
+		}
+	}
+
+	result, err := server.store.UpdateAccountStatusTx(ctx, db.UpdateAccountStatusTxParams{
+		AccountID:  id,
+		FromStatus: fromStatus,
+		Status:     newStatus,
+		Reason:     reason,
+		Actor:      authPayload.Username,
+	})
+	if err != nil {
+		subject := fmt.Sprintf("%s/%d", resourceTypeAccount, id)
+
+		switch {
+		case err == sql.ErrNoRows:
+			return nil, notFound
+		case errors.Is(err, db.ErrInvalidAccountStatusTransition):
+			return nil, failedPreconditionError([]*errdetails.PreconditionFailure_Violation{
+				preconditionViolation("INVALID_STATUS", subject, err.Error()),
+			})
+		case errors.Is(err, db.ErrAccountBalanceNotZero):
+			return nil, failedPreconditionError([]*errdetails.PreconditionFailure_Violation{
+				preconditionViolation("BALANCE_NOT_ZERO", subject, err.Error()),
+			})
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to update account status: %s", err)
+	}
+
+	return convertAccount(result.Account), nil
+}
+
+// accountStatusViolation reports the frozen or closed account which stopped a transfer. nil for the other errors
+func accountStatusViolation(err error) *errdetails.PreconditionFailure_Violation {
+	var statusErr *db.AccountStatusError
+	if !errors.As(err, &statusErr) {
+		return nil
+	}
+
+	return preconditionViolation(
+		"ACCOUNT_"+strings.ToUpper(statusErr.Status),
+		fmt.Sprintf("%s/%d", resourceTypeAccount, statusErr.AccountID),
+		err.Error(),
+	)
+}
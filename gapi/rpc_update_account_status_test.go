@@ -0,0 +1,223 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	db "github.com/tgfukuda/be-master/db/sqlc"
+	"github.com/tgfukuda/be-master/mocks"
+	"github.com/tgfukuda/be-master/pb"
+	"github.com/tgfukuda/be-master/util"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func TestUpdateAccountStatus(t *testing.T) {
+	account := db.Account{ID: util.RandomInt(1, 1000), Owner: util.RandomOwner(), Currency: util.USD, Status: db.AccountActive}
+	banker := util.RandomOwner()
+	reason := "reported as stolen"
+
+	withStatus := func(status string) db.UpdateAccountStatusTxResult {
+		updated := account
+		updated.Status = status
+		return db.UpdateAccountStatusTxResult{Account: updated}
+	}
+
+	testCases := []struct {
+		name          string
+		username      string
+		role          string
+		path          string
+		body          string
+		buildStubs    func(store *mocks.Store)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:     "Freeze",
+			username: banker,
+			role:     util.BankerRole,
+			path:     "/v1/freeze_account",
+			body:     fmt.Sprintf(`{"id": %d, "reason": %q}`, account.ID, reason),
+			buildStubs: func(store *mocks.Store) {
+				store.EXPECT().
+					UpdateAccountStatusTx(mock.Anything, db.UpdateAccountStatusTxParams{
+						AccountID:  account.ID,
+						FromStatus: db.AccountActive,
+						Status:     db.AccountFrozen,
+						Reason:     reason,
+						Actor:      banker,
+					}).
+					Times(1).
+					Return(withStatus(db.AccountFrozen), nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+
+				var rsp pb.FreezeAccountResponse
+				err := protojson.Unmarshal(recorder.Body.Bytes(), &rsp)
+				assert.NoError(t, err)
+				assert.Equal(t, db.AccountFrozen, rsp.Account.Status)
+			},
+		},
+		{
+			name:       "FreezeByDepositor",
+			username:   account.Owner,
+			role:       util.DepositorRole,
+			path:       "/v1/freeze_account",
+			body:       fmt.Sprintf(`{"id": %d, "reason": %q}`, account.ID, reason),
+			buildStubs: func(store *mocks.Store) {},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name:       "FreezeWithoutReason",
+			username:   banker,
+			role:       util.BankerRole,
+			path:       "/v1/freeze_account",
+			body:       fmt.Sprintf(`{"id": %d}`, account.ID),
+			buildStubs: func(store *mocks.Store) {},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:     "UnfreezeClosed",
+			username: banker,
+			role:     util.BankerRole,
+			path:     "/v1/unfreeze_account",
+			body:     fmt.Sprintf(`{"id": %d, "reason": %q}`, account.ID, reason),
+			buildStubs: func(store *mocks.Store) {
+				// only a frozen account can be unfrozen, so a closed one is left closed
+				store.EXPECT().
+					UpdateAccountStatusTx(mock.Anything, db.UpdateAccountStatusTxParams{
+						AccountID:  account.ID,
+						FromStatus: db.AccountFrozen,
+						Status:     db.AccountActive,
+						Reason:     reason,
+						Actor:      banker,
+					}).
+					Times(1).
+					Return(db.UpdateAccountStatusTxResult{}, db.ErrInvalidAccountStatusTransition)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+				assert.Contains(t, recorder.Body.String(), "INVALID_STATUS")
+			},
+		},
+		{
+			name:     "ReopenFrozen",
+			username: account.Owner,
+			role:     util.DepositorRole,
+			path:     "/v1/reopen_account",
+			body:     fmt.Sprintf(`{"id": %d}`, account.ID),
+			buildStubs: func(store *mocks.Store) {
+				frozen := account
+				frozen.Status = db.AccountFrozen
+
+				// only a closed account can be reopened, so the owner can't undo the freeze
+				store.EXPECT().GetAccount(mock.Anything, account.ID).Times(1).Return(frozen, nil)
+				store.EXPECT().
+					UpdateAccountStatusTx(mock.Anything, db.UpdateAccountStatusTxParams{
+						AccountID:  account.ID,
+						FromStatus: db.AccountClosed,
+						Status:     db.AccountActive,
+						Actor:      account.Owner,
+					}).
+					Times(1).
+					Return(db.UpdateAccountStatusTxResult{}, db.ErrInvalidAccountStatusTransition)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+				assert.Contains(t, recorder.Body.String(), "INVALID_STATUS")
+			},
+		},
+		{
+			name:     "Close",
+			username: account.Owner,
+			role:     util.DepositorRole,
+			path:     "/v1/close_account",
+			body:     fmt.Sprintf(`{"id": %d}`, account.ID),
+			buildStubs: func(store *mocks.Store) {
+				store.EXPECT().GetAccount(mock.Anything, account.ID).Times(1).Return(account, nil)
+				store.EXPECT().
+					UpdateAccountStatusTx(mock.Anything, db.UpdateAccountStatusTxParams{
+						AccountID:  account.ID,
+						FromStatus: db.AccountActive,
+						Status:     db.AccountClosed,
+						Actor:      account.Owner,
+					}).
+					Times(1).
+					Return(withStatus(db.AccountClosed), nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+
+				var rsp pb.CloseAccountResponse
+				err := protojson.Unmarshal(recorder.Body.Bytes(), &rsp)
+				assert.NoError(t, err)
+				assert.Equal(t, db.AccountClosed, rsp.Account.Status)
+			},
+		},
+		{
+			name:     "CloseWithBalance",
+			username: account.Owner,
+			role:     util.DepositorRole,
+			path:     "/v1/delete_account",
+			body:     fmt.Sprintf(`{"id": %d}`, account.ID),
+			buildStubs: func(store *mocks.Store) {
+				store.EXPECT().GetAccount(mock.Anything, account.ID).Times(1).Return(account, nil)
+				store.EXPECT().
+					UpdateAccountStatusTx(mock.Anything, mock.Anything).
+					Times(1).
+					Return(db.UpdateAccountStatusTxResult{}, db.ErrAccountBalanceNotZero)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+				assert.Contains(t, recorder.Body.String(), "BALANCE_NOT_ZERO")
+			},
+		},
+		{
+			name:     "ReopenOtherUser",
+			username: util.RandomOwner(),
+			role:     util.DepositorRole,
+			path:     "/v1/reopen_account",
+			body:     fmt.Sprintf(`{"id": %d}`, account.ID),
+			buildStubs: func(store *mocks.Store) {
+				store.EXPECT().GetAccount(mock.Anything, account.ID).Times(1).Return(account, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := mocks.NewStore(t)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			mux := runtime.NewServeMux()
+			err := pb.RegisterSimpleBankHandlerServer(context.Background(), mux, NewGatewayServer(server))
+			assert.NoError(t, err)
+
+			accessToken, _, err := server.tokenMaker.CreateToken(tc.username, tc.role, time.Minute)
+			assert.NoError(t, err)
+
+			request := httptest.NewRequest(http.MethodPost, tc.path, strings.NewReader(tc.body))
+			request.Header.Set("Authorization", fmt.Sprintf("%s %s", authorizationBearer, accessToken))
+			recorder := httptest.NewRecorder()
+			mux.ServeHTTP(recorder, request)
+
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
@@ -0,0 +1,111 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strconv"
+	"time"
+
+	db "github.com/tgfukuda/be-master/db/sqlc"
+	"github.com/tgfukuda/be-master/pb"
+	"github.com/tgfukuda/be-master/util"
+	"github.com/tgfukuda/be-master/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const resourceTypeScheduledTransfer = "scheduled_transfer"
+
+func (server *Server) PauseScheduledTransfer(ctx context.Context, req *pb.PauseScheduledTransferRequest) (*pb.PauseScheduledTransferResponse, error) {
+	scheduled, err := server.updateScheduledTransferStatus(ctx, req.GetId(), db.ScheduledTransferPaused, db.ScheduledTransferActive)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.PauseScheduledTransferResponse{ScheduledTransfer: scheduled}, nil
+}
+
+func (server *Server) ResumeScheduledTransfer(ctx context.Context, req *pb.ResumeScheduledTransferRequest) (*pb.ResumeScheduledTransferResponse, error) {
+	scheduled, err := server.updateScheduledTransferStatus(ctx, req.GetId(), db.ScheduledTransferActive, db.ScheduledTransferPaused)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ResumeScheduledTransferResponse{ScheduledTransfer: scheduled}, nil
+}
+
+func (server *Server) CancelScheduledTransfer(ctx context.Context, req *pb.CancelScheduledTransferRequest) (*pb.CancelScheduledTransferResponse, error) {
+	scheduled, err := server.updateScheduledTransferStatus(
+		ctx,
+		req.GetId(),
+		db.ScheduledTransferCancelled,
+		db.ScheduledTransferActive,
+		db.ScheduledTransferPaused,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CancelScheduledTransferResponse{ScheduledTransfer: scheduled}, nil
+}
+
+// updateScheduledTransferStatus moves the scheduled transfer of the authenticated user to the status
+// if it's in one of fromStatuses. the returned error is already a gRPC status.
+func (server *Server) updateScheduledTransferStatus(ctx context.Context, id int64, newStatus string, fromStatuses ...string) (*pb.ScheduledTransfer, error) {
+	authPayload, err := authPayloadFromContext(ctx)
+	if err != nil {
+		return nil, unauthorizedError(err)
+	}
+
+	if err := val.ValidateScheduledTransferId(id); err != nil {
+		return nil, invalidArgumentError([]*errdetails.BadRequest_FieldViolation{fieldViolation("id", err)})
+	}
+
+	scheduled, err := server.getScheduledTransfer(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if scheduled.Owner != authPayload.Username {
+		return nil, permissionDeniedError(
+			resourceTypeScheduledTransfer,
+			strconv.FormatInt(scheduled.ID, 10),
+			scheduled.Owner,
+			"scheduled transfer doesn't belong to the authenticated user",
+		)
+	}
+
+	// a resumed one skips the runs missed while paused
+	nextRunAt := scheduled.NextRunAt
+	if newStatus == db.ScheduledTransferActive {
+		schedule, err := util.ParseSchedule(scheduled.Schedule)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "invalid schedule: %s", err)
+		}
+		nextRunAt = schedule.Next(time.Now())
+	}
+
+	scheduled, err = server.store.UpdateScheduledTransferStatus(ctx, db.UpdateScheduledTransferStatusParams{
+		Status:       newStatus,
+		NextRunAt:    nextRunAt,
+		ID:           scheduled.ID,
+		FromStatuses: fromStatuses,
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, failedPreconditionError([]*errdetails.PreconditionFailure_Violation{
+				preconditionViolation(
+					"INVALID_STATUS",
+					fmt.Sprintf("%s/%d", resourceTypeScheduledTransfer, id),
+					fmt.Sprintf("scheduled transfer [%d] can't be %s from its status", id, newStatus),
+				),
+			})
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to update scheduled transfer: %s", err)
+	}
+
+	return convertScheduledTransfer(scheduled), nil
+}
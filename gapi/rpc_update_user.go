@@ -7,6 +7,7 @@ import (
 
 	db "github.com/tgfukuda/be-master/db/sqlc"
 	"github.com/tgfukuda/be-master/pb"
+	"github.com/tgfukuda/be-master/token"
 	"github.com/tgfukuda/be-master/util"
 	"github.com/tgfukuda/be-master/val"
 	"google.golang.org/genproto/googleapis/rpc/errdetails"
@@ -15,18 +16,31 @@ import (
 )
 
 func (server *Server) UpdateUser(ctx context.Context, req *pb.UpdateUserRequest) (*pb.UpdateUserResponse, error) {
-	authPayload, err := server.authorizeUser(ctx)
+	authPayload, err := authPayloadFromContext(ctx)
 	if err != nil {
 		return nil, unauthorizedError(err)
 	}
 
+	// the bank user owns the exchange accounts without overdraft limit, so nobody can take it over,
+	// checked before the validation which doesn't promise to reject the name
+	if req.GetUsername() == db.BankUsername {
+		return nil, status.Errorf(codes.PermissionDenied, "cannot update %s", db.BankUsername)
+	}
+
 	violations := validateUpdateUserRequest(req)
 	if violations != nil {
 		return nil, invalidArgumentError(violations)
 	}
 
 	if authPayload.Username != req.GetUsername() {
-		return nil, status.Errorf(codes.PermissionDenied, "cannot update other user's info")
+		if err := server.authorizeUpdateOtherUser(ctx, authPayload, req); err != nil {
+			return nil, err
+		}
+	}
+
+	// only admin can grant roles
+	if len(req.GetRole()) > 0 && authPayload.CheckRole([]string{util.AdminRole}) != nil {
+		return nil, status.Errorf(codes.PermissionDenied, "cannot update role")
 	}
 
 	arg := db.UpdateUserParams{
@@ -39,6 +53,10 @@ func (server *Server) UpdateUser(ctx context.Context, req *pb.UpdateUserRequest)
 			String: req.GetEmail(),
 			Valid:  len(req.GetEmail()) > 0,
 		},
+		Role: sql.NullString{
+			String: req.GetRole(),
+			Valid:  len(req.GetRole()) > 0,
+		},
 	}
 
 	if len(req.GetPassword()) > 0 {
@@ -73,6 +91,37 @@ func (server *Server) UpdateUser(ctx context.Context, req *pb.UpdateUserRequest)
 	return rsp, nil
 }
 
+// authorizeUpdateOtherUser lets admin update any user. bankers can update only the profile of depositors,
+// not the credentials which would let them log in as the user. the returned error is already a gRPC status.
+func (server *Server) authorizeUpdateOtherUser(ctx context.Context, authPayload *token.Payload, req *pb.UpdateUserRequest) error {
+	if authPayload.CheckRole([]string{util.AdminRole}) == nil {
+		return nil
+	}
+
+	if authPayload.CheckRole([]string{util.BankerRole}) != nil {
+		return status.Errorf(codes.PermissionDenied, "cannot update other user's info")
+	}
+
+	if len(req.GetPassword()) > 0 || len(req.GetEmail()) > 0 {
+		return status.Errorf(codes.PermissionDenied, "only admin can update other user's password or email")
+	}
+
+	target, err := server.store.GetUser(ctx, req.GetUsername())
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return status.Errorf(codes.NotFound, "user not found")
+		}
+
+		return status.Errorf(codes.Internal, "failed to get user: %s", err)
+	}
+
+	if target.Role != util.DepositorRole {
+		return status.Errorf(codes.PermissionDenied, "banker can update only depositors")
+	}
+
+	return nil
+}
+
 func validateUpdateUserRequest(req *pb.UpdateUserRequest) (violations []*errdetails.BadRequest_FieldViolation) {
 	if err := val.ValidateUsername(req.GetUsername()); err != nil {
 		violations = append(violations, fieldViolation("username", err))
@@ -96,5 +145,11 @@ func validateUpdateUserRequest(req *pb.UpdateUserRequest) (violations []*errdeta
 		}
 	}
 
+	if len(req.GetRole()) > 0 {
+		if err := val.ValidateRole(req.GetRole()); err != nil {
+			violations = append(violations, fieldViolation("role", err))
+		}
+	}
+
 	return violations
 }
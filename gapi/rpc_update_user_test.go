@@ -0,0 +1,164 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	db "github.com/tgfukuda/be-master/db/sqlc"
+	"github.com/tgfukuda/be-master/mocks"
+	"github.com/tgfukuda/be-master/pb"
+	"github.com/tgfukuda/be-master/util"
+)
+
+func TestUpdateUser(t *testing.T) {
+	depositor := db.User{Username: util.RandomOwner(), Role: util.DepositorRole}
+	otherBanker := db.User{Username: util.RandomOwner(), Role: util.BankerRole}
+	banker := util.RandomOwner()
+	admin := util.RandomOwner()
+
+	updated := func(user db.User) func(store *mocks.Store) {
+		return func(store *mocks.Store) {
+			store.EXPECT().
+				UpdateUser(mock.Anything, mock.MatchedBy(func(arg db.UpdateUserParams) bool {
+					return arg.Username == user.Username
+				})).
+				Times(1).
+				Return(user, nil)
+		}
+	}
+
+	testCases := []struct {
+		name          string
+		username      string
+		role          string
+		body          string
+		buildStubs    func(store *mocks.Store)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:       "Self",
+			username:   depositor.Username,
+			role:       util.DepositorRole,
+			body:       fmt.Sprintf(`{"username": %q, "password": "secret123", "email": "new@example.com"}`, depositor.Username),
+			buildStubs: updated(depositor),
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:       "OtherUserByDepositor",
+			username:   util.RandomOwner(),
+			role:       util.DepositorRole,
+			body:       fmt.Sprintf(`{"username": %q, "full_name": "New Name"}`, depositor.Username),
+			buildStubs: func(store *mocks.Store) {},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name:     "DepositorProfileByBanker",
+			username: banker,
+			role:     util.BankerRole,
+			body:     fmt.Sprintf(`{"username": %q, "full_name": "New Name"}`, depositor.Username),
+			buildStubs: func(store *mocks.Store) {
+				store.EXPECT().GetUser(mock.Anything, depositor.Username).Times(1).Return(depositor, nil)
+				updated(depositor)(store)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:       "DepositorPasswordByBanker",
+			username:   banker,
+			role:       util.BankerRole,
+			body:       fmt.Sprintf(`{"username": %q, "password": "secret123"}`, depositor.Username),
+			buildStubs: func(store *mocks.Store) {},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name:       "DepositorEmailByBanker",
+			username:   banker,
+			role:       util.BankerRole,
+			body:       fmt.Sprintf(`{"username": %q, "email": "new@example.com"}`, depositor.Username),
+			buildStubs: func(store *mocks.Store) {},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name:     "BankerByBanker",
+			username: banker,
+			role:     util.BankerRole,
+			body:     fmt.Sprintf(`{"username": %q, "full_name": "New Name"}`, otherBanker.Username),
+			buildStubs: func(store *mocks.Store) {
+				store.EXPECT().GetUser(mock.Anything, otherBanker.Username).Times(1).Return(otherBanker, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name:       "PasswordByAdmin",
+			username:   admin,
+			role:       util.AdminRole,
+			body:       fmt.Sprintf(`{"username": %q, "password": "secret123"}`, otherBanker.Username),
+			buildStubs: updated(otherBanker),
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:       "BankUserByAdmin",
+			username:   admin,
+			role:       util.AdminRole,
+			body:       fmt.Sprintf(`{"username": %q, "password": "secret123"}`, db.BankUsername),
+			buildStubs: func(store *mocks.Store) {},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name:       "BankUserBySelf",
+			username:   db.BankUsername,
+			role:       util.DepositorRole,
+			body:       fmt.Sprintf(`{"username": %q, "password": "secret123"}`, db.BankUsername),
+			buildStubs: func(store *mocks.Store) {},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := mocks.NewStore(t)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			mux := runtime.NewServeMux()
+			err := pb.RegisterSimpleBankHandlerServer(context.Background(), mux, NewGatewayServer(server))
+			assert.NoError(t, err)
+
+			accessToken, _, err := server.tokenMaker.CreateToken(tc.username, tc.role, time.Minute)
+			assert.NoError(t, err)
+
+			request := httptest.NewRequest(http.MethodPost, "/v1/update_user", strings.NewReader(tc.body))
+			request.Header.Set("Authorization", fmt.Sprintf("%s %s", authorizationBearer, accessToken))
+			recorder := httptest.NewRecorder()
+			mux.ServeHTTP(recorder, request)
+
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
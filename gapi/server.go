@@ -4,10 +4,13 @@ import (
 	"fmt"
 
 	db "github.com/tgfukuda/be-master/db/sqlc"
+	"github.com/tgfukuda/be-master/fx"
 	"github.com/tgfukuda/be-master/pb"
 	"github.com/tgfukuda/be-master/token"
 	"github.com/tgfukuda/be-master/util"
-	"github.com/tgfukuda/be-master/worker"
+	"google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
 )
 
 type Server struct {
@@ -15,22 +18,39 @@ type Server struct {
 	config                           util.Config
 	store                            db.Store
 	tokenMaker                       token.Maker
-	taskDistributor                  worker.TaskDistributor
+	exchangeRateProvider             fx.ExchangeRateProvider
 }
 
 // new Http Server and setup routes
-func NewServer(config util.Config, store db.Store, taskDistributor worker.TaskDistributor) (*Server, error) {
-	tokenMaker, err := token.NewPasetoMaker(config.TokenSymmetricKey)
+func NewServer(config util.Config, store db.Store) (*Server, error) {
+	tokenMaker, err := token.NewMaker(config)
 	if err != nil {
 		return nil, fmt.Errorf("cannot create token maker: %w", err)
 	}
 
+	exchangeRateProvider, err := fx.NewExchangeRateProvider(config.ExchangeRateFile)
+	if err != nil {
+		return nil, fmt.Errorf("cannot create exchange rate provider: %w", err)
+	}
+
 	server := &Server{
-		config:          config,
-		store:           store,
-		tokenMaker:      tokenMaker,
-		taskDistributor: taskDistributor,
+		config:               config,
+		store:                store,
+		tokenMaker:           tokenMaker,
+		exchangeRateProvider: exchangeRateProvider,
 	}
 
 	return server, nil
 }
+
+// RegisterServices registers every service of the grpc server. their methods need the policies in methodPolicies.
+func RegisterServices(grpcServer *grpc.Server, server *Server, healthServer healthpb.HealthServer) {
+	pb.RegisterSimpleBankServer(grpcServer, server)
+	healthpb.RegisterHealthServer(grpcServer, healthServer)
+	reflection.Register(grpcServer) // add usage to server
+}
+
+// UnaryInterceptors are run in this order on every unary call, both by the grpc server and the gateway
+func (server *Server) UnaryInterceptors() []grpc.UnaryServerInterceptor {
+	return []grpc.UnaryServerInterceptor{GrpcTracer, GrpcLogger, GrpcMetrics, server.AuthInterceptor}
+}
@@ -0,0 +1,168 @@
+package gapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/tgfukuda/be-master/pb"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// the gateway picks the marshaler by the Accept header of the request
+const (
+	MIMEStatementCSV       = "text/csv"
+	MIMEStatementJSONLines = "application/jsonl"
+)
+
+var statementCSVHeader = []string{
+	"type",
+	"created_at",
+	"entry_id",
+	"journal_id",
+	"transfer_id",
+	"counterparty_account_id",
+	"counterparty_owner",
+	"amount",
+	"balance",
+}
+
+// statementMarshaler writes the statements in a downloadable format.
+// the other messages, e.g. the requests and the errors, are left to the embedded marshaler.
+type statementMarshaler struct {
+	runtime.Marshaler
+	contentType string
+	marshal     func(statement *pb.GetStatementResponse) ([]byte, error)
+}
+
+// NewStatementCSVMarshaler writes a row for the opening balance, a row for each entry and a row for the closing balance
+func NewStatementCSVMarshaler(fallback runtime.Marshaler) runtime.Marshaler {
+	return &statementMarshaler{
+		Marshaler:   fallback,
+		contentType: MIMEStatementCSV,
+		marshal:     marshalStatementCSV,
+	}
+}
+
+// NewStatementJSONLinesMarshaler writes the statement without the lines first and then a line for each entry
+func NewStatementJSONLinesMarshaler(fallback runtime.Marshaler) runtime.Marshaler {
+	return &statementMarshaler{
+		Marshaler:   fallback,
+		contentType: MIMEStatementJSONLines,
+		marshal: func(statement *pb.GetStatementResponse) ([]byte, error) {
+			return marshalStatementJSONLines(fallback, statement)
+		},
+	}
+}
+
+func (marshaler *statementMarshaler) ContentType(v interface{}) string {
+	if _, ok := v.(*pb.GetStatementResponse); ok {
+		return marshaler.contentType
+	}
+	return marshaler.Marshaler.ContentType(v)
+}
+
+func (marshaler *statementMarshaler) Marshal(v interface{}) ([]byte, error) {
+	if statement, ok := v.(*pb.GetStatementResponse); ok {
+		return marshaler.marshal(statement)
+	}
+	return marshaler.Marshaler.Marshal(v)
+}
+
+func marshalStatementCSV(statement *pb.GetStatementResponse) ([]byte, error) {
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+
+	formatInt := func(value int64) string {
+		return strconv.FormatInt(value, 10)
+	}
+	formatTime := func(value *timestamppb.Timestamp) string {
+		return value.AsTime().Format(time.RFC3339Nano)
+	}
+
+	records := [][]string{
+		statementCSVHeader,
+		{"opening", formatTime(statement.GetStartTime()), "", "", "", "", "", "", formatInt(statement.GetOpeningBalance())},
+	}
+	for _, line := range statement.GetLines() {
+		records = append(records, []string{
+			"entry",
+			formatTime(line.GetCreatedAt()),
+			formatInt(line.GetEntryId()),
+			formatInt(line.GetJournalId()),
+			formatInt(line.GetTransferId()),
+			formatInt(line.GetCounterpartyAccountId()),
+			line.GetCounterpartyOwner(),
+			formatInt(line.GetAmount()),
+			formatInt(line.GetBalance()),
+		})
+	}
+	records = append(records, []string{"closing", formatTime(statement.GetEndTime()), "", "", "", "", "", "", formatInt(statement.GetClosingBalance())})
+
+	if err := writer.WriteAll(records); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func marshalStatementJSONLines(marshaler runtime.Marshaler, statement *pb.GetStatementResponse) ([]byte, error) {
+	var buf bytes.Buffer
+
+	summary := proto.Clone(statement).(*pb.GetStatementResponse)
+	summary.Lines = nil
+
+	messages := []proto.Message{summary}
+	for _, line := range statement.GetLines() {
+		messages = append(messages, line)
+	}
+
+	for _, message := range messages {
+		data, err := marshaler.Marshal(message)
+		if err != nil {
+			return nil, err
+		}
+		// the lines can't have a line break in them
+		if bytes.ContainsAny(data, "\r\n") {
+			return nil, fmt.Errorf("%T is marshaled in multiple lines", message)
+		}
+
+		buf.Write(data)
+		buf.WriteByte('\n')
+	}
+
+	return buf.Bytes(), nil
+}
+
+// StatementAttachment is a forward response option for the gateway to download the statements as a file
+func StatementAttachment(ctx context.Context, w http.ResponseWriter, msg proto.Message) error {
+	statement, ok := msg.(*pb.GetStatementResponse)
+	if !ok {
+		return nil
+	}
+
+	var extension string
+	switch w.Header().Get("Content-Type") {
+	case MIMEStatementCSV:
+		extension = "csv"
+	case MIMEStatementJSONLines:
+		extension = "jsonl"
+	default:
+		return nil
+	}
+
+	const dateLayout = "20060102T150405Z"
+	w.Header().Set("Content-Disposition", fmt.Sprintf(
+		`attachment; filename="statement-%d-%s-%s.%s"`,
+		statement.GetAccountId(),
+		statement.GetStartTime().AsTime().Format(dateLayout),
+		statement.GetEndTime().AsTime().Format(dateLayout),
+		extension,
+	))
+	return nil
+}
@@ -0,0 +1,82 @@
+package gapi
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tgfukuda/be-master/pb"
+	"github.com/tgfukuda/be-master/tracing"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	grpccodes "google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	parentTraceID = "4bf92f3577b34da6a3ce929d0e0e4736"
+	parentSpanID  = "00f067aa0ba902b7"
+	traceparent   = "00-" + parentTraceID + "-" + parentSpanID + "-01"
+)
+
+func TestGrpcTracer(t *testing.T) {
+	provider, exporter := tracing.NewInMemoryTracerProvider()
+	tracing.Setup(provider)
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("traceparent", traceparent))
+	info := &grpc.UnaryServerInfo{FullMethod: pb.SimpleBank_GetAccount_FullMethodName}
+
+	var handlerSpan trace.SpanContext
+	_, err := GrpcTracer(ctx, nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		handlerSpan = trace.SpanContextFromContext(ctx)
+		return nil, status.Errorf(grpccodes.NotFound, "account not found")
+	})
+	assert.Error(t, err)
+
+	spans := exporter.GetSpans()
+	assert.Len(t, spans, 1)
+	assert.Equal(t, pb.SimpleBank_GetAccount_FullMethodName, spans[0].Name)
+	assert.Equal(t, trace.SpanKindServer, spans[0].SpanKind)
+	assert.Equal(t, parentTraceID, spans[0].SpanContext.TraceID().String())
+	assert.Equal(t, parentSpanID, spans[0].Parent.SpanID().String())
+	assert.Equal(t, codes.Error, spans[0].Status.Code)
+
+	// the handler runs in the span
+	assert.Equal(t, spans[0].SpanContext.SpanID(), handlerSpan.SpanID())
+}
+
+func TestHttpTracer(t *testing.T) {
+	provider, exporter := tracing.NewInMemoryTracerProvider()
+	tracing.Setup(provider)
+
+	// the handler span of the gateway is a child of the http span
+	handler := HttpTracer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		info := &grpc.UnaryServerInfo{FullMethod: pb.SimpleBank_LoginUser_FullMethodName}
+		GrpcTracer(r.Context(), nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return nil, nil
+		})
+		w.WriteHeader(http.StatusCreated)
+	}))
+
+	request := httptest.NewRequest(http.MethodPost, "/v1/login_user", nil)
+	request.Header.Set("traceparent", traceparent)
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, request)
+	assert.Equal(t, http.StatusCreated, recorder.Code)
+
+	spans := exporter.GetSpans()
+	assert.Len(t, spans, 2)
+
+	grpcSpan, httpSpan := spans[0], spans[1]
+	assert.Equal(t, "HTTP POST", httpSpan.Name)
+	assert.Equal(t, parentSpanID, httpSpan.Parent.SpanID().String())
+	assert.Equal(t, pb.SimpleBank_LoginUser_FullMethodName, grpcSpan.Name)
+	assert.Equal(t, httpSpan.SpanContext.SpanID(), grpcSpan.Parent.SpanID())
+	for _, span := range spans {
+		assert.Equal(t, parentTraceID, span.SpanContext.TraceID().String())
+	}
+}
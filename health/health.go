@@ -0,0 +1,155 @@
+package health
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	StatusUp   = "up"
+	StatusDown = "down"
+)
+
+// Check pings a dependency. it must return when ctx is done.
+type Check func(ctx context.Context) error
+
+// PingDB checks the connection pool can reach postgres
+func PingDB(conn *sql.DB) Check {
+	return func(ctx context.Context) error {
+		return conn.PingContext(ctx)
+	}
+}
+
+// PingRedis checks redis used by asynq
+func PingRedis(client redis.UniversalClient) Check {
+	return func(ctx context.Context) error {
+		return client.Ping(ctx).Err()
+	}
+}
+
+type DependencyReport struct {
+	Status  string `json:"status"`
+	Latency string `json:"latency"`
+	Error   string `json:"error,omitempty"`
+}
+
+type Report struct {
+	Status       string                      `json:"status"`
+	Dependencies map[string]DependencyReport `json:"dependencies"`
+}
+
+func (report Report) Ready() bool {
+	return report.Status == StatusUp
+}
+
+type dependency struct {
+	name  string
+	check Check
+}
+
+// Checker runs the checks of the dependencies the service can't serve without
+type Checker struct {
+	timeout      time.Duration
+	dependencies []dependency
+}
+
+func NewChecker(timeout time.Duration) *Checker {
+	return &Checker{
+		timeout: timeout,
+	}
+}
+
+func (checker *Checker) Add(name string, check Check) {
+	checker.dependencies = append(checker.dependencies, dependency{name: name, check: check})
+}
+
+// Check runs every check concurrently. the service is up only when all the dependencies are up.
+func (checker *Checker) Check(ctx context.Context) Report {
+	ctx, cancel := context.WithTimeout(ctx, checker.timeout)
+	defer cancel()
+
+	report := Report{
+		Status:       StatusUp,
+		Dependencies: make(map[string]DependencyReport, len(checker.dependencies)),
+	}
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for _, dep := range checker.dependencies {
+		wg.Add(1)
+		go func(dep dependency) {
+			defer wg.Done()
+
+			startTime := time.Now()
+			err := dep.check(ctx)
+			result := DependencyReport{
+				Status:  StatusUp,
+				Latency: time.Since(startTime).String(),
+			}
+			if err != nil {
+				result.Status = StatusDown
+				result.Error = err.Error()
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			report.Dependencies[dep.name] = result
+			if err != nil {
+				report.Status = StatusDown
+			}
+		}(dep)
+	}
+	wg.Wait()
+
+	return report
+}
+
+// Watch runs the checks every interval and passes the report to update until ctx is done
+func (checker *Checker) Watch(ctx context.Context, interval time.Duration, update func(Report)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		update(checker.Check(ctx))
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// LivenessHandler answers as long as the process can serve http. the dependencies aren't checked
+// so that an outage of postgres or redis doesn't restart every pod.
+func LivenessHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, map[string]string{"status": StatusUp})
+	})
+}
+
+// ReadinessHandler reports every dependency and answers 503 when any of them is down
+func (checker *Checker) ReadinessHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		report := checker.Check(r.Context())
+
+		statusCode := http.StatusOK
+		if !report.Ready() {
+			statusCode = http.StatusServiceUnavailable
+		}
+		writeJSON(w, statusCode, report)
+	})
+}
+
+func writeJSON(w http.ResponseWriter, statusCode int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(body)
+}
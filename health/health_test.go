@@ -0,0 +1,90 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func up(ctx context.Context) error {
+	return nil
+}
+
+func down(ctx context.Context) error {
+	return errors.New("connection refused")
+}
+
+// hang blocks until the timeout of the checker
+func hang(ctx context.Context) error {
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+func TestReadinessHandler(t *testing.T) {
+	testCases := []struct {
+		name           string
+		checks         map[string]Check
+		expectedCode   int
+		expectedStatus map[string]string
+	}{
+		{
+			name:           "Up",
+			checks:         map[string]Check{"postgres": up, "redis": up},
+			expectedCode:   http.StatusOK,
+			expectedStatus: map[string]string{"postgres": StatusUp, "redis": StatusUp},
+		},
+		{
+			name:           "Down",
+			checks:         map[string]Check{"postgres": up, "redis": down},
+			expectedCode:   http.StatusServiceUnavailable,
+			expectedStatus: map[string]string{"postgres": StatusUp, "redis": StatusDown},
+		},
+		{
+			name:           "Timeout",
+			checks:         map[string]Check{"postgres": hang, "redis": up},
+			expectedCode:   http.StatusServiceUnavailable,
+			expectedStatus: map[string]string{"postgres": StatusDown, "redis": StatusUp},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			checker := NewChecker(50 * time.Millisecond)
+			for name, check := range tc.checks {
+				checker.Add(name, check)
+			}
+
+			recorder := httptest.NewRecorder()
+			checker.ReadinessHandler().ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+			assert.Equal(t, tc.expectedCode, recorder.Code)
+			assert.Equal(t, "application/json", recorder.Header().Get("Content-Type"))
+
+			var report Report
+			err := json.Unmarshal(recorder.Body.Bytes(), &report)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedCode == http.StatusOK, report.Ready())
+			assert.Len(t, report.Dependencies, len(tc.expectedStatus))
+			for name, status := range tc.expectedStatus {
+				dep := report.Dependencies[name]
+				assert.Equal(t, status, dep.Status)
+				assert.Equal(t, status == StatusDown, len(dep.Error) > 0)
+
+				_, err := time.ParseDuration(dep.Latency)
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestLivenessHandler(t *testing.T) {
+	recorder := httptest.NewRecorder()
+	LivenessHandler().ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.JSONEq(t, `{"status":"up"}`, recorder.Body.String())
+}
@@ -3,9 +3,14 @@ package main
 import (
 	"context"
 	"database/sql"
+	"errors"
+	"fmt"
 	"net"
 	"net/http"
 	"os"
+	"os/signal"
+	"syscall"
+	"time"
 
 	"github.com/golang-migrate/migrate/v4"
 	_ "github.com/golang-migrate/migrate/v4/database/postgres"
@@ -14,21 +19,51 @@ import (
 	"github.com/hibiken/asynq"
 	_ "github.com/lib/pq" // importing with name _ is special import to tell go not to remove this deps
 	"github.com/rakyll/statik/fs"
+	"github.com/redis/go-redis/v9"
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
 	api "github.com/tgfukuda/be-master/api"
 	db "github.com/tgfukuda/be-master/db/sqlc"
 	_ "github.com/tgfukuda/be-master/docs/statik"
 	"github.com/tgfukuda/be-master/gapi"
+	"github.com/tgfukuda/be-master/health"
 	"github.com/tgfukuda/be-master/mail"
+	"github.com/tgfukuda/be-master/metrics"
 	"github.com/tgfukuda/be-master/pb"
+	"github.com/tgfukuda/be-master/token"
+	"github.com/tgfukuda/be-master/tracing"
 	"github.com/tgfukuda/be-master/util"
 	"github.com/tgfukuda/be-master/worker"
+	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/reflection"
+	grpchealth "google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/protobuf/encoding/protojson"
 )
 
+const (
+	jwksPath   = "/.well-known/jwks.json"
+	jwksMaxAge = 5 * time.Minute
+)
+
+// time to wait for the in-flight requests on shutdown
+const shutdownTimeout = 10 * time.Second
+
+const (
+	healthzPath        = "/healthz"
+	readyzPath         = "/readyz"
+	healthCheckTimeout = 2 * time.Second
+	grpcHealthInterval = 10 * time.Second
+)
+
+const metricsPath = "/metrics"
+
+var interruptSignals = []os.Signal{
+	os.Interrupt,
+	syscall.SIGTERM,
+	syscall.SIGINT,
+}
+
 func main() {
 	config, err := util.LoadConfig(".") // read
 	if err != nil {
@@ -39,6 +74,16 @@ func main() {
 		log.Logger = log.Output(zerolog.ConsoleWriter{Out: os.Stderr}) // pretty format
 	}
 
+	// cancelled on SIGINT/SIGTERM to start the graceful shutdown
+	ctx, stop := signal.NotifyContext(context.Background(), interruptSignals...)
+	defer stop()
+
+	tracerProvider, err := tracing.NewOTLPTracerProvider(ctx, config.OTLPEndpoint)
+	if err != nil {
+		log.Fatal().Err(err).Msg("can't create tracer provider")
+	}
+	tracing.Setup(tracerProvider)
+
 	conn, err := sql.Open(config.DBDriver, config.DBSource)
 	if err != nil {
 		log.Fatal().Err(err).Msg("can't connect to db")
@@ -55,11 +100,46 @@ func main() {
 
 	taskDistributor := worker.NewRedisTaskDistributor(redisOpt)
 
-	go runTaskProcessor(config, redisOpt, store)
+	// the same options as asynq so the readiness reflects the connection the worker uses
+	redisClient := redisOpt.MakeRedisClient().(redis.UniversalClient)
+
+	healthChecker := health.NewChecker(healthCheckTimeout)
+	healthChecker.Add("postgres", health.PingDB(conn))
+	healthChecker.Add("redis", health.PingRedis(redisClient))
+
+	// the first component failed cancels ctx and the others shut down with it
+	waitGroup, ctx := errgroup.WithContext(ctx)
+
+	runTaskProcessor(ctx, waitGroup, config, redisOpt, store)
+	runTaskScheduler(ctx, waitGroup, config, redisOpt)
+	runOutboxRelay(ctx, waitGroup, store, taskDistributor)
+	runGatewayServer(ctx, waitGroup, config, store, healthChecker)
+	runGRPCServer(ctx, waitGroup, config, store, healthChecker)
 
-	go runGatewayServer(config, store, taskDistributor)
+	err = waitGroup.Wait()
+
+	// every component has stopped using the connections
+	if closeErr := taskDistributor.Close(); closeErr != nil {
+		log.Error().Err(closeErr).Msg("failed to close task distributor")
+	}
+	if closeErr := redisClient.Close(); closeErr != nil {
+		log.Error().Err(closeErr).Msg("failed to close redis")
+	}
+	if closeErr := conn.Close(); closeErr != nil {
+		log.Error().Err(closeErr).Msg("failed to close db")
+	}
 
-	runGRPCServer(config, store, taskDistributor)
+	// flush the spans of the last requests
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	if shutdownErr := tracerProvider.Shutdown(shutdownCtx); shutdownErr != nil {
+		log.Error().Err(shutdownErr).Msg("failed to shut down tracer provider")
+	}
+
+	if err != nil {
+		log.Fatal().Err(err).Msg("error from wait group")
+	}
+	log.Info().Msg("shut down gracefully")
 }
 
 func runDBMigration(migrationURL, dbSource string) {
@@ -77,88 +157,250 @@ func runDBMigration(migrationURL, dbSource string) {
 	}
 }
 
-func runGRPCServer(config util.Config, store db.Store, taskDistributor worker.TaskDistributor) {
-	server, err := gapi.NewServer(config, store, taskDistributor)
-	if err != nil {
-		log.Fatal().Err(err).Msg("cannnot create server:")
-	}
+func runGRPCServer(
+	ctx context.Context,
+	waitGroup *errgroup.Group,
+	config util.Config,
+	store db.Store,
+	healthChecker *health.Checker,
+) {
+	waitGroup.Go(func() error {
+		server, err := gapi.NewServer(config, store)
+		if err != nil {
+			return fmt.Errorf("cannot create grpc server: %w", err)
+		}
 
-	grpcLogger := grpc.UnaryInterceptor(gapi.GrpcLogger)
+		// the logger and the metrics come before the auth interceptor to record the rejected calls.
+		// the tracer comes first so the logs have the trace id.
+		unaryInterceptors := grpc.ChainUnaryInterceptor(server.UnaryInterceptors()...)
+		streamInterceptors := grpc.ChainStreamInterceptor(server.StreamAuthInterceptor)
 
-	grpcSever := grpc.NewServer(grpcLogger)
-	pb.RegisterSimpleBankServer(grpcSever, server)
-	reflection.Register(grpcSever) // add usage to server
+		grpcServer := grpc.NewServer(unaryInterceptors, streamInterceptors)
+		healthServer := grpchealth.NewServer()
+		gapi.RegisterServices(grpcServer, server, healthServer)
 
-	listener, err := net.Listen("tcp", config.GRPCServerAddress)
-	if err != nil {
-		log.Fatal().Err(err).Msg("cannot create listener")
-	}
+		// keep the serving status of the whole server ("") up to date with the dependencies
+		waitGroup.Go(func() error {
+			healthChecker.Watch(ctx, grpcHealthInterval, func(report health.Report) {
+				servingStatus := healthpb.HealthCheckResponse_SERVING
+				if !report.Ready() {
+					servingStatus = healthpb.HealthCheckResponse_NOT_SERVING
+				}
+				healthServer.SetServingStatus("", servingStatus)
+			})
+			return nil
+		})
 
-	log.Info().Msgf("start grpc server at %s", listener.Addr().String())
-	err = grpcSever.Serve(listener)
-	if err != nil {
-		log.Fatal().Err(err).Msg("cannot start grpc server")
-	}
+		listener, err := net.Listen("tcp", config.GRPCServerAddress)
+		if err != nil {
+			return fmt.Errorf("cannot create grpc listener: %w", err)
+		}
+
+		// shut down when the other component fails as well as on the signal
+		waitGroup.Go(func() error {
+			<-ctx.Done()
+			log.Info().Msg("graceful shutdown grpc server")
+
+			// tell the clients watching the health not to send new calls
+			healthServer.Shutdown()
+
+			stopped := make(chan struct{})
+			go func() {
+				grpcServer.GracefulStop() // waits for the in-flight calls
+				close(stopped)
+			}()
+
+			select {
+			case <-stopped:
+			case <-time.After(shutdownTimeout):
+				log.Error().Msg("grpc server didn't stop in time. closing the connections")
+				grpcServer.Stop()
+			}
+
+			log.Info().Msg("grpc server is stopped")
+			return nil
+		})
+
+		log.Info().Msgf("start grpc server at %s", listener.Addr().String())
+		if err := grpcServer.Serve(listener); err != nil {
+			return fmt.Errorf("grpc server failed to serve: %w", err)
+		}
+
+		return nil
+	})
 }
 
-func runGatewayServer(config util.Config, store db.Store, taskDistributor worker.TaskDistributor) {
-	server, err := gapi.NewServer(config, store, taskDistributor)
-	if err != nil {
-		log.Fatal().Err(err).Msg("cannnot create server")
-	}
+func runGatewayServer(
+	ctx context.Context,
+	waitGroup *errgroup.Group,
+	config util.Config,
+	store db.Store,
+	healthChecker *health.Checker,
+) {
+	waitGroup.Go(func() error {
+		server, err := gapi.NewServer(config, store)
+		if err != nil {
+			return fmt.Errorf("cannot create gateway server: %w", err)
+		}
 
-	grpcMux := runtime.NewServeMux(
-		runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{
+		jsonMarshaler := &runtime.JSONPb{
 			MarshalOptions: protojson.MarshalOptions{
 				UseProtoNames: true,
 			},
 			UnmarshalOptions: protojson.UnmarshalOptions{
 				DiscardUnknown: true,
 			},
-		}),
-	)
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
+		}
 
-	err = pb.RegisterSimpleBankHandlerServer(ctx, grpcMux, server)
-	if err != nil {
-		log.Fatal().Err(err).Msg("cannot register handler server")
-	}
+		grpcMux := runtime.NewServeMux(
+			runtime.WithIncomingHeaderMatcher(gapi.GatewayHeaderMatcher),
+			runtime.WithMarshalerOption(runtime.MIMEWildcard, jsonMarshaler),
+			// the statements are downloaded by the Accept header
+			runtime.WithMarshalerOption(gapi.MIMEStatementCSV, gapi.NewStatementCSVMarshaler(jsonMarshaler)),
+			runtime.WithMarshalerOption(gapi.MIMEStatementJSONLines, gapi.NewStatementJSONLinesMarshaler(jsonMarshaler)),
+			runtime.WithForwardResponseOption(gapi.StatementAttachment),
+		)
 
-	mux := http.NewServeMux()
-	mux.Handle("/", grpcMux)
+		err = pb.RegisterSimpleBankHandlerServer(ctx, grpcMux, gapi.NewGatewayServer(server))
+		if err != nil {
+			return fmt.Errorf("cannot register handler server: %w", err)
+		}
 
-	// host swagger
-	statikFS, err := fs.New()
-	if err != nil {
-		log.Fatal().Err(err).Msg("cannot create statik fs")
-	}
-	// fs := http.FileServer(http.Dir("./docs/swagger")) // for directly uses js.
-	swaggerHandler := http.StripPrefix("/swagger/", http.FileServer(statikFS))
-	mux.Handle("/swagger/", swaggerHandler)
+		mux := http.NewServeMux()
+		mux.Handle("/", grpcMux)
+		mux.Handle(healthzPath, health.LivenessHandler())
+		mux.Handle(readyzPath, healthChecker.ReadinessHandler())
+		mux.Handle(metricsPath, metrics.Handler())
 
-	listener, err := net.Listen("tcp", config.HTTPServerAddress)
-	if err != nil {
-		log.Fatal().Err(err).Msg("cannot create listener")
-	}
+		// publish the public keys so other services can verify our tokens
+		if token.IsAsymmetricMaker(config.TokenMaker) {
+			keyRing, err := token.NewKeyRingFromConfig(config)
+			if err != nil {
+				return fmt.Errorf("cannot create token keyring: %w", err)
+			}
 
-	log.Info().Msgf("start http gateway server at %s", listener.Addr().String())
-	handler := gapi.HttpLogger(mux)
-	err = http.Serve(listener, handler)
-	if err != nil {
-		log.Fatal().Err(err).Msg("cannot start http gateway server")
-	}
+			jwksHandler, err := token.NewJWKSHandler(keyRing, jwksMaxAge)
+			if err != nil {
+				return fmt.Errorf("cannot create jwks handler: %w", err)
+			}
+			mux.Handle(jwksPath, jwksHandler)
+		}
+
+		// host swagger
+		statikFS, err := fs.New()
+		if err != nil {
+			return fmt.Errorf("cannot create statik fs: %w", err)
+		}
+		// fs := http.FileServer(http.Dir("./docs/swagger")) // for directly uses js.
+		swaggerHandler := http.StripPrefix("/swagger/", http.FileServer(statikFS))
+		mux.Handle("/swagger/", swaggerHandler)
+
+		listener, err := net.Listen("tcp", config.HTTPServerAddress)
+		if err != nil {
+			return fmt.Errorf("cannot create gateway listener: %w", err)
+		}
+
+		httpServer := &http.Server{
+			Handler: gapi.HttpTracer(gapi.HttpLogger(gapi.HttpMetrics(mux))),
+		}
+
+		waitGroup.Go(func() error {
+			<-ctx.Done()
+			log.Info().Msg("graceful shutdown http gateway server")
+
+			// ctx is already cancelled, so the deadline is made from the background
+			shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+			defer cancel()
+
+			if err := httpServer.Shutdown(shutdownCtx); err != nil {
+				return fmt.Errorf("failed to shut down http gateway server: %w", err)
+			}
+
+			log.Info().Msg("http gateway server is stopped")
+			return nil
+		})
+
+		log.Info().Msgf("start http gateway server at %s", listener.Addr().String())
+		if err := httpServer.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			return fmt.Errorf("http gateway server failed to serve: %w", err)
+		}
+
+		return nil
+	})
 }
 
-func runTaskProcessor(config util.Config, redisOpt asynq.RedisClientOpt, store db.Store) {
+func runTaskProcessor(
+	ctx context.Context,
+	waitGroup *errgroup.Group,
+	config util.Config,
+	redisOpt asynq.RedisClientOpt,
+	store db.Store,
+) {
 	mailer := mail.NewGmailSender(config.EmailSenderName, config.EmailSenderAddress, config.EmailSenderPassword)
 	taskProcessor := worker.NewRedisTaskProcessor(redisOpt, store, mailer)
-	err := taskProcessor.Start()
-	if err != nil {
-		log.Fatal().Err(err).Msg("cannot start task processor")
-	}
 
-	log.Info().Msg("start task processor")
+	waitGroup.Go(func() error {
+		err := taskProcessor.Start()
+		if err != nil {
+			return fmt.Errorf("cannot start task processor: %w", err)
+		}
+		log.Info().Msg("start task processor")
+
+		<-ctx.Done()
+		log.Info().Msg("graceful shutdown task processor")
+
+		taskProcessor.Shutdown()
+
+		log.Info().Msg("task processor is stopped")
+		return nil
+	})
+}
+
+// runTaskScheduler enqueues the periodic tasks
+func runTaskScheduler(
+	ctx context.Context,
+	waitGroup *errgroup.Group,
+	config util.Config,
+	redisOpt asynq.RedisClientOpt,
+) {
+	waitGroup.Go(func() error {
+		taskScheduler, err := worker.NewRedisTaskScheduler(redisOpt, config.ReconcileLedgerSchedule, config.ScheduledTransfersSchedule)
+		if err != nil {
+			return fmt.Errorf("cannot create task scheduler: %w", err)
+		}
+
+		err = taskScheduler.Start()
+		if err != nil {
+			return fmt.Errorf("cannot start task scheduler: %w", err)
+		}
+		log.Info().Msg("start task scheduler")
+
+		<-ctx.Done()
+		log.Info().Msg("graceful shutdown task scheduler")
+
+		taskScheduler.Shutdown()
+
+		log.Info().Msg("task scheduler is stopped")
+		return nil
+	})
+}
+
+// runOutboxRelay enqueues the tasks committed to the outbox
+func runOutboxRelay(
+	ctx context.Context,
+	waitGroup *errgroup.Group,
+	store db.Store,
+	taskDistributor worker.TaskDistributor,
+) {
+	relay := worker.NewOutboxRelay(store, taskDistributor)
+
+	waitGroup.Go(func() error {
+		log.Info().Msg("start outbox relay")
+		relay.Run(ctx)
+
+		log.Info().Msg("outbox relay is stopped")
+		return nil
+	})
 }
 
 func runGinServer(config util.Config, store db.Store) {
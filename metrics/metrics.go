@@ -0,0 +1,123 @@
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "simple_bank"
+
+// outcomes of a db transaction
+const (
+	TxCommit   = "commit"
+	TxRollback = "rollback"
+	TxFailed   = "failed" // couldn't begin or commit
+	TxRetry    = "retry"  // aborted by a conflict with the concurrent ones and run again
+)
+
+// outcomes of a worker task
+const (
+	TaskSucceeded = "succeeded"
+	TaskFailed    = "failed"
+)
+
+// registry has only the metrics of this service and the runtime.
+// the default registry isn't used so that the imported libraries can't add their metrics silently.
+var registry = prometheus.NewRegistry()
+
+var (
+	grpcRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "grpc_requests_total",
+		Help:      "Number of gRPC calls by method and status code.",
+	}, []string{"method", "code"})
+	grpcRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "grpc_request_duration_seconds",
+		Help:      "Latency of gRPC calls by method and status code.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "code"})
+
+	httpRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "http_requests_total",
+		Help:      "Number of HTTP requests to the gateway by method, route and status code.",
+	}, []string{"method", "route", "code"})
+	httpRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "http_request_duration_seconds",
+		Help:      "Latency of HTTP requests to the gateway by method, route and status code.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "route", "code"})
+
+	dbTransactions = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "db_transactions_total",
+		Help:      "Number of db transactions by name and outcome.",
+	}, []string{"name", "outcome"})
+	dbTransactionDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "db_transaction_duration_seconds",
+		Help:      "Duration of db transactions by name and outcome.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"name", "outcome"})
+
+	workerTasks = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "worker_tasks_total",
+		Help:      "Number of processed tasks by type and outcome.",
+	}, []string{"type", "outcome"})
+	workerTaskDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "worker_task_duration_seconds",
+		Help:      "Duration of processed tasks by type and outcome.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"type", "outcome"})
+)
+
+func init() {
+	registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		grpcRequests,
+		grpcRequestDuration,
+		httpRequests,
+		httpRequestDuration,
+		dbTransactions,
+		dbTransactionDuration,
+		workerTasks,
+		workerTaskDuration,
+	)
+}
+
+// Handler serves the metrics in the prometheus text format
+func Handler() http.Handler {
+	return promhttp.HandlerFor(registry, promhttp.HandlerOpts{})
+}
+
+func ObserveGRPC(method string, code string, duration time.Duration) {
+	grpcRequests.WithLabelValues(method, code).Inc()
+	grpcRequestDuration.WithLabelValues(method, code).Observe(duration.Seconds())
+}
+
+// ObserveHTTP takes the route pattern, not the path, so the ids in the paths don't make a series each
+func ObserveHTTP(method string, route string, statusCode int, duration time.Duration) {
+	code := strconv.Itoa(statusCode)
+	httpRequests.WithLabelValues(method, route, code).Inc()
+	httpRequestDuration.WithLabelValues(method, route, code).Observe(duration.Seconds())
+}
+
+func ObserveTx(name string, outcome string, duration time.Duration) {
+	dbTransactions.WithLabelValues(name, outcome).Inc()
+	dbTransactionDuration.WithLabelValues(name, outcome).Observe(duration.Seconds())
+}
+
+func ObserveTask(taskType string, outcome string, duration time.Duration) {
+	workerTasks.WithLabelValues(taskType, outcome).Inc()
+	workerTaskDuration.WithLabelValues(taskType, outcome).Observe(duration.Seconds())
+}
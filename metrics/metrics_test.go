@@ -0,0 +1,60 @@
+package metrics
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tgfukuda/be-master/util"
+)
+
+// scrape gets the metrics through the handler as prometheus does
+func scrape(t *testing.T) string {
+	server := httptest.NewServer(Handler())
+	defer server.Close()
+
+	res, err := http.Get(server.URL)
+	assert.NoError(t, err)
+	defer res.Body.Close()
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+
+	body, err := io.ReadAll(res.Body)
+	assert.NoError(t, err)
+
+	return string(body)
+}
+
+func TestObserveTx(t *testing.T) {
+	name := util.RandomString(10) // not to be mixed with the other tests
+
+	ObserveTx(name, TxCommit, 10*time.Millisecond)
+	ObserveTx(name, TxCommit, 20*time.Millisecond)
+	ObserveTx(name, TxRollback, time.Millisecond)
+
+	body := scrape(t)
+	assert.Contains(t, body, fmt.Sprintf(`simple_bank_db_transactions_total{name="%s",outcome="commit"} 2`, name))
+	assert.Contains(t, body, fmt.Sprintf(`simple_bank_db_transactions_total{name="%s",outcome="rollback"} 1`, name))
+	assert.Contains(t, body, fmt.Sprintf(`simple_bank_db_transaction_duration_seconds_count{name="%s",outcome="commit"} 2`, name))
+}
+
+func TestObserveTask(t *testing.T) {
+	taskType := util.RandomString(10)
+
+	ObserveTask(taskType, TaskSucceeded, time.Millisecond)
+	ObserveTask(taskType, TaskFailed, time.Millisecond)
+	ObserveTask(taskType, TaskFailed, time.Millisecond)
+
+	body := scrape(t)
+	assert.Contains(t, body, fmt.Sprintf(`simple_bank_worker_tasks_total{outcome="succeeded",type="%s"} 1`, taskType))
+	assert.Contains(t, body, fmt.Sprintf(`simple_bank_worker_tasks_total{outcome="failed",type="%s"} 2`, taskType))
+	assert.Contains(t, body, fmt.Sprintf(`simple_bank_worker_task_duration_seconds_count{outcome="failed",type="%s"} 2`, taskType))
+}
+
+func TestRuntimeMetrics(t *testing.T) {
+	body := scrape(t)
+	assert.Contains(t, body, "go_goroutines")
+}
@@ -0,0 +1,91 @@
+// Code generated by mockery v2.36.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+	fx "github.com/tgfukuda/be-master/fx"
+)
+
+// ExchangeRateProvider is an autogenerated mock type for the ExchangeRateProvider type
+type ExchangeRateProvider struct {
+	mock.Mock
+}
+
+type ExchangeRateProvider_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ExchangeRateProvider) EXPECT() *ExchangeRateProvider_Expecter {
+	return &ExchangeRateProvider_Expecter{mock: &_m.Mock}
+}
+
+// GetRate provides a mock function with given fields: ctx, from, to
+func (_m *ExchangeRateProvider) GetRate(ctx context.Context, from string, to string) (fx.Rate, error) {
+	ret := _m.Called(ctx, from, to)
+
+	var r0 fx.Rate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (fx.Rate, error)); ok {
+		return rf(ctx, from, to)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) fx.Rate); ok {
+		r0 = rf(ctx, from, to)
+	} else {
+		r0 = ret.Get(0).(fx.Rate)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ExchangeRateProvider_GetRate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRate'
+type ExchangeRateProvider_GetRate_Call struct {
+	*mock.Call
+}
+
+// GetRate is a helper method to define mock.On call
+//  - ctx context.Context
+//  - from string
+//  - to string
+func (_e *ExchangeRateProvider_Expecter) GetRate(ctx interface{}, from interface{}, to interface{}) *ExchangeRateProvider_GetRate_Call {
+	return &ExchangeRateProvider_GetRate_Call{Call: _e.mock.On("GetRate", ctx, from, to)}
+}
+
+func (_c *ExchangeRateProvider_GetRate_Call) Run(run func(ctx context.Context, from string, to string)) *ExchangeRateProvider_GetRate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *ExchangeRateProvider_GetRate_Call) Return(_a0 fx.Rate, _a1 error) *ExchangeRateProvider_GetRate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ExchangeRateProvider_GetRate_Call) RunAndReturn(run func(context.Context, string, string) (fx.Rate, error)) *ExchangeRateProvider_GetRate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewExchangeRateProvider creates a new instance of ExchangeRateProvider. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewExchangeRateProvider(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ExchangeRateProvider {
+	mock := &ExchangeRateProvider{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -23,32 +23,32 @@ func (_m *Maker) EXPECT() *Maker_Expecter {
 	return &Maker_Expecter{mock: &_m.Mock}
 }
 
-// CreateToken provides a mock function with given fields: username, duration
-func (_m *Maker) CreateToken(username string, duration time.Duration) (string, *token.Payload, error) {
-	ret := _m.Called(username, duration)
+// CreateToken provides a mock function with given fields: username, role, duration
+func (_m *Maker) CreateToken(username string, role string, duration time.Duration) (string, *token.Payload, error) {
+	ret := _m.Called(username, role, duration)
 
 	var r0 string
 	var r1 *token.Payload
 	var r2 error
-	if rf, ok := ret.Get(0).(func(string, time.Duration) (string, *token.Payload, error)); ok {
-		return rf(username, duration)
+	if rf, ok := ret.Get(0).(func(string, string, time.Duration) (string, *token.Payload, error)); ok {
+		return rf(username, role, duration)
 	}
-	if rf, ok := ret.Get(0).(func(string, time.Duration) string); ok {
-		r0 = rf(username, duration)
+	if rf, ok := ret.Get(0).(func(string, string, time.Duration) string); ok {
+		r0 = rf(username, role, duration)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
 
-	if rf, ok := ret.Get(1).(func(string, time.Duration) *token.Payload); ok {
-		r1 = rf(username, duration)
+	if rf, ok := ret.Get(1).(func(string, string, time.Duration) *token.Payload); ok {
+		r1 = rf(username, role, duration)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(*token.Payload)
 		}
 	}
 
-	if rf, ok := ret.Get(2).(func(string, time.Duration) error); ok {
-		r2 = rf(username, duration)
+	if rf, ok := ret.Get(2).(func(string, string, time.Duration) error); ok {
+		r2 = rf(username, role, duration)
 	} else {
 		r2 = ret.Error(2)
 	}
@@ -63,14 +63,15 @@ type Maker_CreateToken_Call struct {
 
 // CreateToken is a helper method to define mock.On call
 //  - username string
+//  - role string
 //  - duration time.Duration
-func (_e *Maker_Expecter) CreateToken(username interface{}, duration interface{}) *Maker_CreateToken_Call {
-	return &Maker_CreateToken_Call{Call: _e.mock.On("CreateToken", username, duration)}
+func (_e *Maker_Expecter) CreateToken(username interface{}, role interface{}, duration interface{}) *Maker_CreateToken_Call {
+	return &Maker_CreateToken_Call{Call: _e.mock.On("CreateToken", username, role, duration)}
 }
 
-func (_c *Maker_CreateToken_Call) Run(run func(username string, duration time.Duration)) *Maker_CreateToken_Call {
+func (_c *Maker_CreateToken_Call) Run(run func(username string, role string, duration time.Duration)) *Maker_CreateToken_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string), args[1].(time.Duration))
+		run(args[0].(string), args[1].(string), args[2].(time.Duration))
 	})
 	return _c
 }
@@ -80,7 +81,7 @@ func (_c *Maker_CreateToken_Call) Return(_a0 string, _a1 *token.Payload, _a2 err
 	return _c
 }
 
-func (_c *Maker_CreateToken_Call) RunAndReturn(run func(string, time.Duration) (string, *token.Payload, error)) *Maker_CreateToken_Call {
+func (_c *Maker_CreateToken_Call) RunAndReturn(run func(string, string, time.Duration) (string, *token.Payload, error)) *Maker_CreateToken_Call {
 	_c.Call.Return(run)
 	return _c
 }
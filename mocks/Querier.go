@@ -8,6 +8,8 @@ import (
 	mock "github.com/stretchr/testify/mock"
 	db "github.com/tgfukuda/be-master/db/sqlc"
 
+	sql "database/sql"
+
 	uuid "github.com/google/uuid"
 )
 
@@ -77,23 +79,23 @@ func (_c *Querier_AddAccountBalance_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
-// CreateAccount provides a mock function with given fields: ctx, arg
-func (_m *Querier) CreateAccount(ctx context.Context, arg db.CreateAccountParams) (db.Account, error) {
-	ret := _m.Called(ctx, arg)
+// BlockSessionFamily provides a mock function with given fields: ctx, familyID
+func (_m *Querier) BlockSessionFamily(ctx context.Context, familyID uuid.UUID) (int64, error) {
+	ret := _m.Called(ctx, familyID)
 
-	var r0 db.Account
+	var r0 int64
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, db.CreateAccountParams) (db.Account, error)); ok {
-		return rf(ctx, arg)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (int64, error)); ok {
+		return rf(ctx, familyID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, db.CreateAccountParams) db.Account); ok {
-		r0 = rf(ctx, arg)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) int64); ok {
+		r0 = rf(ctx, familyID)
 	} else {
-		r0 = ret.Get(0).(db.Account)
+		r0 = ret.Get(0).(int64)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, db.CreateAccountParams) error); ok {
-		r1 = rf(ctx, arg)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, familyID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -101,52 +103,52 @@ func (_m *Querier) CreateAccount(ctx context.Context, arg db.CreateAccountParams
 	return r0, r1
 }
 
-// Querier_CreateAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateAccount'
-type Querier_CreateAccount_Call struct {
+// Querier_BlockSessionFamily_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BlockSessionFamily'
+type Querier_BlockSessionFamily_Call struct {
 	*mock.Call
 }
 
-// CreateAccount is a helper method to define mock.On call
+// BlockSessionFamily is a helper method to define mock.On call
 //  - ctx context.Context
-//  - arg db.CreateAccountParams
-func (_e *Querier_Expecter) CreateAccount(ctx interface{}, arg interface{}) *Querier_CreateAccount_Call {
-	return &Querier_CreateAccount_Call{Call: _e.mock.On("CreateAccount", ctx, arg)}
+//  - familyID uuid.UUID
+func (_e *Querier_Expecter) BlockSessionFamily(ctx interface{}, familyID interface{}) *Querier_BlockSessionFamily_Call {
+	return &Querier_BlockSessionFamily_Call{Call: _e.mock.On("BlockSessionFamily", ctx, familyID)}
 }
 
-func (_c *Querier_CreateAccount_Call) Run(run func(ctx context.Context, arg db.CreateAccountParams)) *Querier_CreateAccount_Call {
+func (_c *Querier_BlockSessionFamily_Call) Run(run func(ctx context.Context, familyID uuid.UUID)) *Querier_BlockSessionFamily_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(db.CreateAccountParams))
+		run(args[0].(context.Context), args[1].(uuid.UUID))
 	})
 	return _c
 }
 
-func (_c *Querier_CreateAccount_Call) Return(_a0 db.Account, _a1 error) *Querier_CreateAccount_Call {
+func (_c *Querier_BlockSessionFamily_Call) Return(_a0 int64, _a1 error) *Querier_BlockSessionFamily_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *Querier_CreateAccount_Call) RunAndReturn(run func(context.Context, db.CreateAccountParams) (db.Account, error)) *Querier_CreateAccount_Call {
+func (_c *Querier_BlockSessionFamily_Call) RunAndReturn(run func(context.Context, uuid.UUID) (int64, error)) *Querier_BlockSessionFamily_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateEntry provides a mock function with given fields: ctx, arg
-func (_m *Querier) CreateEntry(ctx context.Context, arg db.CreateEntryParams) (db.Entry, error) {
-	ret := _m.Called(ctx, arg)
+// BlockUserSessions provides a mock function with given fields: ctx, username
+func (_m *Querier) BlockUserSessions(ctx context.Context, username string) (int64, error) {
+	ret := _m.Called(ctx, username)
 
-	var r0 db.Entry
+	var r0 int64
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, db.CreateEntryParams) (db.Entry, error)); ok {
-		return rf(ctx, arg)
+	if rf, ok := ret.Get(0).(func(context.Context, string) (int64, error)); ok {
+		return rf(ctx, username)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, db.CreateEntryParams) db.Entry); ok {
-		r0 = rf(ctx, arg)
+	if rf, ok := ret.Get(0).(func(context.Context, string) int64); ok {
+		r0 = rf(ctx, username)
 	} else {
-		r0 = ret.Get(0).(db.Entry)
+		r0 = ret.Get(0).(int64)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, db.CreateEntryParams) error); ok {
-		r1 = rf(ctx, arg)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, username)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -154,51 +156,53 @@ func (_m *Querier) CreateEntry(ctx context.Context, arg db.CreateEntryParams) (d
 	return r0, r1
 }
 
-// Querier_CreateEntry_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateEntry'
-type Querier_CreateEntry_Call struct {
+// Querier_BlockUserSessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BlockUserSessions'
+type Querier_BlockUserSessions_Call struct {
 	*mock.Call
 }
 
-// CreateEntry is a helper method to define mock.On call
+// BlockUserSessions is a helper method to define mock.On call
 //  - ctx context.Context
-//  - arg db.CreateEntryParams
-func (_e *Querier_Expecter) CreateEntry(ctx interface{}, arg interface{}) *Querier_CreateEntry_Call {
-	return &Querier_CreateEntry_Call{Call: _e.mock.On("CreateEntry", ctx, arg)}
+//  - username string
+func (_e *Querier_Expecter) BlockUserSessions(ctx interface{}, username interface{}) *Querier_BlockUserSessions_Call {
+	return &Querier_BlockUserSessions_Call{Call: _e.mock.On("BlockUserSessions", ctx, username)}
 }
 
-func (_c *Querier_CreateEntry_Call) Run(run func(ctx context.Context, arg db.CreateEntryParams)) *Querier_CreateEntry_Call {
+func (_c *Querier_BlockUserSessions_Call) Run(run func(ctx context.Context, username string)) *Querier_BlockUserSessions_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(db.CreateEntryParams))
+		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *Querier_CreateEntry_Call) Return(_a0 db.Entry, _a1 error) *Querier_CreateEntry_Call {
+func (_c *Querier_BlockUserSessions_Call) Return(_a0 int64, _a1 error) *Querier_BlockUserSessions_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *Querier_CreateEntry_Call) RunAndReturn(run func(context.Context, db.CreateEntryParams) (db.Entry, error)) *Querier_CreateEntry_Call {
+func (_c *Querier_BlockUserSessions_Call) RunAndReturn(run func(context.Context, string) (int64, error)) *Querier_BlockUserSessions_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateNewSession provides a mock function with given fields: ctx, arg
-func (_m *Querier) CreateNewSession(ctx context.Context, arg db.CreateNewSessionParams) (db.Session, error) {
+// ClaimPendingOutbox provides a mock function with given fields: ctx, arg
+func (_m *Querier) ClaimPendingOutbox(ctx context.Context, arg db.ClaimPendingOutboxParams) ([]db.Outbox, error) {
 	ret := _m.Called(ctx, arg)
 
-	var r0 db.Session
+	var r0 []db.Outbox
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, db.CreateNewSessionParams) (db.Session, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.ClaimPendingOutboxParams) ([]db.Outbox, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, db.CreateNewSessionParams) db.Session); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.ClaimPendingOutboxParams) []db.Outbox); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(db.Session)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.Outbox)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, db.CreateNewSessionParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, db.ClaimPendingOutboxParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -207,51 +211,51 @@ func (_m *Querier) CreateNewSession(ctx context.Context, arg db.CreateNewSession
 	return r0, r1
 }
 
-// Querier_CreateNewSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateNewSession'
-type Querier_CreateNewSession_Call struct {
+// Querier_ClaimPendingOutbox_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimPendingOutbox'
+type Querier_ClaimPendingOutbox_Call struct {
 	*mock.Call
 }
 
-// CreateNewSession is a helper method to define mock.On call
+// ClaimPendingOutbox is a helper method to define mock.On call
 //  - ctx context.Context
-//  - arg db.CreateNewSessionParams
-func (_e *Querier_Expecter) CreateNewSession(ctx interface{}, arg interface{}) *Querier_CreateNewSession_Call {
-	return &Querier_CreateNewSession_Call{Call: _e.mock.On("CreateNewSession", ctx, arg)}
+//  - arg db.ClaimPendingOutboxParams
+func (_e *Querier_Expecter) ClaimPendingOutbox(ctx interface{}, arg interface{}) *Querier_ClaimPendingOutbox_Call {
+	return &Querier_ClaimPendingOutbox_Call{Call: _e.mock.On("ClaimPendingOutbox", ctx, arg)}
 }
 
-func (_c *Querier_CreateNewSession_Call) Run(run func(ctx context.Context, arg db.CreateNewSessionParams)) *Querier_CreateNewSession_Call {
+func (_c *Querier_ClaimPendingOutbox_Call) Run(run func(ctx context.Context, arg db.ClaimPendingOutboxParams)) *Querier_ClaimPendingOutbox_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(db.CreateNewSessionParams))
+		run(args[0].(context.Context), args[1].(db.ClaimPendingOutboxParams))
 	})
 	return _c
 }
 
-func (_c *Querier_CreateNewSession_Call) Return(_a0 db.Session, _a1 error) *Querier_CreateNewSession_Call {
+func (_c *Querier_ClaimPendingOutbox_Call) Return(_a0 []db.Outbox, _a1 error) *Querier_ClaimPendingOutbox_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *Querier_CreateNewSession_Call) RunAndReturn(run func(context.Context, db.CreateNewSessionParams) (db.Session, error)) *Querier_CreateNewSession_Call {
+func (_c *Querier_ClaimPendingOutbox_Call) RunAndReturn(run func(context.Context, db.ClaimPendingOutboxParams) ([]db.Outbox, error)) *Querier_ClaimPendingOutbox_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateTransfer provides a mock function with given fields: ctx, arg
-func (_m *Querier) CreateTransfer(ctx context.Context, arg db.CreateTransferParams) (db.Transfer, error) {
+// CreateAccount provides a mock function with given fields: ctx, arg
+func (_m *Querier) CreateAccount(ctx context.Context, arg db.CreateAccountParams) (db.Account, error) {
 	ret := _m.Called(ctx, arg)
 
-	var r0 db.Transfer
+	var r0 db.Account
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, db.CreateTransferParams) (db.Transfer, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateAccountParams) (db.Account, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, db.CreateTransferParams) db.Transfer); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateAccountParams) db.Account); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(db.Transfer)
+		r0 = ret.Get(0).(db.Account)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, db.CreateTransferParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, db.CreateAccountParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -260,51 +264,51 @@ func (_m *Querier) CreateTransfer(ctx context.Context, arg db.CreateTransferPara
 	return r0, r1
 }
 
-// Querier_CreateTransfer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateTransfer'
-type Querier_CreateTransfer_Call struct {
+// Querier_CreateAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateAccount'
+type Querier_CreateAccount_Call struct {
 	*mock.Call
 }
 
-// CreateTransfer is a helper method to define mock.On call
+// CreateAccount is a helper method to define mock.On call
 //  - ctx context.Context
-//  - arg db.CreateTransferParams
-func (_e *Querier_Expecter) CreateTransfer(ctx interface{}, arg interface{}) *Querier_CreateTransfer_Call {
-	return &Querier_CreateTransfer_Call{Call: _e.mock.On("CreateTransfer", ctx, arg)}
+//  - arg db.CreateAccountParams
+func (_e *Querier_Expecter) CreateAccount(ctx interface{}, arg interface{}) *Querier_CreateAccount_Call {
+	return &Querier_CreateAccount_Call{Call: _e.mock.On("CreateAccount", ctx, arg)}
 }
 
-func (_c *Querier_CreateTransfer_Call) Run(run func(ctx context.Context, arg db.CreateTransferParams)) *Querier_CreateTransfer_Call {
+func (_c *Querier_CreateAccount_Call) Run(run func(ctx context.Context, arg db.CreateAccountParams)) *Querier_CreateAccount_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(db.CreateTransferParams))
+		run(args[0].(context.Context), args[1].(db.CreateAccountParams))
 	})
 	return _c
 }
 
-func (_c *Querier_CreateTransfer_Call) Return(_a0 db.Transfer, _a1 error) *Querier_CreateTransfer_Call {
+func (_c *Querier_CreateAccount_Call) Return(_a0 db.Account, _a1 error) *Querier_CreateAccount_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *Querier_CreateTransfer_Call) RunAndReturn(run func(context.Context, db.CreateTransferParams) (db.Transfer, error)) *Querier_CreateTransfer_Call {
+func (_c *Querier_CreateAccount_Call) RunAndReturn(run func(context.Context, db.CreateAccountParams) (db.Account, error)) *Querier_CreateAccount_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateUser provides a mock function with given fields: ctx, arg
-func (_m *Querier) CreateUser(ctx context.Context, arg db.CreateUserParams) (db.User, error) {
+// CreateAccountStatusEvent provides a mock function with given fields: ctx, arg
+func (_m *Querier) CreateAccountStatusEvent(ctx context.Context, arg db.CreateAccountStatusEventParams) (db.AccountStatusEvent, error) {
 	ret := _m.Called(ctx, arg)
 
-	var r0 db.User
+	var r0 db.AccountStatusEvent
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, db.CreateUserParams) (db.User, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateAccountStatusEventParams) (db.AccountStatusEvent, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, db.CreateUserParams) db.User); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateAccountStatusEventParams) db.AccountStatusEvent); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(db.User)
+		r0 = ret.Get(0).(db.AccountStatusEvent)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, db.CreateUserParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, db.CreateAccountStatusEventParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -313,51 +317,51 @@ func (_m *Querier) CreateUser(ctx context.Context, arg db.CreateUserParams) (db.
 	return r0, r1
 }
 
-// Querier_CreateUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateUser'
-type Querier_CreateUser_Call struct {
+// Querier_CreateAccountStatusEvent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateAccountStatusEvent'
+type Querier_CreateAccountStatusEvent_Call struct {
 	*mock.Call
 }
 
-// CreateUser is a helper method to define mock.On call
+// CreateAccountStatusEvent is a helper method to define mock.On call
 //  - ctx context.Context
-//  - arg db.CreateUserParams
-func (_e *Querier_Expecter) CreateUser(ctx interface{}, arg interface{}) *Querier_CreateUser_Call {
-	return &Querier_CreateUser_Call{Call: _e.mock.On("CreateUser", ctx, arg)}
+//  - arg db.CreateAccountStatusEventParams
+func (_e *Querier_Expecter) CreateAccountStatusEvent(ctx interface{}, arg interface{}) *Querier_CreateAccountStatusEvent_Call {
+	return &Querier_CreateAccountStatusEvent_Call{Call: _e.mock.On("CreateAccountStatusEvent", ctx, arg)}
 }
 
-func (_c *Querier_CreateUser_Call) Run(run func(ctx context.Context, arg db.CreateUserParams)) *Querier_CreateUser_Call {
+func (_c *Querier_CreateAccountStatusEvent_Call) Run(run func(ctx context.Context, arg db.CreateAccountStatusEventParams)) *Querier_CreateAccountStatusEvent_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(db.CreateUserParams))
+		run(args[0].(context.Context), args[1].(db.CreateAccountStatusEventParams))
 	})
 	return _c
 }
 
-func (_c *Querier_CreateUser_Call) Return(_a0 db.User, _a1 error) *Querier_CreateUser_Call {
+func (_c *Querier_CreateAccountStatusEvent_Call) Return(_a0 db.AccountStatusEvent, _a1 error) *Querier_CreateAccountStatusEvent_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *Querier_CreateUser_Call) RunAndReturn(run func(context.Context, db.CreateUserParams) (db.User, error)) *Querier_CreateUser_Call {
+func (_c *Querier_CreateAccountStatusEvent_Call) RunAndReturn(run func(context.Context, db.CreateAccountStatusEventParams) (db.AccountStatusEvent, error)) *Querier_CreateAccountStatusEvent_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateVerifyEmail provides a mock function with given fields: ctx, arg
-func (_m *Querier) CreateVerifyEmail(ctx context.Context, arg db.CreateVerifyEmailParams) (db.VerifyEmail, error) {
+// CreateEntry provides a mock function with given fields: ctx, arg
+func (_m *Querier) CreateEntry(ctx context.Context, arg db.CreateEntryParams) (db.Entry, error) {
 	ret := _m.Called(ctx, arg)
 
-	var r0 db.VerifyEmail
+	var r0 db.Entry
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, db.CreateVerifyEmailParams) (db.VerifyEmail, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateEntryParams) (db.Entry, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, db.CreateVerifyEmailParams) db.VerifyEmail); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateEntryParams) db.Entry); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(db.VerifyEmail)
+		r0 = ret.Get(0).(db.Entry)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, db.CreateVerifyEmailParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, db.CreateEntryParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -366,181 +370,210 @@ func (_m *Querier) CreateVerifyEmail(ctx context.Context, arg db.CreateVerifyEma
 	return r0, r1
 }
 
-// Querier_CreateVerifyEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateVerifyEmail'
-type Querier_CreateVerifyEmail_Call struct {
+// Querier_CreateEntry_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateEntry'
+type Querier_CreateEntry_Call struct {
 	*mock.Call
 }
 
-// CreateVerifyEmail is a helper method to define mock.On call
+// CreateEntry is a helper method to define mock.On call
 //  - ctx context.Context
-//  - arg db.CreateVerifyEmailParams
-func (_e *Querier_Expecter) CreateVerifyEmail(ctx interface{}, arg interface{}) *Querier_CreateVerifyEmail_Call {
-	return &Querier_CreateVerifyEmail_Call{Call: _e.mock.On("CreateVerifyEmail", ctx, arg)}
+//  - arg db.CreateEntryParams
+func (_e *Querier_Expecter) CreateEntry(ctx interface{}, arg interface{}) *Querier_CreateEntry_Call {
+	return &Querier_CreateEntry_Call{Call: _e.mock.On("CreateEntry", ctx, arg)}
 }
 
-func (_c *Querier_CreateVerifyEmail_Call) Run(run func(ctx context.Context, arg db.CreateVerifyEmailParams)) *Querier_CreateVerifyEmail_Call {
+func (_c *Querier_CreateEntry_Call) Run(run func(ctx context.Context, arg db.CreateEntryParams)) *Querier_CreateEntry_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(db.CreateVerifyEmailParams))
+		run(args[0].(context.Context), args[1].(db.CreateEntryParams))
 	})
 	return _c
 }
 
-func (_c *Querier_CreateVerifyEmail_Call) Return(_a0 db.VerifyEmail, _a1 error) *Querier_CreateVerifyEmail_Call {
+func (_c *Querier_CreateEntry_Call) Return(_a0 db.Entry, _a1 error) *Querier_CreateEntry_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *Querier_CreateVerifyEmail_Call) RunAndReturn(run func(context.Context, db.CreateVerifyEmailParams) (db.VerifyEmail, error)) *Querier_CreateVerifyEmail_Call {
+func (_c *Querier_CreateEntry_Call) RunAndReturn(run func(context.Context, db.CreateEntryParams) (db.Entry, error)) *Querier_CreateEntry_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteAccount provides a mock function with given fields: ctx, id
-func (_m *Querier) DeleteAccount(ctx context.Context, id int64) error {
-	ret := _m.Called(ctx, id)
+// CreateExchangeAccount provides a mock function with given fields: ctx, arg
+func (_m *Querier) CreateExchangeAccount(ctx context.Context, arg db.CreateExchangeAccountParams) (db.Account, error) {
+	ret := _m.Called(ctx, arg)
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
-		r0 = rf(ctx, id)
+	var r0 db.Account
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateExchangeAccountParams) (db.Account, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateExchangeAccountParams) db.Account); ok {
+		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(db.Account)
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, db.CreateExchangeAccountParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// Querier_DeleteAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAccount'
-type Querier_DeleteAccount_Call struct {
+// Querier_CreateExchangeAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateExchangeAccount'
+type Querier_CreateExchangeAccount_Call struct {
 	*mock.Call
 }
 
-// DeleteAccount is a helper method to define mock.On call
+// CreateExchangeAccount is a helper method to define mock.On call
 //  - ctx context.Context
-//  - id int64
-func (_e *Querier_Expecter) DeleteAccount(ctx interface{}, id interface{}) *Querier_DeleteAccount_Call {
-	return &Querier_DeleteAccount_Call{Call: _e.mock.On("DeleteAccount", ctx, id)}
+//  - arg db.CreateExchangeAccountParams
+func (_e *Querier_Expecter) CreateExchangeAccount(ctx interface{}, arg interface{}) *Querier_CreateExchangeAccount_Call {
+	return &Querier_CreateExchangeAccount_Call{Call: _e.mock.On("CreateExchangeAccount", ctx, arg)}
 }
 
-func (_c *Querier_DeleteAccount_Call) Run(run func(ctx context.Context, id int64)) *Querier_DeleteAccount_Call {
+func (_c *Querier_CreateExchangeAccount_Call) Run(run func(ctx context.Context, arg db.CreateExchangeAccountParams)) *Querier_CreateExchangeAccount_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int64))
+		run(args[0].(context.Context), args[1].(db.CreateExchangeAccountParams))
 	})
 	return _c
 }
 
-func (_c *Querier_DeleteAccount_Call) Return(_a0 error) *Querier_DeleteAccount_Call {
-	_c.Call.Return(_a0)
+func (_c *Querier_CreateExchangeAccount_Call) Return(_a0 db.Account, _a1 error) *Querier_CreateExchangeAccount_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *Querier_DeleteAccount_Call) RunAndReturn(run func(context.Context, int64) error) *Querier_DeleteAccount_Call {
+func (_c *Querier_CreateExchangeAccount_Call) RunAndReturn(run func(context.Context, db.CreateExchangeAccountParams) (db.Account, error)) *Querier_CreateExchangeAccount_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteEntry provides a mock function with given fields: ctx, id
-func (_m *Querier) DeleteEntry(ctx context.Context, id int64) error {
-	ret := _m.Called(ctx, id)
+// CreateIdempotencyKey provides a mock function with given fields: ctx, arg
+func (_m *Querier) CreateIdempotencyKey(ctx context.Context, arg db.CreateIdempotencyKeyParams) (db.IdempotencyKey, error) {
+	ret := _m.Called(ctx, arg)
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
-		r0 = rf(ctx, id)
+	var r0 db.IdempotencyKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateIdempotencyKeyParams) (db.IdempotencyKey, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateIdempotencyKeyParams) db.IdempotencyKey); ok {
+		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(db.IdempotencyKey)
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, db.CreateIdempotencyKeyParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// Querier_DeleteEntry_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteEntry'
-type Querier_DeleteEntry_Call struct {
+// Querier_CreateIdempotencyKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateIdempotencyKey'
+type Querier_CreateIdempotencyKey_Call struct {
 	*mock.Call
 }
 
-// DeleteEntry is a helper method to define mock.On call
+// CreateIdempotencyKey is a helper method to define mock.On call
 //  - ctx context.Context
-//  - id int64
-func (_e *Querier_Expecter) DeleteEntry(ctx interface{}, id interface{}) *Querier_DeleteEntry_Call {
-	return &Querier_DeleteEntry_Call{Call: _e.mock.On("DeleteEntry", ctx, id)}
+//  - arg db.CreateIdempotencyKeyParams
+func (_e *Querier_Expecter) CreateIdempotencyKey(ctx interface{}, arg interface{}) *Querier_CreateIdempotencyKey_Call {
+	return &Querier_CreateIdempotencyKey_Call{Call: _e.mock.On("CreateIdempotencyKey", ctx, arg)}
 }
 
-func (_c *Querier_DeleteEntry_Call) Run(run func(ctx context.Context, id int64)) *Querier_DeleteEntry_Call {
+func (_c *Querier_CreateIdempotencyKey_Call) Run(run func(ctx context.Context, arg db.CreateIdempotencyKeyParams)) *Querier_CreateIdempotencyKey_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int64))
+		run(args[0].(context.Context), args[1].(db.CreateIdempotencyKeyParams))
 	})
 	return _c
 }
 
-func (_c *Querier_DeleteEntry_Call) Return(_a0 error) *Querier_DeleteEntry_Call {
-	_c.Call.Return(_a0)
+func (_c *Querier_CreateIdempotencyKey_Call) Return(_a0 db.IdempotencyKey, _a1 error) *Querier_CreateIdempotencyKey_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *Querier_DeleteEntry_Call) RunAndReturn(run func(context.Context, int64) error) *Querier_DeleteEntry_Call {
+func (_c *Querier_CreateIdempotencyKey_Call) RunAndReturn(run func(context.Context, db.CreateIdempotencyKeyParams) (db.IdempotencyKey, error)) *Querier_CreateIdempotencyKey_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteTransfer provides a mock function with given fields: ctx, id
-func (_m *Querier) DeleteTransfer(ctx context.Context, id int64) error {
-	ret := _m.Called(ctx, id)
+// CreateJournal provides a mock function with given fields: ctx
+func (_m *Querier) CreateJournal(ctx context.Context) (db.Journal, error) {
+	ret := _m.Called(ctx)
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
-		r0 = rf(ctx, id)
+	var r0 db.Journal
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (db.Journal, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) db.Journal); ok {
+		r0 = rf(ctx)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(db.Journal)
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// Querier_DeleteTransfer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteTransfer'
-type Querier_DeleteTransfer_Call struct {
+// Querier_CreateJournal_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateJournal'
+type Querier_CreateJournal_Call struct {
 	*mock.Call
 }
 
-// DeleteTransfer is a helper method to define mock.On call
+// CreateJournal is a helper method to define mock.On call
 //  - ctx context.Context
-//  - id int64
-func (_e *Querier_Expecter) DeleteTransfer(ctx interface{}, id interface{}) *Querier_DeleteTransfer_Call {
-	return &Querier_DeleteTransfer_Call{Call: _e.mock.On("DeleteTransfer", ctx, id)}
+func (_e *Querier_Expecter) CreateJournal(ctx interface{}) *Querier_CreateJournal_Call {
+	return &Querier_CreateJournal_Call{Call: _e.mock.On("CreateJournal", ctx)}
 }
 
-func (_c *Querier_DeleteTransfer_Call) Run(run func(ctx context.Context, id int64)) *Querier_DeleteTransfer_Call {
+func (_c *Querier_CreateJournal_Call) Run(run func(ctx context.Context)) *Querier_CreateJournal_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int64))
+		run(args[0].(context.Context))
 	})
 	return _c
 }
 
-func (_c *Querier_DeleteTransfer_Call) Return(_a0 error) *Querier_DeleteTransfer_Call {
-	_c.Call.Return(_a0)
+func (_c *Querier_CreateJournal_Call) Return(_a0 db.Journal, _a1 error) *Querier_CreateJournal_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *Querier_DeleteTransfer_Call) RunAndReturn(run func(context.Context, int64) error) *Querier_DeleteTransfer_Call {
+func (_c *Querier_CreateJournal_Call) RunAndReturn(run func(context.Context) (db.Journal, error)) *Querier_CreateJournal_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetAccount provides a mock function with given fields: ctx, id
-func (_m *Querier) GetAccount(ctx context.Context, id int64) (db.Account, error) {
-	ret := _m.Called(ctx, id)
+// CreateNewSession provides a mock function with given fields: ctx, arg
+func (_m *Querier) CreateNewSession(ctx context.Context, arg db.CreateNewSessionParams) (db.Session, error) {
+	ret := _m.Called(ctx, arg)
 
-	var r0 db.Account
+	var r0 db.Session
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64) (db.Account, error)); ok {
-		return rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateNewSessionParams) (db.Session, error)); ok {
+		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int64) db.Account); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateNewSessionParams) db.Session); ok {
+		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(db.Account)
+		r0 = ret.Get(0).(db.Session)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
-		r1 = rf(ctx, id)
+	if rf, ok := ret.Get(1).(func(context.Context, db.CreateNewSessionParams) error); ok {
+		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -548,52 +581,52 @@ func (_m *Querier) GetAccount(ctx context.Context, id int64) (db.Account, error)
 	return r0, r1
 }
 
-// Querier_GetAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAccount'
-type Querier_GetAccount_Call struct {
+// Querier_CreateNewSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateNewSession'
+type Querier_CreateNewSession_Call struct {
 	*mock.Call
 }
 
-// GetAccount is a helper method to define mock.On call
+// CreateNewSession is a helper method to define mock.On call
 //  - ctx context.Context
-//  - id int64
-func (_e *Querier_Expecter) GetAccount(ctx interface{}, id interface{}) *Querier_GetAccount_Call {
-	return &Querier_GetAccount_Call{Call: _e.mock.On("GetAccount", ctx, id)}
+//  - arg db.CreateNewSessionParams
+func (_e *Querier_Expecter) CreateNewSession(ctx interface{}, arg interface{}) *Querier_CreateNewSession_Call {
+	return &Querier_CreateNewSession_Call{Call: _e.mock.On("CreateNewSession", ctx, arg)}
 }
 
-func (_c *Querier_GetAccount_Call) Run(run func(ctx context.Context, id int64)) *Querier_GetAccount_Call {
+func (_c *Querier_CreateNewSession_Call) Run(run func(ctx context.Context, arg db.CreateNewSessionParams)) *Querier_CreateNewSession_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int64))
+		run(args[0].(context.Context), args[1].(db.CreateNewSessionParams))
 	})
 	return _c
 }
 
-func (_c *Querier_GetAccount_Call) Return(_a0 db.Account, _a1 error) *Querier_GetAccount_Call {
+func (_c *Querier_CreateNewSession_Call) Return(_a0 db.Session, _a1 error) *Querier_CreateNewSession_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *Querier_GetAccount_Call) RunAndReturn(run func(context.Context, int64) (db.Account, error)) *Querier_GetAccount_Call {
+func (_c *Querier_CreateNewSession_Call) RunAndReturn(run func(context.Context, db.CreateNewSessionParams) (db.Session, error)) *Querier_CreateNewSession_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetAccountForUpdate provides a mock function with given fields: ctx, id
-func (_m *Querier) GetAccountForUpdate(ctx context.Context, id int64) (db.Account, error) {
-	ret := _m.Called(ctx, id)
+// CreateOutbox provides a mock function with given fields: ctx, arg
+func (_m *Querier) CreateOutbox(ctx context.Context, arg db.CreateOutboxParams) (db.Outbox, error) {
+	ret := _m.Called(ctx, arg)
 
-	var r0 db.Account
+	var r0 db.Outbox
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64) (db.Account, error)); ok {
-		return rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateOutboxParams) (db.Outbox, error)); ok {
+		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int64) db.Account); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateOutboxParams) db.Outbox); ok {
+		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(db.Account)
+		r0 = ret.Get(0).(db.Outbox)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
-		r1 = rf(ctx, id)
+	if rf, ok := ret.Get(1).(func(context.Context, db.CreateOutboxParams) error); ok {
+		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -601,52 +634,52 @@ func (_m *Querier) GetAccountForUpdate(ctx context.Context, id int64) (db.Accoun
 	return r0, r1
 }
 
-// Querier_GetAccountForUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAccountForUpdate'
-type Querier_GetAccountForUpdate_Call struct {
+// Querier_CreateOutbox_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateOutbox'
+type Querier_CreateOutbox_Call struct {
 	*mock.Call
 }
 
-// GetAccountForUpdate is a helper method to define mock.On call
+// CreateOutbox is a helper method to define mock.On call
 //  - ctx context.Context
-//  - id int64
-func (_e *Querier_Expecter) GetAccountForUpdate(ctx interface{}, id interface{}) *Querier_GetAccountForUpdate_Call {
-	return &Querier_GetAccountForUpdate_Call{Call: _e.mock.On("GetAccountForUpdate", ctx, id)}
+//  - arg db.CreateOutboxParams
+func (_e *Querier_Expecter) CreateOutbox(ctx interface{}, arg interface{}) *Querier_CreateOutbox_Call {
+	return &Querier_CreateOutbox_Call{Call: _e.mock.On("CreateOutbox", ctx, arg)}
 }
 
-func (_c *Querier_GetAccountForUpdate_Call) Run(run func(ctx context.Context, id int64)) *Querier_GetAccountForUpdate_Call {
+func (_c *Querier_CreateOutbox_Call) Run(run func(ctx context.Context, arg db.CreateOutboxParams)) *Querier_CreateOutbox_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int64))
+		run(args[0].(context.Context), args[1].(db.CreateOutboxParams))
 	})
 	return _c
 }
 
-func (_c *Querier_GetAccountForUpdate_Call) Return(_a0 db.Account, _a1 error) *Querier_GetAccountForUpdate_Call {
+func (_c *Querier_CreateOutbox_Call) Return(_a0 db.Outbox, _a1 error) *Querier_CreateOutbox_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *Querier_GetAccountForUpdate_Call) RunAndReturn(run func(context.Context, int64) (db.Account, error)) *Querier_GetAccountForUpdate_Call {
+func (_c *Querier_CreateOutbox_Call) RunAndReturn(run func(context.Context, db.CreateOutboxParams) (db.Outbox, error)) *Querier_CreateOutbox_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetAccountForUpdateUnsafe provides a mock function with given fields: ctx, id
-func (_m *Querier) GetAccountForUpdateUnsafe(ctx context.Context, id int64) (db.Account, error) {
-	ret := _m.Called(ctx, id)
+// CreateReconciliationReport provides a mock function with given fields: ctx, arg
+func (_m *Querier) CreateReconciliationReport(ctx context.Context, arg db.CreateReconciliationReportParams) (db.ReconciliationReport, error) {
+	ret := _m.Called(ctx, arg)
 
-	var r0 db.Account
+	var r0 db.ReconciliationReport
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64) (db.Account, error)); ok {
-		return rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateReconciliationReportParams) (db.ReconciliationReport, error)); ok {
+		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int64) db.Account); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateReconciliationReportParams) db.ReconciliationReport); ok {
+		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(db.Account)
+		r0 = ret.Get(0).(db.ReconciliationReport)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
-		r1 = rf(ctx, id)
+	if rf, ok := ret.Get(1).(func(context.Context, db.CreateReconciliationReportParams) error); ok {
+		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -654,52 +687,52 @@ func (_m *Querier) GetAccountForUpdateUnsafe(ctx context.Context, id int64) (db.
 	return r0, r1
 }
 
-// Querier_GetAccountForUpdateUnsafe_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAccountForUpdateUnsafe'
-type Querier_GetAccountForUpdateUnsafe_Call struct {
+// Querier_CreateReconciliationReport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateReconciliationReport'
+type Querier_CreateReconciliationReport_Call struct {
 	*mock.Call
 }
 
-// GetAccountForUpdateUnsafe is a helper method to define mock.On call
+// CreateReconciliationReport is a helper method to define mock.On call
 //  - ctx context.Context
-//  - id int64
-func (_e *Querier_Expecter) GetAccountForUpdateUnsafe(ctx interface{}, id interface{}) *Querier_GetAccountForUpdateUnsafe_Call {
-	return &Querier_GetAccountForUpdateUnsafe_Call{Call: _e.mock.On("GetAccountForUpdateUnsafe", ctx, id)}
+//  - arg db.CreateReconciliationReportParams
+func (_e *Querier_Expecter) CreateReconciliationReport(ctx interface{}, arg interface{}) *Querier_CreateReconciliationReport_Call {
+	return &Querier_CreateReconciliationReport_Call{Call: _e.mock.On("CreateReconciliationReport", ctx, arg)}
 }
 
-func (_c *Querier_GetAccountForUpdateUnsafe_Call) Run(run func(ctx context.Context, id int64)) *Querier_GetAccountForUpdateUnsafe_Call {
+func (_c *Querier_CreateReconciliationReport_Call) Run(run func(ctx context.Context, arg db.CreateReconciliationReportParams)) *Querier_CreateReconciliationReport_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int64))
+		run(args[0].(context.Context), args[1].(db.CreateReconciliationReportParams))
 	})
 	return _c
 }
 
-func (_c *Querier_GetAccountForUpdateUnsafe_Call) Return(_a0 db.Account, _a1 error) *Querier_GetAccountForUpdateUnsafe_Call {
+func (_c *Querier_CreateReconciliationReport_Call) Return(_a0 db.ReconciliationReport, _a1 error) *Querier_CreateReconciliationReport_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *Querier_GetAccountForUpdateUnsafe_Call) RunAndReturn(run func(context.Context, int64) (db.Account, error)) *Querier_GetAccountForUpdateUnsafe_Call {
+func (_c *Querier_CreateReconciliationReport_Call) RunAndReturn(run func(context.Context, db.CreateReconciliationReportParams) (db.ReconciliationReport, error)) *Querier_CreateReconciliationReport_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetEntry provides a mock function with given fields: ctx, id
-func (_m *Querier) GetEntry(ctx context.Context, id int64) (db.Entry, error) {
-	ret := _m.Called(ctx, id)
+// CreateScheduledTransfer provides a mock function with given fields: ctx, arg
+func (_m *Querier) CreateScheduledTransfer(ctx context.Context, arg db.CreateScheduledTransferParams) (db.ScheduledTransfer, error) {
+	ret := _m.Called(ctx, arg)
 
-	var r0 db.Entry
+	var r0 db.ScheduledTransfer
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64) (db.Entry, error)); ok {
-		return rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateScheduledTransferParams) (db.ScheduledTransfer, error)); ok {
+		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int64) db.Entry); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateScheduledTransferParams) db.ScheduledTransfer); ok {
+		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(db.Entry)
+		r0 = ret.Get(0).(db.ScheduledTransfer)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
-		r1 = rf(ctx, id)
+	if rf, ok := ret.Get(1).(func(context.Context, db.CreateScheduledTransferParams) error); ok {
+		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -707,52 +740,52 @@ func (_m *Querier) GetEntry(ctx context.Context, id int64) (db.Entry, error) {
 	return r0, r1
 }
 
-// Querier_GetEntry_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetEntry'
-type Querier_GetEntry_Call struct {
+// Querier_CreateScheduledTransfer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateScheduledTransfer'
+type Querier_CreateScheduledTransfer_Call struct {
 	*mock.Call
 }
 
-// GetEntry is a helper method to define mock.On call
+// CreateScheduledTransfer is a helper method to define mock.On call
 //  - ctx context.Context
-//  - id int64
-func (_e *Querier_Expecter) GetEntry(ctx interface{}, id interface{}) *Querier_GetEntry_Call {
-	return &Querier_GetEntry_Call{Call: _e.mock.On("GetEntry", ctx, id)}
+//  - arg db.CreateScheduledTransferParams
+func (_e *Querier_Expecter) CreateScheduledTransfer(ctx interface{}, arg interface{}) *Querier_CreateScheduledTransfer_Call {
+	return &Querier_CreateScheduledTransfer_Call{Call: _e.mock.On("CreateScheduledTransfer", ctx, arg)}
 }
 
-func (_c *Querier_GetEntry_Call) Run(run func(ctx context.Context, id int64)) *Querier_GetEntry_Call {
+func (_c *Querier_CreateScheduledTransfer_Call) Run(run func(ctx context.Context, arg db.CreateScheduledTransferParams)) *Querier_CreateScheduledTransfer_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int64))
+		run(args[0].(context.Context), args[1].(db.CreateScheduledTransferParams))
 	})
 	return _c
 }
 
-func (_c *Querier_GetEntry_Call) Return(_a0 db.Entry, _a1 error) *Querier_GetEntry_Call {
+func (_c *Querier_CreateScheduledTransfer_Call) Return(_a0 db.ScheduledTransfer, _a1 error) *Querier_CreateScheduledTransfer_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *Querier_GetEntry_Call) RunAndReturn(run func(context.Context, int64) (db.Entry, error)) *Querier_GetEntry_Call {
+func (_c *Querier_CreateScheduledTransfer_Call) RunAndReturn(run func(context.Context, db.CreateScheduledTransferParams) (db.ScheduledTransfer, error)) *Querier_CreateScheduledTransfer_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetSession provides a mock function with given fields: ctx, id
-func (_m *Querier) GetSession(ctx context.Context, id uuid.UUID) (db.Session, error) {
-	ret := _m.Called(ctx, id)
+// CreateScheduledTransferRun provides a mock function with given fields: ctx, arg
+func (_m *Querier) CreateScheduledTransferRun(ctx context.Context, arg db.CreateScheduledTransferRunParams) (db.ScheduledTransferRun, error) {
+	ret := _m.Called(ctx, arg)
 
-	var r0 db.Session
+	var r0 db.ScheduledTransferRun
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (db.Session, error)); ok {
-		return rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateScheduledTransferRunParams) (db.ScheduledTransferRun, error)); ok {
+		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) db.Session); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateScheduledTransferRunParams) db.ScheduledTransferRun); ok {
+		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(db.Session)
+		r0 = ret.Get(0).(db.ScheduledTransferRun)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
-		r1 = rf(ctx, id)
+	if rf, ok := ret.Get(1).(func(context.Context, db.CreateScheduledTransferRunParams) error); ok {
+		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -760,52 +793,52 @@ func (_m *Querier) GetSession(ctx context.Context, id uuid.UUID) (db.Session, er
 	return r0, r1
 }
 
-// Querier_GetSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSession'
-type Querier_GetSession_Call struct {
+// Querier_CreateScheduledTransferRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateScheduledTransferRun'
+type Querier_CreateScheduledTransferRun_Call struct {
 	*mock.Call
 }
 
-// GetSession is a helper method to define mock.On call
+// CreateScheduledTransferRun is a helper method to define mock.On call
 //  - ctx context.Context
-//  - id uuid.UUID
-func (_e *Querier_Expecter) GetSession(ctx interface{}, id interface{}) *Querier_GetSession_Call {
-	return &Querier_GetSession_Call{Call: _e.mock.On("GetSession", ctx, id)}
+//  - arg db.CreateScheduledTransferRunParams
+func (_e *Querier_Expecter) CreateScheduledTransferRun(ctx interface{}, arg interface{}) *Querier_CreateScheduledTransferRun_Call {
+	return &Querier_CreateScheduledTransferRun_Call{Call: _e.mock.On("CreateScheduledTransferRun", ctx, arg)}
 }
 
-func (_c *Querier_GetSession_Call) Run(run func(ctx context.Context, id uuid.UUID)) *Querier_GetSession_Call {
+func (_c *Querier_CreateScheduledTransferRun_Call) Run(run func(ctx context.Context, arg db.CreateScheduledTransferRunParams)) *Querier_CreateScheduledTransferRun_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID))
+		run(args[0].(context.Context), args[1].(db.CreateScheduledTransferRunParams))
 	})
 	return _c
 }
 
-func (_c *Querier_GetSession_Call) Return(_a0 db.Session, _a1 error) *Querier_GetSession_Call {
+func (_c *Querier_CreateScheduledTransferRun_Call) Return(_a0 db.ScheduledTransferRun, _a1 error) *Querier_CreateScheduledTransferRun_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *Querier_GetSession_Call) RunAndReturn(run func(context.Context, uuid.UUID) (db.Session, error)) *Querier_GetSession_Call {
+func (_c *Querier_CreateScheduledTransferRun_Call) RunAndReturn(run func(context.Context, db.CreateScheduledTransferRunParams) (db.ScheduledTransferRun, error)) *Querier_CreateScheduledTransferRun_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetTransfer provides a mock function with given fields: ctx, id
-func (_m *Querier) GetTransfer(ctx context.Context, id int64) (db.Transfer, error) {
-	ret := _m.Called(ctx, id)
+// CreateTransfer provides a mock function with given fields: ctx, arg
+func (_m *Querier) CreateTransfer(ctx context.Context, arg db.CreateTransferParams) (db.Transfer, error) {
+	ret := _m.Called(ctx, arg)
 
 	var r0 db.Transfer
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64) (db.Transfer, error)); ok {
-		return rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateTransferParams) (db.Transfer, error)); ok {
+		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int64) db.Transfer); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateTransferParams) db.Transfer); ok {
+		r0 = rf(ctx, arg)
 	} else {
 		r0 = ret.Get(0).(db.Transfer)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
-		r1 = rf(ctx, id)
+	if rf, ok := ret.Get(1).(func(context.Context, db.CreateTransferParams) error); ok {
+		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -813,52 +846,1448 @@ func (_m *Querier) GetTransfer(ctx context.Context, id int64) (db.Transfer, erro
 	return r0, r1
 }
 
-// Querier_GetTransfer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTransfer'
-type Querier_GetTransfer_Call struct {
+// Querier_CreateTransfer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateTransfer'
+type Querier_CreateTransfer_Call struct {
 	*mock.Call
 }
 
-// GetTransfer is a helper method to define mock.On call
+// CreateTransfer is a helper method to define mock.On call
 //  - ctx context.Context
-//  - id int64
-func (_e *Querier_Expecter) GetTransfer(ctx interface{}, id interface{}) *Querier_GetTransfer_Call {
-	return &Querier_GetTransfer_Call{Call: _e.mock.On("GetTransfer", ctx, id)}
+//  - arg db.CreateTransferParams
+func (_e *Querier_Expecter) CreateTransfer(ctx interface{}, arg interface{}) *Querier_CreateTransfer_Call {
+	return &Querier_CreateTransfer_Call{Call: _e.mock.On("CreateTransfer", ctx, arg)}
 }
 
-func (_c *Querier_GetTransfer_Call) Run(run func(ctx context.Context, id int64)) *Querier_GetTransfer_Call {
+func (_c *Querier_CreateTransfer_Call) Run(run func(ctx context.Context, arg db.CreateTransferParams)) *Querier_CreateTransfer_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int64))
+		run(args[0].(context.Context), args[1].(db.CreateTransferParams))
 	})
 	return _c
 }
 
-func (_c *Querier_GetTransfer_Call) Return(_a0 db.Transfer, _a1 error) *Querier_GetTransfer_Call {
+func (_c *Querier_CreateTransfer_Call) Return(_a0 db.Transfer, _a1 error) *Querier_CreateTransfer_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *Querier_GetTransfer_Call) RunAndReturn(run func(context.Context, int64) (db.Transfer, error)) *Querier_GetTransfer_Call {
+func (_c *Querier_CreateTransfer_Call) RunAndReturn(run func(context.Context, db.CreateTransferParams) (db.Transfer, error)) *Querier_CreateTransfer_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetUser provides a mock function with given fields: ctx, username
-func (_m *Querier) GetUser(ctx context.Context, username string) (db.User, error) {
-	ret := _m.Called(ctx, username)
+// CreateUser provides a mock function with given fields: ctx, arg
+func (_m *Querier) CreateUser(ctx context.Context, arg db.CreateUserParams) (db.User, error) {
+	ret := _m.Called(ctx, arg)
 
 	var r0 db.User
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (db.User, error)); ok {
-		return rf(ctx, username)
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateUserParams) (db.User, error)); ok {
+		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) db.User); ok {
-		r0 = rf(ctx, username)
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateUserParams) db.User); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(db.User)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.CreateUserParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Querier_CreateUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateUser'
+type Querier_CreateUser_Call struct {
+	*mock.Call
+}
+
+// CreateUser is a helper method to define mock.On call
+//  - ctx context.Context
+//  - arg db.CreateUserParams
+func (_e *Querier_Expecter) CreateUser(ctx interface{}, arg interface{}) *Querier_CreateUser_Call {
+	return &Querier_CreateUser_Call{Call: _e.mock.On("CreateUser", ctx, arg)}
+}
+
+func (_c *Querier_CreateUser_Call) Run(run func(ctx context.Context, arg db.CreateUserParams)) *Querier_CreateUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.CreateUserParams))
+	})
+	return _c
+}
+
+func (_c *Querier_CreateUser_Call) Return(_a0 db.User, _a1 error) *Querier_CreateUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Querier_CreateUser_Call) RunAndReturn(run func(context.Context, db.CreateUserParams) (db.User, error)) *Querier_CreateUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateVerifyEmail provides a mock function with given fields: ctx, arg
+func (_m *Querier) CreateVerifyEmail(ctx context.Context, arg db.CreateVerifyEmailParams) (db.VerifyEmail, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 db.VerifyEmail
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateVerifyEmailParams) (db.VerifyEmail, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateVerifyEmailParams) db.VerifyEmail); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(db.VerifyEmail)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.CreateVerifyEmailParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Querier_CreateVerifyEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateVerifyEmail'
+type Querier_CreateVerifyEmail_Call struct {
+	*mock.Call
+}
+
+// CreateVerifyEmail is a helper method to define mock.On call
+//  - ctx context.Context
+//  - arg db.CreateVerifyEmailParams
+func (_e *Querier_Expecter) CreateVerifyEmail(ctx interface{}, arg interface{}) *Querier_CreateVerifyEmail_Call {
+	return &Querier_CreateVerifyEmail_Call{Call: _e.mock.On("CreateVerifyEmail", ctx, arg)}
+}
+
+func (_c *Querier_CreateVerifyEmail_Call) Run(run func(ctx context.Context, arg db.CreateVerifyEmailParams)) *Querier_CreateVerifyEmail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.CreateVerifyEmailParams))
+	})
+	return _c
+}
+
+func (_c *Querier_CreateVerifyEmail_Call) Return(_a0 db.VerifyEmail, _a1 error) *Querier_CreateVerifyEmail_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Querier_CreateVerifyEmail_Call) RunAndReturn(run func(context.Context, db.CreateVerifyEmailParams) (db.VerifyEmail, error)) *Querier_CreateVerifyEmail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAccount provides a mock function with given fields: ctx, id
+func (_m *Querier) GetAccount(ctx context.Context, id int64) (db.Account, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 db.Account
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (db.Account, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) db.Account); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(db.Account)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Querier_GetAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAccount'
+type Querier_GetAccount_Call struct {
+	*mock.Call
+}
+
+// GetAccount is a helper method to define mock.On call
+//  - ctx context.Context
+//  - id int64
+func (_e *Querier_Expecter) GetAccount(ctx interface{}, id interface{}) *Querier_GetAccount_Call {
+	return &Querier_GetAccount_Call{Call: _e.mock.On("GetAccount", ctx, id)}
+}
+
+func (_c *Querier_GetAccount_Call) Run(run func(ctx context.Context, id int64)) *Querier_GetAccount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *Querier_GetAccount_Call) Return(_a0 db.Account, _a1 error) *Querier_GetAccount_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Querier_GetAccount_Call) RunAndReturn(run func(context.Context, int64) (db.Account, error)) *Querier_GetAccount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAccountByCurrency provides a mock function with given fields: ctx, arg
+func (_m *Querier) GetAccountByCurrency(ctx context.Context, arg db.GetAccountByCurrencyParams) (db.Account, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 db.Account
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.GetAccountByCurrencyParams) (db.Account, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.GetAccountByCurrencyParams) db.Account); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(db.Account)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.GetAccountByCurrencyParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Querier_GetAccountByCurrency_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAccountByCurrency'
+type Querier_GetAccountByCurrency_Call struct {
+	*mock.Call
+}
+
+// GetAccountByCurrency is a helper method to define mock.On call
+//  - ctx context.Context
+//  - arg db.GetAccountByCurrencyParams
+func (_e *Querier_Expecter) GetAccountByCurrency(ctx interface{}, arg interface{}) *Querier_GetAccountByCurrency_Call {
+	return &Querier_GetAccountByCurrency_Call{Call: _e.mock.On("GetAccountByCurrency", ctx, arg)}
+}
+
+func (_c *Querier_GetAccountByCurrency_Call) Run(run func(ctx context.Context, arg db.GetAccountByCurrencyParams)) *Querier_GetAccountByCurrency_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.GetAccountByCurrencyParams))
+	})
+	return _c
+}
+
+func (_c *Querier_GetAccountByCurrency_Call) Return(_a0 db.Account, _a1 error) *Querier_GetAccountByCurrency_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Querier_GetAccountByCurrency_Call) RunAndReturn(run func(context.Context, db.GetAccountByCurrencyParams) (db.Account, error)) *Querier_GetAccountByCurrency_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAccountForUpdate provides a mock function with given fields: ctx, id
+func (_m *Querier) GetAccountForUpdate(ctx context.Context, id int64) (db.Account, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 db.Account
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (db.Account, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) db.Account); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(db.Account)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Querier_GetAccountForUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAccountForUpdate'
+type Querier_GetAccountForUpdate_Call struct {
+	*mock.Call
+}
+
+// GetAccountForUpdate is a helper method to define mock.On call
+//  - ctx context.Context
+//  - id int64
+func (_e *Querier_Expecter) GetAccountForUpdate(ctx interface{}, id interface{}) *Querier_GetAccountForUpdate_Call {
+	return &Querier_GetAccountForUpdate_Call{Call: _e.mock.On("GetAccountForUpdate", ctx, id)}
+}
+
+func (_c *Querier_GetAccountForUpdate_Call) Run(run func(ctx context.Context, id int64)) *Querier_GetAccountForUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *Querier_GetAccountForUpdate_Call) Return(_a0 db.Account, _a1 error) *Querier_GetAccountForUpdate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Querier_GetAccountForUpdate_Call) RunAndReturn(run func(context.Context, int64) (db.Account, error)) *Querier_GetAccountForUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAccountForUpdateUnsafe provides a mock function with given fields: ctx, id
+func (_m *Querier) GetAccountForUpdateUnsafe(ctx context.Context, id int64) (db.Account, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 db.Account
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (db.Account, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) db.Account); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(db.Account)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Querier_GetAccountForUpdateUnsafe_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAccountForUpdateUnsafe'
+type Querier_GetAccountForUpdateUnsafe_Call struct {
+	*mock.Call
+}
+
+// GetAccountForUpdateUnsafe is a helper method to define mock.On call
+//  - ctx context.Context
+//  - id int64
+func (_e *Querier_Expecter) GetAccountForUpdateUnsafe(ctx interface{}, id interface{}) *Querier_GetAccountForUpdateUnsafe_Call {
+	return &Querier_GetAccountForUpdateUnsafe_Call{Call: _e.mock.On("GetAccountForUpdateUnsafe", ctx, id)}
+}
+
+func (_c *Querier_GetAccountForUpdateUnsafe_Call) Run(run func(ctx context.Context, id int64)) *Querier_GetAccountForUpdateUnsafe_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *Querier_GetAccountForUpdateUnsafe_Call) Return(_a0 db.Account, _a1 error) *Querier_GetAccountForUpdateUnsafe_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Querier_GetAccountForUpdateUnsafe_Call) RunAndReturn(run func(context.Context, int64) (db.Account, error)) *Querier_GetAccountForUpdateUnsafe_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetBalanceBefore provides a mock function with given fields: ctx, arg
+func (_m *Querier) GetBalanceBefore(ctx context.Context, arg db.GetBalanceBeforeParams) (int64, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.GetBalanceBeforeParams) (int64, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.GetBalanceBeforeParams) int64); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.GetBalanceBeforeParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Querier_GetBalanceBefore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBalanceBefore'
+type Querier_GetBalanceBefore_Call struct {
+	*mock.Call
+}
+
+// GetBalanceBefore is a helper method to define mock.On call
+//  - ctx context.Context
+//  - arg db.GetBalanceBeforeParams
+func (_e *Querier_Expecter) GetBalanceBefore(ctx interface{}, arg interface{}) *Querier_GetBalanceBefore_Call {
+	return &Querier_GetBalanceBefore_Call{Call: _e.mock.On("GetBalanceBefore", ctx, arg)}
+}
+
+func (_c *Querier_GetBalanceBefore_Call) Run(run func(ctx context.Context, arg db.GetBalanceBeforeParams)) *Querier_GetBalanceBefore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.GetBalanceBeforeParams))
+	})
+	return _c
+}
+
+func (_c *Querier_GetBalanceBefore_Call) Return(_a0 int64, _a1 error) *Querier_GetBalanceBefore_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Querier_GetBalanceBefore_Call) RunAndReturn(run func(context.Context, db.GetBalanceBeforeParams) (int64, error)) *Querier_GetBalanceBefore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetEntry provides a mock function with given fields: ctx, id
+func (_m *Querier) GetEntry(ctx context.Context, id int64) (db.Entry, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 db.Entry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (db.Entry, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) db.Entry); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(db.Entry)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Querier_GetEntry_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetEntry'
+type Querier_GetEntry_Call struct {
+	*mock.Call
+}
+
+// GetEntry is a helper method to define mock.On call
+//  - ctx context.Context
+//  - id int64
+func (_e *Querier_Expecter) GetEntry(ctx interface{}, id interface{}) *Querier_GetEntry_Call {
+	return &Querier_GetEntry_Call{Call: _e.mock.On("GetEntry", ctx, id)}
+}
+
+func (_c *Querier_GetEntry_Call) Run(run func(ctx context.Context, id int64)) *Querier_GetEntry_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *Querier_GetEntry_Call) Return(_a0 db.Entry, _a1 error) *Querier_GetEntry_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Querier_GetEntry_Call) RunAndReturn(run func(context.Context, int64) (db.Entry, error)) *Querier_GetEntry_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetIdempotencyKey provides a mock function with given fields: ctx, arg
+func (_m *Querier) GetIdempotencyKey(ctx context.Context, arg db.GetIdempotencyKeyParams) (db.IdempotencyKey, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 db.IdempotencyKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.GetIdempotencyKeyParams) (db.IdempotencyKey, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.GetIdempotencyKeyParams) db.IdempotencyKey); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(db.IdempotencyKey)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.GetIdempotencyKeyParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Querier_GetIdempotencyKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetIdempotencyKey'
+type Querier_GetIdempotencyKey_Call struct {
+	*mock.Call
+}
+
+// GetIdempotencyKey is a helper method to define mock.On call
+//  - ctx context.Context
+//  - arg db.GetIdempotencyKeyParams
+func (_e *Querier_Expecter) GetIdempotencyKey(ctx interface{}, arg interface{}) *Querier_GetIdempotencyKey_Call {
+	return &Querier_GetIdempotencyKey_Call{Call: _e.mock.On("GetIdempotencyKey", ctx, arg)}
+}
+
+func (_c *Querier_GetIdempotencyKey_Call) Run(run func(ctx context.Context, arg db.GetIdempotencyKeyParams)) *Querier_GetIdempotencyKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.GetIdempotencyKeyParams))
+	})
+	return _c
+}
+
+func (_c *Querier_GetIdempotencyKey_Call) Return(_a0 db.IdempotencyKey, _a1 error) *Querier_GetIdempotencyKey_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Querier_GetIdempotencyKey_Call) RunAndReturn(run func(context.Context, db.GetIdempotencyKeyParams) (db.IdempotencyKey, error)) *Querier_GetIdempotencyKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetJournal provides a mock function with given fields: ctx, id
+func (_m *Querier) GetJournal(ctx context.Context, id int64) (db.Journal, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 db.Journal
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (db.Journal, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) db.Journal); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(db.Journal)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Querier_GetJournal_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJournal'
+type Querier_GetJournal_Call struct {
+	*mock.Call
+}
+
+// GetJournal is a helper method to define mock.On call
+//  - ctx context.Context
+//  - id int64
+func (_e *Querier_Expecter) GetJournal(ctx interface{}, id interface{}) *Querier_GetJournal_Call {
+	return &Querier_GetJournal_Call{Call: _e.mock.On("GetJournal", ctx, id)}
+}
+
+func (_c *Querier_GetJournal_Call) Run(run func(ctx context.Context, id int64)) *Querier_GetJournal_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *Querier_GetJournal_Call) Return(_a0 db.Journal, _a1 error) *Querier_GetJournal_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Querier_GetJournal_Call) RunAndReturn(run func(context.Context, int64) (db.Journal, error)) *Querier_GetJournal_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetReversedAmount provides a mock function with given fields: ctx, reversalOf
+func (_m *Querier) GetReversedAmount(ctx context.Context, reversalOf sql.NullInt64) (int64, error) {
+	ret := _m.Called(ctx, reversalOf)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, sql.NullInt64) (int64, error)); ok {
+		return rf(ctx, reversalOf)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sql.NullInt64) int64); ok {
+		r0 = rf(ctx, reversalOf)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, sql.NullInt64) error); ok {
+		r1 = rf(ctx, reversalOf)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Querier_GetReversedAmount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReversedAmount'
+type Querier_GetReversedAmount_Call struct {
+	*mock.Call
+}
+
+// GetReversedAmount is a helper method to define mock.On call
+//  - ctx context.Context
+//  - reversalOf sql.NullInt64
+func (_e *Querier_Expecter) GetReversedAmount(ctx interface{}, reversalOf interface{}) *Querier_GetReversedAmount_Call {
+	return &Querier_GetReversedAmount_Call{Call: _e.mock.On("GetReversedAmount", ctx, reversalOf)}
+}
+
+func (_c *Querier_GetReversedAmount_Call) Run(run func(ctx context.Context, reversalOf sql.NullInt64)) *Querier_GetReversedAmount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(sql.NullInt64))
+	})
+	return _c
+}
+
+func (_c *Querier_GetReversedAmount_Call) Return(_a0 int64, _a1 error) *Querier_GetReversedAmount_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Querier_GetReversedAmount_Call) RunAndReturn(run func(context.Context, sql.NullInt64) (int64, error)) *Querier_GetReversedAmount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetScheduledTransfer provides a mock function with given fields: ctx, id
+func (_m *Querier) GetScheduledTransfer(ctx context.Context, id int64) (db.ScheduledTransfer, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 db.ScheduledTransfer
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (db.ScheduledTransfer, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) db.ScheduledTransfer); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(db.ScheduledTransfer)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Querier_GetScheduledTransfer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetScheduledTransfer'
+type Querier_GetScheduledTransfer_Call struct {
+	*mock.Call
+}
+
+// GetScheduledTransfer is a helper method to define mock.On call
+//  - ctx context.Context
+//  - id int64
+func (_e *Querier_Expecter) GetScheduledTransfer(ctx interface{}, id interface{}) *Querier_GetScheduledTransfer_Call {
+	return &Querier_GetScheduledTransfer_Call{Call: _e.mock.On("GetScheduledTransfer", ctx, id)}
+}
+
+func (_c *Querier_GetScheduledTransfer_Call) Run(run func(ctx context.Context, id int64)) *Querier_GetScheduledTransfer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *Querier_GetScheduledTransfer_Call) Return(_a0 db.ScheduledTransfer, _a1 error) *Querier_GetScheduledTransfer_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Querier_GetScheduledTransfer_Call) RunAndReturn(run func(context.Context, int64) (db.ScheduledTransfer, error)) *Querier_GetScheduledTransfer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetScheduledTransferForUpdate provides a mock function with given fields: ctx, id
+func (_m *Querier) GetScheduledTransferForUpdate(ctx context.Context, id int64) (db.ScheduledTransfer, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 db.ScheduledTransfer
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (db.ScheduledTransfer, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) db.ScheduledTransfer); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(db.ScheduledTransfer)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Querier_GetScheduledTransferForUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetScheduledTransferForUpdate'
+type Querier_GetScheduledTransferForUpdate_Call struct {
+	*mock.Call
+}
+
+// GetScheduledTransferForUpdate is a helper method to define mock.On call
+//  - ctx context.Context
+//  - id int64
+func (_e *Querier_Expecter) GetScheduledTransferForUpdate(ctx interface{}, id interface{}) *Querier_GetScheduledTransferForUpdate_Call {
+	return &Querier_GetScheduledTransferForUpdate_Call{Call: _e.mock.On("GetScheduledTransferForUpdate", ctx, id)}
+}
+
+func (_c *Querier_GetScheduledTransferForUpdate_Call) Run(run func(ctx context.Context, id int64)) *Querier_GetScheduledTransferForUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *Querier_GetScheduledTransferForUpdate_Call) Return(_a0 db.ScheduledTransfer, _a1 error) *Querier_GetScheduledTransferForUpdate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Querier_GetScheduledTransferForUpdate_Call) RunAndReturn(run func(context.Context, int64) (db.ScheduledTransfer, error)) *Querier_GetScheduledTransferForUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSession provides a mock function with given fields: ctx, id
+func (_m *Querier) GetSession(ctx context.Context, id uuid.UUID) (db.Session, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 db.Session
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (db.Session, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) db.Session); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(db.Session)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Querier_GetSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSession'
+type Querier_GetSession_Call struct {
+	*mock.Call
+}
+
+// GetSession is a helper method to define mock.On call
+//  - ctx context.Context
+//  - id uuid.UUID
+func (_e *Querier_Expecter) GetSession(ctx interface{}, id interface{}) *Querier_GetSession_Call {
+	return &Querier_GetSession_Call{Call: _e.mock.On("GetSession", ctx, id)}
+}
+
+func (_c *Querier_GetSession_Call) Run(run func(ctx context.Context, id uuid.UUID)) *Querier_GetSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *Querier_GetSession_Call) Return(_a0 db.Session, _a1 error) *Querier_GetSession_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Querier_GetSession_Call) RunAndReturn(run func(context.Context, uuid.UUID) (db.Session, error)) *Querier_GetSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSessionForUpdate provides a mock function with given fields: ctx, id
+func (_m *Querier) GetSessionForUpdate(ctx context.Context, id uuid.UUID) (db.Session, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 db.Session
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (db.Session, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) db.Session); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(db.Session)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Querier_GetSessionForUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSessionForUpdate'
+type Querier_GetSessionForUpdate_Call struct {
+	*mock.Call
+}
+
+// GetSessionForUpdate is a helper method to define mock.On call
+//  - ctx context.Context
+//  - id uuid.UUID
+func (_e *Querier_Expecter) GetSessionForUpdate(ctx interface{}, id interface{}) *Querier_GetSessionForUpdate_Call {
+	return &Querier_GetSessionForUpdate_Call{Call: _e.mock.On("GetSessionForUpdate", ctx, id)}
+}
+
+func (_c *Querier_GetSessionForUpdate_Call) Run(run func(ctx context.Context, id uuid.UUID)) *Querier_GetSessionForUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *Querier_GetSessionForUpdate_Call) Return(_a0 db.Session, _a1 error) *Querier_GetSessionForUpdate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Querier_GetSessionForUpdate_Call) RunAndReturn(run func(context.Context, uuid.UUID) (db.Session, error)) *Querier_GetSessionForUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTransfer provides a mock function with given fields: ctx, id
+func (_m *Querier) GetTransfer(ctx context.Context, id int64) (db.Transfer, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 db.Transfer
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (db.Transfer, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) db.Transfer); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(db.Transfer)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Querier_GetTransfer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTransfer'
+type Querier_GetTransfer_Call struct {
+	*mock.Call
+}
+
+// GetTransfer is a helper method to define mock.On call
+//  - ctx context.Context
+//  - id int64
+func (_e *Querier_Expecter) GetTransfer(ctx interface{}, id interface{}) *Querier_GetTransfer_Call {
+	return &Querier_GetTransfer_Call{Call: _e.mock.On("GetTransfer", ctx, id)}
+}
+
+func (_c *Querier_GetTransfer_Call) Run(run func(ctx context.Context, id int64)) *Querier_GetTransfer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *Querier_GetTransfer_Call) Return(_a0 db.Transfer, _a1 error) *Querier_GetTransfer_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Querier_GetTransfer_Call) RunAndReturn(run func(context.Context, int64) (db.Transfer, error)) *Querier_GetTransfer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTransferForUpdate provides a mock function with given fields: ctx, id
+func (_m *Querier) GetTransferForUpdate(ctx context.Context, id int64) (db.Transfer, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 db.Transfer
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (db.Transfer, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) db.Transfer); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(db.Transfer)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Querier_GetTransferForUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTransferForUpdate'
+type Querier_GetTransferForUpdate_Call struct {
+	*mock.Call
+}
+
+// GetTransferForUpdate is a helper method to define mock.On call
+//  - ctx context.Context
+//  - id int64
+func (_e *Querier_Expecter) GetTransferForUpdate(ctx interface{}, id interface{}) *Querier_GetTransferForUpdate_Call {
+	return &Querier_GetTransferForUpdate_Call{Call: _e.mock.On("GetTransferForUpdate", ctx, id)}
+}
+
+func (_c *Querier_GetTransferForUpdate_Call) Run(run func(ctx context.Context, id int64)) *Querier_GetTransferForUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *Querier_GetTransferForUpdate_Call) Return(_a0 db.Transfer, _a1 error) *Querier_GetTransferForUpdate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Querier_GetTransferForUpdate_Call) RunAndReturn(run func(context.Context, int64) (db.Transfer, error)) *Querier_GetTransferForUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUser provides a mock function with given fields: ctx, username
+func (_m *Querier) GetUser(ctx context.Context, username string) (db.User, error) {
+	ret := _m.Called(ctx, username)
+
+	var r0 db.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (db.User, error)); ok {
+		return rf(ctx, username)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) db.User); ok {
+		r0 = rf(ctx, username)
+	} else {
+		r0 = ret.Get(0).(db.User)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, username)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Querier_GetUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUser'
+type Querier_GetUser_Call struct {
+	*mock.Call
+}
+
+// GetUser is a helper method to define mock.On call
+//  - ctx context.Context
+//  - username string
+func (_e *Querier_Expecter) GetUser(ctx interface{}, username interface{}) *Querier_GetUser_Call {
+	return &Querier_GetUser_Call{Call: _e.mock.On("GetUser", ctx, username)}
+}
+
+func (_c *Querier_GetUser_Call) Run(run func(ctx context.Context, username string)) *Querier_GetUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Querier_GetUser_Call) Return(_a0 db.User, _a1 error) *Querier_GetUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Querier_GetUser_Call) RunAndReturn(run func(context.Context, string) (db.User, error)) *Querier_GetUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListAccountIDs provides a mock function with given fields: ctx, arg
+func (_m *Querier) ListAccountIDs(ctx context.Context, arg db.ListAccountIDsParams) ([]int64, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 []int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListAccountIDsParams) ([]int64, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListAccountIDsParams) []int64); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.ListAccountIDsParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Querier_ListAccountIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListAccountIDs'
+type Querier_ListAccountIDs_Call struct {
+	*mock.Call
+}
+
+// ListAccountIDs is a helper method to define mock.On call
+//  - ctx context.Context
+//  - arg db.ListAccountIDsParams
+func (_e *Querier_Expecter) ListAccountIDs(ctx interface{}, arg interface{}) *Querier_ListAccountIDs_Call {
+	return &Querier_ListAccountIDs_Call{Call: _e.mock.On("ListAccountIDs", ctx, arg)}
+}
+
+func (_c *Querier_ListAccountIDs_Call) Run(run func(ctx context.Context, arg db.ListAccountIDsParams)) *Querier_ListAccountIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.ListAccountIDsParams))
+	})
+	return _c
+}
+
+func (_c *Querier_ListAccountIDs_Call) Return(_a0 []int64, _a1 error) *Querier_ListAccountIDs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Querier_ListAccountIDs_Call) RunAndReturn(run func(context.Context, db.ListAccountIDsParams) ([]int64, error)) *Querier_ListAccountIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListAccountStatusEvents provides a mock function with given fields: ctx, arg
+func (_m *Querier) ListAccountStatusEvents(ctx context.Context, arg db.ListAccountStatusEventsParams) ([]db.AccountStatusEvent, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 []db.AccountStatusEvent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListAccountStatusEventsParams) ([]db.AccountStatusEvent, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListAccountStatusEventsParams) []db.AccountStatusEvent); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.AccountStatusEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.ListAccountStatusEventsParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Querier_ListAccountStatusEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListAccountStatusEvents'
+type Querier_ListAccountStatusEvents_Call struct {
+	*mock.Call
+}
+
+// ListAccountStatusEvents is a helper method to define mock.On call
+//  - ctx context.Context
+//  - arg db.ListAccountStatusEventsParams
+func (_e *Querier_Expecter) ListAccountStatusEvents(ctx interface{}, arg interface{}) *Querier_ListAccountStatusEvents_Call {
+	return &Querier_ListAccountStatusEvents_Call{Call: _e.mock.On("ListAccountStatusEvents", ctx, arg)}
+}
+
+func (_c *Querier_ListAccountStatusEvents_Call) Run(run func(ctx context.Context, arg db.ListAccountStatusEventsParams)) *Querier_ListAccountStatusEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.ListAccountStatusEventsParams))
+	})
+	return _c
+}
+
+func (_c *Querier_ListAccountStatusEvents_Call) Return(_a0 []db.AccountStatusEvent, _a1 error) *Querier_ListAccountStatusEvents_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Querier_ListAccountStatusEvents_Call) RunAndReturn(run func(context.Context, db.ListAccountStatusEventsParams) ([]db.AccountStatusEvent, error)) *Querier_ListAccountStatusEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListAccounts provides a mock function with given fields: ctx, arg
+func (_m *Querier) ListAccounts(ctx context.Context, arg db.ListAccountsParams) ([]db.Account, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 []db.Account
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListAccountsParams) ([]db.Account, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListAccountsParams) []db.Account); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.Account)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.ListAccountsParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Querier_ListAccounts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListAccounts'
+type Querier_ListAccounts_Call struct {
+	*mock.Call
+}
+
+// ListAccounts is a helper method to define mock.On call
+//  - ctx context.Context
+//  - arg db.ListAccountsParams
+func (_e *Querier_Expecter) ListAccounts(ctx interface{}, arg interface{}) *Querier_ListAccounts_Call {
+	return &Querier_ListAccounts_Call{Call: _e.mock.On("ListAccounts", ctx, arg)}
+}
+
+func (_c *Querier_ListAccounts_Call) Run(run func(ctx context.Context, arg db.ListAccountsParams)) *Querier_ListAccounts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.ListAccountsParams))
+	})
+	return _c
+}
+
+func (_c *Querier_ListAccounts_Call) Return(_a0 []db.Account, _a1 error) *Querier_ListAccounts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Querier_ListAccounts_Call) RunAndReturn(run func(context.Context, db.ListAccountsParams) ([]db.Account, error)) *Querier_ListAccounts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListBalanceMismatches provides a mock function with given fields: ctx, accountID
+func (_m *Querier) ListBalanceMismatches(ctx context.Context, accountID sql.NullInt64) ([]db.ListBalanceMismatchesRow, error) {
+	ret := _m.Called(ctx, accountID)
+
+	var r0 []db.ListBalanceMismatchesRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, sql.NullInt64) ([]db.ListBalanceMismatchesRow, error)); ok {
+		return rf(ctx, accountID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sql.NullInt64) []db.ListBalanceMismatchesRow); ok {
+		r0 = rf(ctx, accountID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.ListBalanceMismatchesRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, sql.NullInt64) error); ok {
+		r1 = rf(ctx, accountID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Querier_ListBalanceMismatches_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListBalanceMismatches'
+type Querier_ListBalanceMismatches_Call struct {
+	*mock.Call
+}
+
+// ListBalanceMismatches is a helper method to define mock.On call
+//  - ctx context.Context
+//  - accountID sql.NullInt64
+func (_e *Querier_Expecter) ListBalanceMismatches(ctx interface{}, accountID interface{}) *Querier_ListBalanceMismatches_Call {
+	return &Querier_ListBalanceMismatches_Call{Call: _e.mock.On("ListBalanceMismatches", ctx, accountID)}
+}
+
+func (_c *Querier_ListBalanceMismatches_Call) Run(run func(ctx context.Context, accountID sql.NullInt64)) *Querier_ListBalanceMismatches_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(sql.NullInt64))
+	})
+	return _c
+}
+
+func (_c *Querier_ListBalanceMismatches_Call) Return(_a0 []db.ListBalanceMismatchesRow, _a1 error) *Querier_ListBalanceMismatches_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Querier_ListBalanceMismatches_Call) RunAndReturn(run func(context.Context, sql.NullInt64) ([]db.ListBalanceMismatchesRow, error)) *Querier_ListBalanceMismatches_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListDueScheduledTransferIDs provides a mock function with given fields: ctx, arg
+func (_m *Querier) ListDueScheduledTransferIDs(ctx context.Context, arg db.ListDueScheduledTransferIDsParams) ([]int64, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 []int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListDueScheduledTransferIDsParams) ([]int64, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListDueScheduledTransferIDsParams) []int64); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.ListDueScheduledTransferIDsParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Querier_ListDueScheduledTransferIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListDueScheduledTransferIDs'
+type Querier_ListDueScheduledTransferIDs_Call struct {
+	*mock.Call
+}
+
+// ListDueScheduledTransferIDs is a helper method to define mock.On call
+//  - ctx context.Context
+//  - arg db.ListDueScheduledTransferIDsParams
+func (_e *Querier_Expecter) ListDueScheduledTransferIDs(ctx interface{}, arg interface{}) *Querier_ListDueScheduledTransferIDs_Call {
+	return &Querier_ListDueScheduledTransferIDs_Call{Call: _e.mock.On("ListDueScheduledTransferIDs", ctx, arg)}
+}
+
+func (_c *Querier_ListDueScheduledTransferIDs_Call) Run(run func(ctx context.Context, arg db.ListDueScheduledTransferIDsParams)) *Querier_ListDueScheduledTransferIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.ListDueScheduledTransferIDsParams))
+	})
+	return _c
+}
+
+func (_c *Querier_ListDueScheduledTransferIDs_Call) Return(_a0 []int64, _a1 error) *Querier_ListDueScheduledTransferIDs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Querier_ListDueScheduledTransferIDs_Call) RunAndReturn(run func(context.Context, db.ListDueScheduledTransferIDsParams) ([]int64, error)) *Querier_ListDueScheduledTransferIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListEntries provides a mock function with given fields: ctx, arg
+func (_m *Querier) ListEntries(ctx context.Context, arg db.ListEntriesParams) ([]db.Entry, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 []db.Entry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListEntriesParams) ([]db.Entry, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListEntriesParams) []db.Entry); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.Entry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.ListEntriesParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Querier_ListEntries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListEntries'
+type Querier_ListEntries_Call struct {
+	*mock.Call
+}
+
+// ListEntries is a helper method to define mock.On call
+//  - ctx context.Context
+//  - arg db.ListEntriesParams
+func (_e *Querier_Expecter) ListEntries(ctx interface{}, arg interface{}) *Querier_ListEntries_Call {
+	return &Querier_ListEntries_Call{Call: _e.mock.On("ListEntries", ctx, arg)}
+}
+
+func (_c *Querier_ListEntries_Call) Run(run func(ctx context.Context, arg db.ListEntriesParams)) *Querier_ListEntries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.ListEntriesParams))
+	})
+	return _c
+}
+
+func (_c *Querier_ListEntries_Call) Return(_a0 []db.Entry, _a1 error) *Querier_ListEntries_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Querier_ListEntries_Call) RunAndReturn(run func(context.Context, db.ListEntriesParams) ([]db.Entry, error)) *Querier_ListEntries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListEntriesOf provides a mock function with given fields: ctx, arg
+func (_m *Querier) ListEntriesOf(ctx context.Context, arg db.ListEntriesOfParams) ([]db.Entry, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 []db.Entry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListEntriesOfParams) ([]db.Entry, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListEntriesOfParams) []db.Entry); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.Entry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.ListEntriesOfParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Querier_ListEntriesOf_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListEntriesOf'
+type Querier_ListEntriesOf_Call struct {
+	*mock.Call
+}
+
+// ListEntriesOf is a helper method to define mock.On call
+//  - ctx context.Context
+//  - arg db.ListEntriesOfParams
+func (_e *Querier_Expecter) ListEntriesOf(ctx interface{}, arg interface{}) *Querier_ListEntriesOf_Call {
+	return &Querier_ListEntriesOf_Call{Call: _e.mock.On("ListEntriesOf", ctx, arg)}
+}
+
+func (_c *Querier_ListEntriesOf_Call) Run(run func(ctx context.Context, arg db.ListEntriesOfParams)) *Querier_ListEntriesOf_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.ListEntriesOfParams))
+	})
+	return _c
+}
+
+func (_c *Querier_ListEntriesOf_Call) Return(_a0 []db.Entry, _a1 error) *Querier_ListEntriesOf_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Querier_ListEntriesOf_Call) RunAndReturn(run func(context.Context, db.ListEntriesOfParams) ([]db.Entry, error)) *Querier_ListEntriesOf_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListJournalEntries provides a mock function with given fields: ctx, journalID
+func (_m *Querier) ListJournalEntries(ctx context.Context, journalID int64) ([]db.Entry, error) {
+	ret := _m.Called(ctx, journalID)
+
+	var r0 []db.Entry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]db.Entry, error)); ok {
+		return rf(ctx, journalID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []db.Entry); ok {
+		r0 = rf(ctx, journalID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.Entry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, journalID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Querier_ListJournalEntries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListJournalEntries'
+type Querier_ListJournalEntries_Call struct {
+	*mock.Call
+}
+
+// ListJournalEntries is a helper method to define mock.On call
+//  - ctx context.Context
+//  - journalID int64
+func (_e *Querier_Expecter) ListJournalEntries(ctx interface{}, journalID interface{}) *Querier_ListJournalEntries_Call {
+	return &Querier_ListJournalEntries_Call{Call: _e.mock.On("ListJournalEntries", ctx, journalID)}
+}
+
+func (_c *Querier_ListJournalEntries_Call) Run(run func(ctx context.Context, journalID int64)) *Querier_ListJournalEntries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *Querier_ListJournalEntries_Call) Return(_a0 []db.Entry, _a1 error) *Querier_ListJournalEntries_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Querier_ListJournalEntries_Call) RunAndReturn(run func(context.Context, int64) ([]db.Entry, error)) *Querier_ListJournalEntries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListReconciliationReports provides a mock function with given fields: ctx, arg
+func (_m *Querier) ListReconciliationReports(ctx context.Context, arg db.ListReconciliationReportsParams) ([]db.ReconciliationReport, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 []db.ReconciliationReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListReconciliationReportsParams) ([]db.ReconciliationReport, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListReconciliationReportsParams) []db.ReconciliationReport); ok {
+		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(db.User)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.ReconciliationReport)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, username)
+	if rf, ok := ret.Get(1).(func(context.Context, db.ListReconciliationReportsParams) error); ok {
+		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -866,53 +2295,53 @@ func (_m *Querier) GetUser(ctx context.Context, username string) (db.User, error
 	return r0, r1
 }
 
-// Querier_GetUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUser'
-type Querier_GetUser_Call struct {
+// Querier_ListReconciliationReports_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListReconciliationReports'
+type Querier_ListReconciliationReports_Call struct {
 	*mock.Call
 }
 
-// GetUser is a helper method to define mock.On call
+// ListReconciliationReports is a helper method to define mock.On call
 //  - ctx context.Context
-//  - username string
-func (_e *Querier_Expecter) GetUser(ctx interface{}, username interface{}) *Querier_GetUser_Call {
-	return &Querier_GetUser_Call{Call: _e.mock.On("GetUser", ctx, username)}
+//  - arg db.ListReconciliationReportsParams
+func (_e *Querier_Expecter) ListReconciliationReports(ctx interface{}, arg interface{}) *Querier_ListReconciliationReports_Call {
+	return &Querier_ListReconciliationReports_Call{Call: _e.mock.On("ListReconciliationReports", ctx, arg)}
 }
 
-func (_c *Querier_GetUser_Call) Run(run func(ctx context.Context, username string)) *Querier_GetUser_Call {
+func (_c *Querier_ListReconciliationReports_Call) Run(run func(ctx context.Context, arg db.ListReconciliationReportsParams)) *Querier_ListReconciliationReports_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(db.ListReconciliationReportsParams))
 	})
 	return _c
 }
 
-func (_c *Querier_GetUser_Call) Return(_a0 db.User, _a1 error) *Querier_GetUser_Call {
+func (_c *Querier_ListReconciliationReports_Call) Return(_a0 []db.ReconciliationReport, _a1 error) *Querier_ListReconciliationReports_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *Querier_GetUser_Call) RunAndReturn(run func(context.Context, string) (db.User, error)) *Querier_GetUser_Call {
+func (_c *Querier_ListReconciliationReports_Call) RunAndReturn(run func(context.Context, db.ListReconciliationReportsParams) ([]db.ReconciliationReport, error)) *Querier_ListReconciliationReports_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListAccounts provides a mock function with given fields: ctx, arg
-func (_m *Querier) ListAccounts(ctx context.Context, arg db.ListAccountsParams) ([]db.Account, error) {
+// ListScheduledTransferRuns provides a mock function with given fields: ctx, arg
+func (_m *Querier) ListScheduledTransferRuns(ctx context.Context, arg db.ListScheduledTransferRunsParams) ([]db.ScheduledTransferRun, error) {
 	ret := _m.Called(ctx, arg)
 
-	var r0 []db.Account
+	var r0 []db.ScheduledTransferRun
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, db.ListAccountsParams) ([]db.Account, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListScheduledTransferRunsParams) ([]db.ScheduledTransferRun, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, db.ListAccountsParams) []db.Account); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListScheduledTransferRunsParams) []db.ScheduledTransferRun); ok {
 		r0 = rf(ctx, arg)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]db.Account)
+			r0 = ret.Get(0).([]db.ScheduledTransferRun)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, db.ListAccountsParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, db.ListScheduledTransferRunsParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -921,53 +2350,53 @@ func (_m *Querier) ListAccounts(ctx context.Context, arg db.ListAccountsParams)
 	return r0, r1
 }
 
-// Querier_ListAccounts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListAccounts'
-type Querier_ListAccounts_Call struct {
+// Querier_ListScheduledTransferRuns_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListScheduledTransferRuns'
+type Querier_ListScheduledTransferRuns_Call struct {
 	*mock.Call
 }
 
-// ListAccounts is a helper method to define mock.On call
+// ListScheduledTransferRuns is a helper method to define mock.On call
 //  - ctx context.Context
-//  - arg db.ListAccountsParams
-func (_e *Querier_Expecter) ListAccounts(ctx interface{}, arg interface{}) *Querier_ListAccounts_Call {
-	return &Querier_ListAccounts_Call{Call: _e.mock.On("ListAccounts", ctx, arg)}
+//  - arg db.ListScheduledTransferRunsParams
+func (_e *Querier_Expecter) ListScheduledTransferRuns(ctx interface{}, arg interface{}) *Querier_ListScheduledTransferRuns_Call {
+	return &Querier_ListScheduledTransferRuns_Call{Call: _e.mock.On("ListScheduledTransferRuns", ctx, arg)}
 }
 
-func (_c *Querier_ListAccounts_Call) Run(run func(ctx context.Context, arg db.ListAccountsParams)) *Querier_ListAccounts_Call {
+func (_c *Querier_ListScheduledTransferRuns_Call) Run(run func(ctx context.Context, arg db.ListScheduledTransferRunsParams)) *Querier_ListScheduledTransferRuns_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(db.ListAccountsParams))
+		run(args[0].(context.Context), args[1].(db.ListScheduledTransferRunsParams))
 	})
 	return _c
 }
 
-func (_c *Querier_ListAccounts_Call) Return(_a0 []db.Account, _a1 error) *Querier_ListAccounts_Call {
+func (_c *Querier_ListScheduledTransferRuns_Call) Return(_a0 []db.ScheduledTransferRun, _a1 error) *Querier_ListScheduledTransferRuns_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *Querier_ListAccounts_Call) RunAndReturn(run func(context.Context, db.ListAccountsParams) ([]db.Account, error)) *Querier_ListAccounts_Call {
+func (_c *Querier_ListScheduledTransferRuns_Call) RunAndReturn(run func(context.Context, db.ListScheduledTransferRunsParams) ([]db.ScheduledTransferRun, error)) *Querier_ListScheduledTransferRuns_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListEntries provides a mock function with given fields: ctx, arg
-func (_m *Querier) ListEntries(ctx context.Context, arg db.ListEntriesParams) ([]db.Entry, error) {
+// ListScheduledTransfers provides a mock function with given fields: ctx, arg
+func (_m *Querier) ListScheduledTransfers(ctx context.Context, arg db.ListScheduledTransfersParams) ([]db.ScheduledTransfer, error) {
 	ret := _m.Called(ctx, arg)
 
-	var r0 []db.Entry
+	var r0 []db.ScheduledTransfer
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, db.ListEntriesParams) ([]db.Entry, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListScheduledTransfersParams) ([]db.ScheduledTransfer, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, db.ListEntriesParams) []db.Entry); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListScheduledTransfersParams) []db.ScheduledTransfer); ok {
 		r0 = rf(ctx, arg)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]db.Entry)
+			r0 = ret.Get(0).([]db.ScheduledTransfer)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, db.ListEntriesParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, db.ListScheduledTransfersParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -976,53 +2405,53 @@ func (_m *Querier) ListEntries(ctx context.Context, arg db.ListEntriesParams) ([
 	return r0, r1
 }
 
-// Querier_ListEntries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListEntries'
-type Querier_ListEntries_Call struct {
+// Querier_ListScheduledTransfers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListScheduledTransfers'
+type Querier_ListScheduledTransfers_Call struct {
 	*mock.Call
 }
 
-// ListEntries is a helper method to define mock.On call
+// ListScheduledTransfers is a helper method to define mock.On call
 //  - ctx context.Context
-//  - arg db.ListEntriesParams
-func (_e *Querier_Expecter) ListEntries(ctx interface{}, arg interface{}) *Querier_ListEntries_Call {
-	return &Querier_ListEntries_Call{Call: _e.mock.On("ListEntries", ctx, arg)}
+//  - arg db.ListScheduledTransfersParams
+func (_e *Querier_Expecter) ListScheduledTransfers(ctx interface{}, arg interface{}) *Querier_ListScheduledTransfers_Call {
+	return &Querier_ListScheduledTransfers_Call{Call: _e.mock.On("ListScheduledTransfers", ctx, arg)}
 }
 
-func (_c *Querier_ListEntries_Call) Run(run func(ctx context.Context, arg db.ListEntriesParams)) *Querier_ListEntries_Call {
+func (_c *Querier_ListScheduledTransfers_Call) Run(run func(ctx context.Context, arg db.ListScheduledTransfersParams)) *Querier_ListScheduledTransfers_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(db.ListEntriesParams))
+		run(args[0].(context.Context), args[1].(db.ListScheduledTransfersParams))
 	})
 	return _c
 }
 
-func (_c *Querier_ListEntries_Call) Return(_a0 []db.Entry, _a1 error) *Querier_ListEntries_Call {
+func (_c *Querier_ListScheduledTransfers_Call) Return(_a0 []db.ScheduledTransfer, _a1 error) *Querier_ListScheduledTransfers_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *Querier_ListEntries_Call) RunAndReturn(run func(context.Context, db.ListEntriesParams) ([]db.Entry, error)) *Querier_ListEntries_Call {
+func (_c *Querier_ListScheduledTransfers_Call) RunAndReturn(run func(context.Context, db.ListScheduledTransfersParams) ([]db.ScheduledTransfer, error)) *Querier_ListScheduledTransfers_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListEntriesOf provides a mock function with given fields: ctx, arg
-func (_m *Querier) ListEntriesOf(ctx context.Context, arg db.ListEntriesOfParams) ([]db.Entry, error) {
+// ListStatementEntries provides a mock function with given fields: ctx, arg
+func (_m *Querier) ListStatementEntries(ctx context.Context, arg db.ListStatementEntriesParams) ([]db.ListStatementEntriesRow, error) {
 	ret := _m.Called(ctx, arg)
 
-	var r0 []db.Entry
+	var r0 []db.ListStatementEntriesRow
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, db.ListEntriesOfParams) ([]db.Entry, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListStatementEntriesParams) ([]db.ListStatementEntriesRow, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, db.ListEntriesOfParams) []db.Entry); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListStatementEntriesParams) []db.ListStatementEntriesRow); ok {
 		r0 = rf(ctx, arg)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]db.Entry)
+			r0 = ret.Get(0).([]db.ListStatementEntriesRow)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, db.ListEntriesOfParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, db.ListStatementEntriesParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -1031,31 +2460,31 @@ func (_m *Querier) ListEntriesOf(ctx context.Context, arg db.ListEntriesOfParams
 	return r0, r1
 }
 
-// Querier_ListEntriesOf_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListEntriesOf'
-type Querier_ListEntriesOf_Call struct {
+// Querier_ListStatementEntries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListStatementEntries'
+type Querier_ListStatementEntries_Call struct {
 	*mock.Call
 }
 
-// ListEntriesOf is a helper method to define mock.On call
+// ListStatementEntries is a helper method to define mock.On call
 //  - ctx context.Context
-//  - arg db.ListEntriesOfParams
-func (_e *Querier_Expecter) ListEntriesOf(ctx interface{}, arg interface{}) *Querier_ListEntriesOf_Call {
-	return &Querier_ListEntriesOf_Call{Call: _e.mock.On("ListEntriesOf", ctx, arg)}
+//  - arg db.ListStatementEntriesParams
+func (_e *Querier_Expecter) ListStatementEntries(ctx interface{}, arg interface{}) *Querier_ListStatementEntries_Call {
+	return &Querier_ListStatementEntries_Call{Call: _e.mock.On("ListStatementEntries", ctx, arg)}
 }
 
-func (_c *Querier_ListEntriesOf_Call) Run(run func(ctx context.Context, arg db.ListEntriesOfParams)) *Querier_ListEntriesOf_Call {
+func (_c *Querier_ListStatementEntries_Call) Run(run func(ctx context.Context, arg db.ListStatementEntriesParams)) *Querier_ListStatementEntries_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(db.ListEntriesOfParams))
+		run(args[0].(context.Context), args[1].(db.ListStatementEntriesParams))
 	})
 	return _c
 }
 
-func (_c *Querier_ListEntriesOf_Call) Return(_a0 []db.Entry, _a1 error) *Querier_ListEntriesOf_Call {
+func (_c *Querier_ListStatementEntries_Call) Return(_a0 []db.ListStatementEntriesRow, _a1 error) *Querier_ListStatementEntries_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *Querier_ListEntriesOf_Call) RunAndReturn(run func(context.Context, db.ListEntriesOfParams) ([]db.Entry, error)) *Querier_ListEntriesOf_Call {
+func (_c *Querier_ListStatementEntries_Call) RunAndReturn(run func(context.Context, db.ListStatementEntriesParams) ([]db.ListStatementEntriesRow, error)) *Querier_ListStatementEntries_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -1170,6 +2599,61 @@ func (_c *Querier_ListTransfersFrom_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// ListTransfersOfOwner provides a mock function with given fields: ctx, arg
+func (_m *Querier) ListTransfersOfOwner(ctx context.Context, arg db.ListTransfersOfOwnerParams) ([]db.ListTransfersOfOwnerRow, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 []db.ListTransfersOfOwnerRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListTransfersOfOwnerParams) ([]db.ListTransfersOfOwnerRow, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListTransfersOfOwnerParams) []db.ListTransfersOfOwnerRow); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.ListTransfersOfOwnerRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.ListTransfersOfOwnerParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Querier_ListTransfersOfOwner_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListTransfersOfOwner'
+type Querier_ListTransfersOfOwner_Call struct {
+	*mock.Call
+}
+
+// ListTransfersOfOwner is a helper method to define mock.On call
+//  - ctx context.Context
+//  - arg db.ListTransfersOfOwnerParams
+func (_e *Querier_Expecter) ListTransfersOfOwner(ctx interface{}, arg interface{}) *Querier_ListTransfersOfOwner_Call {
+	return &Querier_ListTransfersOfOwner_Call{Call: _e.mock.On("ListTransfersOfOwner", ctx, arg)}
+}
+
+func (_c *Querier_ListTransfersOfOwner_Call) Run(run func(ctx context.Context, arg db.ListTransfersOfOwnerParams)) *Querier_ListTransfersOfOwner_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.ListTransfersOfOwnerParams))
+	})
+	return _c
+}
+
+func (_c *Querier_ListTransfersOfOwner_Call) Return(_a0 []db.ListTransfersOfOwnerRow, _a1 error) *Querier_ListTransfersOfOwner_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Querier_ListTransfersOfOwner_Call) RunAndReturn(run func(context.Context, db.ListTransfersOfOwnerParams) ([]db.ListTransfersOfOwnerRow, error)) *Querier_ListTransfersOfOwner_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListTransfersTo provides a mock function with given fields: ctx, arg
 func (_m *Querier) ListTransfersTo(ctx context.Context, arg db.ListTransfersToParams) ([]db.Transfer, error) {
 	ret := _m.Called(ctx, arg)
@@ -1225,22 +2709,216 @@ func (_c *Querier_ListTransfersTo_Call) RunAndReturn(run func(context.Context, d
 	return _c
 }
 
-// UpdateAccount provides a mock function with given fields: ctx, arg
-func (_m *Querier) UpdateAccount(ctx context.Context, arg db.UpdateAccountParams) (db.Account, error) {
+// ListUnbalancedJournals provides a mock function with given fields: ctx, accountID
+func (_m *Querier) ListUnbalancedJournals(ctx context.Context, accountID sql.NullInt64) ([]db.ListUnbalancedJournalsRow, error) {
+	ret := _m.Called(ctx, accountID)
+
+	var r0 []db.ListUnbalancedJournalsRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, sql.NullInt64) ([]db.ListUnbalancedJournalsRow, error)); ok {
+		return rf(ctx, accountID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sql.NullInt64) []db.ListUnbalancedJournalsRow); ok {
+		r0 = rf(ctx, accountID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.ListUnbalancedJournalsRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, sql.NullInt64) error); ok {
+		r1 = rf(ctx, accountID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Querier_ListUnbalancedJournals_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListUnbalancedJournals'
+type Querier_ListUnbalancedJournals_Call struct {
+	*mock.Call
+}
+
+// ListUnbalancedJournals is a helper method to define mock.On call
+//  - ctx context.Context
+//  - accountID sql.NullInt64
+func (_e *Querier_Expecter) ListUnbalancedJournals(ctx interface{}, accountID interface{}) *Querier_ListUnbalancedJournals_Call {
+	return &Querier_ListUnbalancedJournals_Call{Call: _e.mock.On("ListUnbalancedJournals", ctx, accountID)}
+}
+
+func (_c *Querier_ListUnbalancedJournals_Call) Run(run func(ctx context.Context, accountID sql.NullInt64)) *Querier_ListUnbalancedJournals_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(sql.NullInt64))
+	})
+	return _c
+}
+
+func (_c *Querier_ListUnbalancedJournals_Call) Return(_a0 []db.ListUnbalancedJournalsRow, _a1 error) *Querier_ListUnbalancedJournals_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Querier_ListUnbalancedJournals_Call) RunAndReturn(run func(context.Context, sql.NullInt64) ([]db.ListUnbalancedJournalsRow, error)) *Querier_ListUnbalancedJournals_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkOutboxFailed provides a mock function with given fields: ctx, arg
+func (_m *Querier) MarkOutboxFailed(ctx context.Context, arg db.MarkOutboxFailedParams) error {
+	ret := _m.Called(ctx, arg)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.MarkOutboxFailedParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Querier_MarkOutboxFailed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkOutboxFailed'
+type Querier_MarkOutboxFailed_Call struct {
+	*mock.Call
+}
+
+// MarkOutboxFailed is a helper method to define mock.On call
+//  - ctx context.Context
+//  - arg db.MarkOutboxFailedParams
+func (_e *Querier_Expecter) MarkOutboxFailed(ctx interface{}, arg interface{}) *Querier_MarkOutboxFailed_Call {
+	return &Querier_MarkOutboxFailed_Call{Call: _e.mock.On("MarkOutboxFailed", ctx, arg)}
+}
+
+func (_c *Querier_MarkOutboxFailed_Call) Run(run func(ctx context.Context, arg db.MarkOutboxFailedParams)) *Querier_MarkOutboxFailed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.MarkOutboxFailedParams))
+	})
+	return _c
+}
+
+func (_c *Querier_MarkOutboxFailed_Call) Return(_a0 error) *Querier_MarkOutboxFailed_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Querier_MarkOutboxFailed_Call) RunAndReturn(run func(context.Context, db.MarkOutboxFailedParams) error) *Querier_MarkOutboxFailed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkOutboxSent provides a mock function with given fields: ctx, id
+func (_m *Querier) MarkOutboxSent(ctx context.Context, id int64) error {
+	ret := _m.Called(ctx, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Querier_MarkOutboxSent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkOutboxSent'
+type Querier_MarkOutboxSent_Call struct {
+	*mock.Call
+}
+
+// MarkOutboxSent is a helper method to define mock.On call
+//  - ctx context.Context
+//  - id int64
+func (_e *Querier_Expecter) MarkOutboxSent(ctx interface{}, id interface{}) *Querier_MarkOutboxSent_Call {
+	return &Querier_MarkOutboxSent_Call{Call: _e.mock.On("MarkOutboxSent", ctx, id)}
+}
+
+func (_c *Querier_MarkOutboxSent_Call) Run(run func(ctx context.Context, id int64)) *Querier_MarkOutboxSent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *Querier_MarkOutboxSent_Call) Return(_a0 error) *Querier_MarkOutboxSent_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Querier_MarkOutboxSent_Call) RunAndReturn(run func(context.Context, int64) error) *Querier_MarkOutboxSent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RotateSession provides a mock function with given fields: ctx, id
+func (_m *Querier) RotateSession(ctx context.Context, id uuid.UUID) (db.Session, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 db.Session
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (db.Session, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) db.Session); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(db.Session)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Querier_RotateSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RotateSession'
+type Querier_RotateSession_Call struct {
+	*mock.Call
+}
+
+// RotateSession is a helper method to define mock.On call
+//  - ctx context.Context
+//  - id uuid.UUID
+func (_e *Querier_Expecter) RotateSession(ctx interface{}, id interface{}) *Querier_RotateSession_Call {
+	return &Querier_RotateSession_Call{Call: _e.mock.On("RotateSession", ctx, id)}
+}
+
+func (_c *Querier_RotateSession_Call) Run(run func(ctx context.Context, id uuid.UUID)) *Querier_RotateSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *Querier_RotateSession_Call) Return(_a0 db.Session, _a1 error) *Querier_RotateSession_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Querier_RotateSession_Call) RunAndReturn(run func(context.Context, uuid.UUID) (db.Session, error)) *Querier_RotateSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateAccountOverdraftLimit provides a mock function with given fields: ctx, arg
+func (_m *Querier) UpdateAccountOverdraftLimit(ctx context.Context, arg db.UpdateAccountOverdraftLimitParams) (db.Account, error) {
 	ret := _m.Called(ctx, arg)
 
 	var r0 db.Account
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateAccountParams) (db.Account, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateAccountOverdraftLimitParams) (db.Account, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateAccountParams) db.Account); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateAccountOverdraftLimitParams) db.Account); ok {
 		r0 = rf(ctx, arg)
 	} else {
 		r0 = ret.Get(0).(db.Account)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, db.UpdateAccountParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, db.UpdateAccountOverdraftLimitParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -1249,51 +2927,51 @@ func (_m *Querier) UpdateAccount(ctx context.Context, arg db.UpdateAccountParams
 	return r0, r1
 }
 
-// Querier_UpdateAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateAccount'
-type Querier_UpdateAccount_Call struct {
+// Querier_UpdateAccountOverdraftLimit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateAccountOverdraftLimit'
+type Querier_UpdateAccountOverdraftLimit_Call struct {
 	*mock.Call
 }
 
-// UpdateAccount is a helper method to define mock.On call
+// UpdateAccountOverdraftLimit is a helper method to define mock.On call
 //  - ctx context.Context
-//  - arg db.UpdateAccountParams
-func (_e *Querier_Expecter) UpdateAccount(ctx interface{}, arg interface{}) *Querier_UpdateAccount_Call {
-	return &Querier_UpdateAccount_Call{Call: _e.mock.On("UpdateAccount", ctx, arg)}
+//  - arg db.UpdateAccountOverdraftLimitParams
+func (_e *Querier_Expecter) UpdateAccountOverdraftLimit(ctx interface{}, arg interface{}) *Querier_UpdateAccountOverdraftLimit_Call {
+	return &Querier_UpdateAccountOverdraftLimit_Call{Call: _e.mock.On("UpdateAccountOverdraftLimit", ctx, arg)}
 }
 
-func (_c *Querier_UpdateAccount_Call) Run(run func(ctx context.Context, arg db.UpdateAccountParams)) *Querier_UpdateAccount_Call {
+func (_c *Querier_UpdateAccountOverdraftLimit_Call) Run(run func(ctx context.Context, arg db.UpdateAccountOverdraftLimitParams)) *Querier_UpdateAccountOverdraftLimit_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(db.UpdateAccountParams))
+		run(args[0].(context.Context), args[1].(db.UpdateAccountOverdraftLimitParams))
 	})
 	return _c
 }
 
-func (_c *Querier_UpdateAccount_Call) Return(_a0 db.Account, _a1 error) *Querier_UpdateAccount_Call {
+func (_c *Querier_UpdateAccountOverdraftLimit_Call) Return(_a0 db.Account, _a1 error) *Querier_UpdateAccountOverdraftLimit_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *Querier_UpdateAccount_Call) RunAndReturn(run func(context.Context, db.UpdateAccountParams) (db.Account, error)) *Querier_UpdateAccount_Call {
+func (_c *Querier_UpdateAccountOverdraftLimit_Call) RunAndReturn(run func(context.Context, db.UpdateAccountOverdraftLimitParams) (db.Account, error)) *Querier_UpdateAccountOverdraftLimit_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateEntry provides a mock function with given fields: ctx, arg
-func (_m *Querier) UpdateEntry(ctx context.Context, arg db.UpdateEntryParams) (db.Entry, error) {
+// UpdateAccountStatus provides a mock function with given fields: ctx, arg
+func (_m *Querier) UpdateAccountStatus(ctx context.Context, arg db.UpdateAccountStatusParams) (db.Account, error) {
 	ret := _m.Called(ctx, arg)
 
-	var r0 db.Entry
+	var r0 db.Account
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateEntryParams) (db.Entry, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateAccountStatusParams) (db.Account, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateEntryParams) db.Entry); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateAccountStatusParams) db.Account); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(db.Entry)
+		r0 = ret.Get(0).(db.Account)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, db.UpdateEntryParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, db.UpdateAccountStatusParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -1302,51 +2980,51 @@ func (_m *Querier) UpdateEntry(ctx context.Context, arg db.UpdateEntryParams) (d
 	return r0, r1
 }
 
-// Querier_UpdateEntry_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateEntry'
-type Querier_UpdateEntry_Call struct {
+// Querier_UpdateAccountStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateAccountStatus'
+type Querier_UpdateAccountStatus_Call struct {
 	*mock.Call
 }
 
-// UpdateEntry is a helper method to define mock.On call
+// UpdateAccountStatus is a helper method to define mock.On call
 //  - ctx context.Context
-//  - arg db.UpdateEntryParams
-func (_e *Querier_Expecter) UpdateEntry(ctx interface{}, arg interface{}) *Querier_UpdateEntry_Call {
-	return &Querier_UpdateEntry_Call{Call: _e.mock.On("UpdateEntry", ctx, arg)}
+//  - arg db.UpdateAccountStatusParams
+func (_e *Querier_Expecter) UpdateAccountStatus(ctx interface{}, arg interface{}) *Querier_UpdateAccountStatus_Call {
+	return &Querier_UpdateAccountStatus_Call{Call: _e.mock.On("UpdateAccountStatus", ctx, arg)}
 }
 
-func (_c *Querier_UpdateEntry_Call) Run(run func(ctx context.Context, arg db.UpdateEntryParams)) *Querier_UpdateEntry_Call {
+func (_c *Querier_UpdateAccountStatus_Call) Run(run func(ctx context.Context, arg db.UpdateAccountStatusParams)) *Querier_UpdateAccountStatus_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(db.UpdateEntryParams))
+		run(args[0].(context.Context), args[1].(db.UpdateAccountStatusParams))
 	})
 	return _c
 }
 
-func (_c *Querier_UpdateEntry_Call) Return(_a0 db.Entry, _a1 error) *Querier_UpdateEntry_Call {
+func (_c *Querier_UpdateAccountStatus_Call) Return(_a0 db.Account, _a1 error) *Querier_UpdateAccountStatus_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *Querier_UpdateEntry_Call) RunAndReturn(run func(context.Context, db.UpdateEntryParams) (db.Entry, error)) *Querier_UpdateEntry_Call {
+func (_c *Querier_UpdateAccountStatus_Call) RunAndReturn(run func(context.Context, db.UpdateAccountStatusParams) (db.Account, error)) *Querier_UpdateAccountStatus_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateTransferAmount provides a mock function with given fields: ctx, arg
-func (_m *Querier) UpdateTransferAmount(ctx context.Context, arg db.UpdateTransferAmountParams) (db.Transfer, error) {
+// UpdateIdempotencyKeyResponse provides a mock function with given fields: ctx, arg
+func (_m *Querier) UpdateIdempotencyKeyResponse(ctx context.Context, arg db.UpdateIdempotencyKeyResponseParams) (db.IdempotencyKey, error) {
 	ret := _m.Called(ctx, arg)
 
-	var r0 db.Transfer
+	var r0 db.IdempotencyKey
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateTransferAmountParams) (db.Transfer, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateIdempotencyKeyResponseParams) (db.IdempotencyKey, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateTransferAmountParams) db.Transfer); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateIdempotencyKeyResponseParams) db.IdempotencyKey); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(db.Transfer)
+		r0 = ret.Get(0).(db.IdempotencyKey)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, db.UpdateTransferAmountParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, db.UpdateIdempotencyKeyResponseParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -1355,51 +3033,51 @@ func (_m *Querier) UpdateTransferAmount(ctx context.Context, arg db.UpdateTransf
 	return r0, r1
 }
 
-// Querier_UpdateTransferAmount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTransferAmount'
-type Querier_UpdateTransferAmount_Call struct {
+// Querier_UpdateIdempotencyKeyResponse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateIdempotencyKeyResponse'
+type Querier_UpdateIdempotencyKeyResponse_Call struct {
 	*mock.Call
 }
 
-// UpdateTransferAmount is a helper method to define mock.On call
+// UpdateIdempotencyKeyResponse is a helper method to define mock.On call
 //  - ctx context.Context
-//  - arg db.UpdateTransferAmountParams
-func (_e *Querier_Expecter) UpdateTransferAmount(ctx interface{}, arg interface{}) *Querier_UpdateTransferAmount_Call {
-	return &Querier_UpdateTransferAmount_Call{Call: _e.mock.On("UpdateTransferAmount", ctx, arg)}
+//  - arg db.UpdateIdempotencyKeyResponseParams
+func (_e *Querier_Expecter) UpdateIdempotencyKeyResponse(ctx interface{}, arg interface{}) *Querier_UpdateIdempotencyKeyResponse_Call {
+	return &Querier_UpdateIdempotencyKeyResponse_Call{Call: _e.mock.On("UpdateIdempotencyKeyResponse", ctx, arg)}
 }
 
-func (_c *Querier_UpdateTransferAmount_Call) Run(run func(ctx context.Context, arg db.UpdateTransferAmountParams)) *Querier_UpdateTransferAmount_Call {
+func (_c *Querier_UpdateIdempotencyKeyResponse_Call) Run(run func(ctx context.Context, arg db.UpdateIdempotencyKeyResponseParams)) *Querier_UpdateIdempotencyKeyResponse_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(db.UpdateTransferAmountParams))
+		run(args[0].(context.Context), args[1].(db.UpdateIdempotencyKeyResponseParams))
 	})
 	return _c
 }
 
-func (_c *Querier_UpdateTransferAmount_Call) Return(_a0 db.Transfer, _a1 error) *Querier_UpdateTransferAmount_Call {
+func (_c *Querier_UpdateIdempotencyKeyResponse_Call) Return(_a0 db.IdempotencyKey, _a1 error) *Querier_UpdateIdempotencyKeyResponse_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *Querier_UpdateTransferAmount_Call) RunAndReturn(run func(context.Context, db.UpdateTransferAmountParams) (db.Transfer, error)) *Querier_UpdateTransferAmount_Call {
+func (_c *Querier_UpdateIdempotencyKeyResponse_Call) RunAndReturn(run func(context.Context, db.UpdateIdempotencyKeyResponseParams) (db.IdempotencyKey, error)) *Querier_UpdateIdempotencyKeyResponse_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateTransferFrom provides a mock function with given fields: ctx, arg
-func (_m *Querier) UpdateTransferFrom(ctx context.Context, arg db.UpdateTransferFromParams) (db.Transfer, error) {
+// UpdateScheduledTransferNextRunAt provides a mock function with given fields: ctx, arg
+func (_m *Querier) UpdateScheduledTransferNextRunAt(ctx context.Context, arg db.UpdateScheduledTransferNextRunAtParams) (db.ScheduledTransfer, error) {
 	ret := _m.Called(ctx, arg)
 
-	var r0 db.Transfer
+	var r0 db.ScheduledTransfer
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateTransferFromParams) (db.Transfer, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateScheduledTransferNextRunAtParams) (db.ScheduledTransfer, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateTransferFromParams) db.Transfer); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateScheduledTransferNextRunAtParams) db.ScheduledTransfer); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(db.Transfer)
+		r0 = ret.Get(0).(db.ScheduledTransfer)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, db.UpdateTransferFromParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, db.UpdateScheduledTransferNextRunAtParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -1408,51 +3086,51 @@ func (_m *Querier) UpdateTransferFrom(ctx context.Context, arg db.UpdateTransfer
 	return r0, r1
 }
 
-// Querier_UpdateTransferFrom_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTransferFrom'
-type Querier_UpdateTransferFrom_Call struct {
+// Querier_UpdateScheduledTransferNextRunAt_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateScheduledTransferNextRunAt'
+type Querier_UpdateScheduledTransferNextRunAt_Call struct {
 	*mock.Call
 }
 
-// UpdateTransferFrom is a helper method to define mock.On call
+// UpdateScheduledTransferNextRunAt is a helper method to define mock.On call
 //  - ctx context.Context
-//  - arg db.UpdateTransferFromParams
-func (_e *Querier_Expecter) UpdateTransferFrom(ctx interface{}, arg interface{}) *Querier_UpdateTransferFrom_Call {
-	return &Querier_UpdateTransferFrom_Call{Call: _e.mock.On("UpdateTransferFrom", ctx, arg)}
+//  - arg db.UpdateScheduledTransferNextRunAtParams
+func (_e *Querier_Expecter) UpdateScheduledTransferNextRunAt(ctx interface{}, arg interface{}) *Querier_UpdateScheduledTransferNextRunAt_Call {
+	return &Querier_UpdateScheduledTransferNextRunAt_Call{Call: _e.mock.On("UpdateScheduledTransferNextRunAt", ctx, arg)}
 }
 
-func (_c *Querier_UpdateTransferFrom_Call) Run(run func(ctx context.Context, arg db.UpdateTransferFromParams)) *Querier_UpdateTransferFrom_Call {
+func (_c *Querier_UpdateScheduledTransferNextRunAt_Call) Run(run func(ctx context.Context, arg db.UpdateScheduledTransferNextRunAtParams)) *Querier_UpdateScheduledTransferNextRunAt_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(db.UpdateTransferFromParams))
+		run(args[0].(context.Context), args[1].(db.UpdateScheduledTransferNextRunAtParams))
 	})
 	return _c
 }
 
-func (_c *Querier_UpdateTransferFrom_Call) Return(_a0 db.Transfer, _a1 error) *Querier_UpdateTransferFrom_Call {
+func (_c *Querier_UpdateScheduledTransferNextRunAt_Call) Return(_a0 db.ScheduledTransfer, _a1 error) *Querier_UpdateScheduledTransferNextRunAt_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *Querier_UpdateTransferFrom_Call) RunAndReturn(run func(context.Context, db.UpdateTransferFromParams) (db.Transfer, error)) *Querier_UpdateTransferFrom_Call {
+func (_c *Querier_UpdateScheduledTransferNextRunAt_Call) RunAndReturn(run func(context.Context, db.UpdateScheduledTransferNextRunAtParams) (db.ScheduledTransfer, error)) *Querier_UpdateScheduledTransferNextRunAt_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateTransferTo provides a mock function with given fields: ctx, arg
-func (_m *Querier) UpdateTransferTo(ctx context.Context, arg db.UpdateTransferToParams) (db.Transfer, error) {
+// UpdateScheduledTransferStatus provides a mock function with given fields: ctx, arg
+func (_m *Querier) UpdateScheduledTransferStatus(ctx context.Context, arg db.UpdateScheduledTransferStatusParams) (db.ScheduledTransfer, error) {
 	ret := _m.Called(ctx, arg)
 
-	var r0 db.Transfer
+	var r0 db.ScheduledTransfer
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateTransferToParams) (db.Transfer, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateScheduledTransferStatusParams) (db.ScheduledTransfer, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateTransferToParams) db.Transfer); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateScheduledTransferStatusParams) db.ScheduledTransfer); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(db.Transfer)
+		r0 = ret.Get(0).(db.ScheduledTransfer)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, db.UpdateTransferToParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, db.UpdateScheduledTransferStatusParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -1461,31 +3139,31 @@ func (_m *Querier) UpdateTransferTo(ctx context.Context, arg db.UpdateTransferTo
 	return r0, r1
 }
 
-// Querier_UpdateTransferTo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTransferTo'
-type Querier_UpdateTransferTo_Call struct {
+// Querier_UpdateScheduledTransferStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateScheduledTransferStatus'
+type Querier_UpdateScheduledTransferStatus_Call struct {
 	*mock.Call
 }
 
-// UpdateTransferTo is a helper method to define mock.On call
+// UpdateScheduledTransferStatus is a helper method to define mock.On call
 //  - ctx context.Context
-//  - arg db.UpdateTransferToParams
-func (_e *Querier_Expecter) UpdateTransferTo(ctx interface{}, arg interface{}) *Querier_UpdateTransferTo_Call {
-	return &Querier_UpdateTransferTo_Call{Call: _e.mock.On("UpdateTransferTo", ctx, arg)}
+//  - arg db.UpdateScheduledTransferStatusParams
+func (_e *Querier_Expecter) UpdateScheduledTransferStatus(ctx interface{}, arg interface{}) *Querier_UpdateScheduledTransferStatus_Call {
+	return &Querier_UpdateScheduledTransferStatus_Call{Call: _e.mock.On("UpdateScheduledTransferStatus", ctx, arg)}
 }
 
-func (_c *Querier_UpdateTransferTo_Call) Run(run func(ctx context.Context, arg db.UpdateTransferToParams)) *Querier_UpdateTransferTo_Call {
+func (_c *Querier_UpdateScheduledTransferStatus_Call) Run(run func(ctx context.Context, arg db.UpdateScheduledTransferStatusParams)) *Querier_UpdateScheduledTransferStatus_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(db.UpdateTransferToParams))
+		run(args[0].(context.Context), args[1].(db.UpdateScheduledTransferStatusParams))
 	})
 	return _c
 }
 
-func (_c *Querier_UpdateTransferTo_Call) Return(_a0 db.Transfer, _a1 error) *Querier_UpdateTransferTo_Call {
+func (_c *Querier_UpdateScheduledTransferStatus_Call) Return(_a0 db.ScheduledTransfer, _a1 error) *Querier_UpdateScheduledTransferStatus_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *Querier_UpdateTransferTo_Call) RunAndReturn(run func(context.Context, db.UpdateTransferToParams) (db.Transfer, error)) *Querier_UpdateTransferTo_Call {
+func (_c *Querier_UpdateScheduledTransferStatus_Call) RunAndReturn(run func(context.Context, db.UpdateScheduledTransferStatusParams) (db.ScheduledTransfer, error)) *Querier_UpdateScheduledTransferStatus_Call {
 	_c.Call.Return(run)
 	return _c
 }
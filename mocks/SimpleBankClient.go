@@ -25,6 +25,356 @@ func (_m *SimpleBankClient) EXPECT() *SimpleBankClient_Expecter {
 	return &SimpleBankClient_Expecter{mock: &_m.Mock}
 }
 
+// CancelScheduledTransfer provides a mock function with given fields: ctx, in, opts
+func (_m *SimpleBankClient) CancelScheduledTransfer(ctx context.Context, in *pb.CancelScheduledTransferRequest, opts ...grpc.CallOption) (*pb.CancelScheduledTransferResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *pb.CancelScheduledTransferResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.CancelScheduledTransferRequest, ...grpc.CallOption) (*pb.CancelScheduledTransferResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.CancelScheduledTransferRequest, ...grpc.CallOption) *pb.CancelScheduledTransferResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.CancelScheduledTransferResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.CancelScheduledTransferRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SimpleBankClient_CancelScheduledTransfer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelScheduledTransfer'
+type SimpleBankClient_CancelScheduledTransfer_Call struct {
+	*mock.Call
+}
+
+// CancelScheduledTransfer is a helper method to define mock.On call
+//  - ctx context.Context
+//  - in *pb.CancelScheduledTransferRequest
+//  - opts ...grpc.CallOption
+func (_e *SimpleBankClient_Expecter) CancelScheduledTransfer(ctx interface{}, in interface{}, opts ...interface{}) *SimpleBankClient_CancelScheduledTransfer_Call {
+	return &SimpleBankClient_CancelScheduledTransfer_Call{Call: _e.mock.On("CancelScheduledTransfer",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *SimpleBankClient_CancelScheduledTransfer_Call) Run(run func(ctx context.Context, in *pb.CancelScheduledTransferRequest, opts ...grpc.CallOption)) *SimpleBankClient_CancelScheduledTransfer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*pb.CancelScheduledTransferRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *SimpleBankClient_CancelScheduledTransfer_Call) Return(_a0 *pb.CancelScheduledTransferResponse, _a1 error) *SimpleBankClient_CancelScheduledTransfer_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SimpleBankClient_CancelScheduledTransfer_Call) RunAndReturn(run func(context.Context, *pb.CancelScheduledTransferRequest, ...grpc.CallOption) (*pb.CancelScheduledTransferResponse, error)) *SimpleBankClient_CancelScheduledTransfer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CloseAccount provides a mock function with given fields: ctx, in, opts
+func (_m *SimpleBankClient) CloseAccount(ctx context.Context, in *pb.CloseAccountRequest, opts ...grpc.CallOption) (*pb.CloseAccountResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *pb.CloseAccountResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.CloseAccountRequest, ...grpc.CallOption) (*pb.CloseAccountResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.CloseAccountRequest, ...grpc.CallOption) *pb.CloseAccountResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.CloseAccountResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.CloseAccountRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SimpleBankClient_CloseAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CloseAccount'
+type SimpleBankClient_CloseAccount_Call struct {
+	*mock.Call
+}
+
+// CloseAccount is a helper method to define mock.On call
+//  - ctx context.Context
+//  - in *pb.CloseAccountRequest
+//  - opts ...grpc.CallOption
+func (_e *SimpleBankClient_Expecter) CloseAccount(ctx interface{}, in interface{}, opts ...interface{}) *SimpleBankClient_CloseAccount_Call {
+	return &SimpleBankClient_CloseAccount_Call{Call: _e.mock.On("CloseAccount",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *SimpleBankClient_CloseAccount_Call) Run(run func(ctx context.Context, in *pb.CloseAccountRequest, opts ...grpc.CallOption)) *SimpleBankClient_CloseAccount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*pb.CloseAccountRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *SimpleBankClient_CloseAccount_Call) Return(_a0 *pb.CloseAccountResponse, _a1 error) *SimpleBankClient_CloseAccount_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SimpleBankClient_CloseAccount_Call) RunAndReturn(run func(context.Context, *pb.CloseAccountRequest, ...grpc.CallOption) (*pb.CloseAccountResponse, error)) *SimpleBankClient_CloseAccount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateAccount provides a mock function with given fields: ctx, in, opts
+func (_m *SimpleBankClient) CreateAccount(ctx context.Context, in *pb.CreateAccountRequest, opts ...grpc.CallOption) (*pb.CreateAccountResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *pb.CreateAccountResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.CreateAccountRequest, ...grpc.CallOption) (*pb.CreateAccountResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.CreateAccountRequest, ...grpc.CallOption) *pb.CreateAccountResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.CreateAccountResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.CreateAccountRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SimpleBankClient_CreateAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateAccount'
+type SimpleBankClient_CreateAccount_Call struct {
+	*mock.Call
+}
+
+// CreateAccount is a helper method to define mock.On call
+//  - ctx context.Context
+//  - in *pb.CreateAccountRequest
+//  - opts ...grpc.CallOption
+func (_e *SimpleBankClient_Expecter) CreateAccount(ctx interface{}, in interface{}, opts ...interface{}) *SimpleBankClient_CreateAccount_Call {
+	return &SimpleBankClient_CreateAccount_Call{Call: _e.mock.On("CreateAccount",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *SimpleBankClient_CreateAccount_Call) Run(run func(ctx context.Context, in *pb.CreateAccountRequest, opts ...grpc.CallOption)) *SimpleBankClient_CreateAccount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*pb.CreateAccountRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *SimpleBankClient_CreateAccount_Call) Return(_a0 *pb.CreateAccountResponse, _a1 error) *SimpleBankClient_CreateAccount_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SimpleBankClient_CreateAccount_Call) RunAndReturn(run func(context.Context, *pb.CreateAccountRequest, ...grpc.CallOption) (*pb.CreateAccountResponse, error)) *SimpleBankClient_CreateAccount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateScheduledTransfer provides a mock function with given fields: ctx, in, opts
+func (_m *SimpleBankClient) CreateScheduledTransfer(ctx context.Context, in *pb.CreateScheduledTransferRequest, opts ...grpc.CallOption) (*pb.CreateScheduledTransferResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *pb.CreateScheduledTransferResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.CreateScheduledTransferRequest, ...grpc.CallOption) (*pb.CreateScheduledTransferResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.CreateScheduledTransferRequest, ...grpc.CallOption) *pb.CreateScheduledTransferResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.CreateScheduledTransferResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.CreateScheduledTransferRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SimpleBankClient_CreateScheduledTransfer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateScheduledTransfer'
+type SimpleBankClient_CreateScheduledTransfer_Call struct {
+	*mock.Call
+}
+
+// CreateScheduledTransfer is a helper method to define mock.On call
+//  - ctx context.Context
+//  - in *pb.CreateScheduledTransferRequest
+//  - opts ...grpc.CallOption
+func (_e *SimpleBankClient_Expecter) CreateScheduledTransfer(ctx interface{}, in interface{}, opts ...interface{}) *SimpleBankClient_CreateScheduledTransfer_Call {
+	return &SimpleBankClient_CreateScheduledTransfer_Call{Call: _e.mock.On("CreateScheduledTransfer",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *SimpleBankClient_CreateScheduledTransfer_Call) Run(run func(ctx context.Context, in *pb.CreateScheduledTransferRequest, opts ...grpc.CallOption)) *SimpleBankClient_CreateScheduledTransfer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*pb.CreateScheduledTransferRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *SimpleBankClient_CreateScheduledTransfer_Call) Return(_a0 *pb.CreateScheduledTransferResponse, _a1 error) *SimpleBankClient_CreateScheduledTransfer_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SimpleBankClient_CreateScheduledTransfer_Call) RunAndReturn(run func(context.Context, *pb.CreateScheduledTransferRequest, ...grpc.CallOption) (*pb.CreateScheduledTransferResponse, error)) *SimpleBankClient_CreateScheduledTransfer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateTransfer provides a mock function with given fields: ctx, in, opts
+func (_m *SimpleBankClient) CreateTransfer(ctx context.Context, in *pb.CreateTransferRequest, opts ...grpc.CallOption) (*pb.CreateTransferResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *pb.CreateTransferResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.CreateTransferRequest, ...grpc.CallOption) (*pb.CreateTransferResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.CreateTransferRequest, ...grpc.CallOption) *pb.CreateTransferResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.CreateTransferResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.CreateTransferRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SimpleBankClient_CreateTransfer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateTransfer'
+type SimpleBankClient_CreateTransfer_Call struct {
+	*mock.Call
+}
+
+// CreateTransfer is a helper method to define mock.On call
+//  - ctx context.Context
+//  - in *pb.CreateTransferRequest
+//  - opts ...grpc.CallOption
+func (_e *SimpleBankClient_Expecter) CreateTransfer(ctx interface{}, in interface{}, opts ...interface{}) *SimpleBankClient_CreateTransfer_Call {
+	return &SimpleBankClient_CreateTransfer_Call{Call: _e.mock.On("CreateTransfer",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *SimpleBankClient_CreateTransfer_Call) Run(run func(ctx context.Context, in *pb.CreateTransferRequest, opts ...grpc.CallOption)) *SimpleBankClient_CreateTransfer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*pb.CreateTransferRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *SimpleBankClient_CreateTransfer_Call) Return(_a0 *pb.CreateTransferResponse, _a1 error) *SimpleBankClient_CreateTransfer_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SimpleBankClient_CreateTransfer_Call) RunAndReturn(run func(context.Context, *pb.CreateTransferRequest, ...grpc.CallOption) (*pb.CreateTransferResponse, error)) *SimpleBankClient_CreateTransfer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateUser provides a mock function with given fields: ctx, in, opts
 func (_m *SimpleBankClient) CreateUser(ctx context.Context, in *pb.CreateUserRequest, opts ...grpc.CallOption) (*pb.CreateUserResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -36,20 +386,1070 @@ func (_m *SimpleBankClient) CreateUser(ctx context.Context, in *pb.CreateUserReq
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *pb.CreateUserResponse
+	var r0 *pb.CreateUserResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.CreateUserRequest, ...grpc.CallOption) (*pb.CreateUserResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.CreateUserRequest, ...grpc.CallOption) *pb.CreateUserResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.CreateUserResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.CreateUserRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SimpleBankClient_CreateUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateUser'
+type SimpleBankClient_CreateUser_Call struct {
+	*mock.Call
+}
+
+// CreateUser is a helper method to define mock.On call
+//  - ctx context.Context
+//  - in *pb.CreateUserRequest
+//  - opts ...grpc.CallOption
+func (_e *SimpleBankClient_Expecter) CreateUser(ctx interface{}, in interface{}, opts ...interface{}) *SimpleBankClient_CreateUser_Call {
+	return &SimpleBankClient_CreateUser_Call{Call: _e.mock.On("CreateUser",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *SimpleBankClient_CreateUser_Call) Run(run func(ctx context.Context, in *pb.CreateUserRequest, opts ...grpc.CallOption)) *SimpleBankClient_CreateUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*pb.CreateUserRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *SimpleBankClient_CreateUser_Call) Return(_a0 *pb.CreateUserResponse, _a1 error) *SimpleBankClient_CreateUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SimpleBankClient_CreateUser_Call) RunAndReturn(run func(context.Context, *pb.CreateUserRequest, ...grpc.CallOption) (*pb.CreateUserResponse, error)) *SimpleBankClient_CreateUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteAccount provides a mock function with given fields: ctx, in, opts
+func (_m *SimpleBankClient) DeleteAccount(ctx context.Context, in *pb.DeleteAccountRequest, opts ...grpc.CallOption) (*pb.DeleteAccountResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *pb.DeleteAccountResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.DeleteAccountRequest, ...grpc.CallOption) (*pb.DeleteAccountResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.DeleteAccountRequest, ...grpc.CallOption) *pb.DeleteAccountResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.DeleteAccountResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.DeleteAccountRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SimpleBankClient_DeleteAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAccount'
+type SimpleBankClient_DeleteAccount_Call struct {
+	*mock.Call
+}
+
+// DeleteAccount is a helper method to define mock.On call
+//  - ctx context.Context
+//  - in *pb.DeleteAccountRequest
+//  - opts ...grpc.CallOption
+func (_e *SimpleBankClient_Expecter) DeleteAccount(ctx interface{}, in interface{}, opts ...interface{}) *SimpleBankClient_DeleteAccount_Call {
+	return &SimpleBankClient_DeleteAccount_Call{Call: _e.mock.On("DeleteAccount",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *SimpleBankClient_DeleteAccount_Call) Run(run func(ctx context.Context, in *pb.DeleteAccountRequest, opts ...grpc.CallOption)) *SimpleBankClient_DeleteAccount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*pb.DeleteAccountRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *SimpleBankClient_DeleteAccount_Call) Return(_a0 *pb.DeleteAccountResponse, _a1 error) *SimpleBankClient_DeleteAccount_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SimpleBankClient_DeleteAccount_Call) RunAndReturn(run func(context.Context, *pb.DeleteAccountRequest, ...grpc.CallOption) (*pb.DeleteAccountResponse, error)) *SimpleBankClient_DeleteAccount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FreezeAccount provides a mock function with given fields: ctx, in, opts
+func (_m *SimpleBankClient) FreezeAccount(ctx context.Context, in *pb.FreezeAccountRequest, opts ...grpc.CallOption) (*pb.FreezeAccountResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *pb.FreezeAccountResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.FreezeAccountRequest, ...grpc.CallOption) (*pb.FreezeAccountResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.FreezeAccountRequest, ...grpc.CallOption) *pb.FreezeAccountResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.FreezeAccountResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.FreezeAccountRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SimpleBankClient_FreezeAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FreezeAccount'
+type SimpleBankClient_FreezeAccount_Call struct {
+	*mock.Call
+}
+
+// FreezeAccount is a helper method to define mock.On call
+//  - ctx context.Context
+//  - in *pb.FreezeAccountRequest
+//  - opts ...grpc.CallOption
+func (_e *SimpleBankClient_Expecter) FreezeAccount(ctx interface{}, in interface{}, opts ...interface{}) *SimpleBankClient_FreezeAccount_Call {
+	return &SimpleBankClient_FreezeAccount_Call{Call: _e.mock.On("FreezeAccount",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *SimpleBankClient_FreezeAccount_Call) Run(run func(ctx context.Context, in *pb.FreezeAccountRequest, opts ...grpc.CallOption)) *SimpleBankClient_FreezeAccount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*pb.FreezeAccountRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *SimpleBankClient_FreezeAccount_Call) Return(_a0 *pb.FreezeAccountResponse, _a1 error) *SimpleBankClient_FreezeAccount_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SimpleBankClient_FreezeAccount_Call) RunAndReturn(run func(context.Context, *pb.FreezeAccountRequest, ...grpc.CallOption) (*pb.FreezeAccountResponse, error)) *SimpleBankClient_FreezeAccount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAccount provides a mock function with given fields: ctx, in, opts
+func (_m *SimpleBankClient) GetAccount(ctx context.Context, in *pb.GetAccountRequest, opts ...grpc.CallOption) (*pb.GetAccountResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *pb.GetAccountResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.GetAccountRequest, ...grpc.CallOption) (*pb.GetAccountResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.GetAccountRequest, ...grpc.CallOption) *pb.GetAccountResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.GetAccountResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.GetAccountRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SimpleBankClient_GetAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAccount'
+type SimpleBankClient_GetAccount_Call struct {
+	*mock.Call
+}
+
+// GetAccount is a helper method to define mock.On call
+//  - ctx context.Context
+//  - in *pb.GetAccountRequest
+//  - opts ...grpc.CallOption
+func (_e *SimpleBankClient_Expecter) GetAccount(ctx interface{}, in interface{}, opts ...interface{}) *SimpleBankClient_GetAccount_Call {
+	return &SimpleBankClient_GetAccount_Call{Call: _e.mock.On("GetAccount",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *SimpleBankClient_GetAccount_Call) Run(run func(ctx context.Context, in *pb.GetAccountRequest, opts ...grpc.CallOption)) *SimpleBankClient_GetAccount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*pb.GetAccountRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *SimpleBankClient_GetAccount_Call) Return(_a0 *pb.GetAccountResponse, _a1 error) *SimpleBankClient_GetAccount_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SimpleBankClient_GetAccount_Call) RunAndReturn(run func(context.Context, *pb.GetAccountRequest, ...grpc.CallOption) (*pb.GetAccountResponse, error)) *SimpleBankClient_GetAccount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetStatement provides a mock function with given fields: ctx, in, opts
+func (_m *SimpleBankClient) GetStatement(ctx context.Context, in *pb.GetStatementRequest, opts ...grpc.CallOption) (*pb.GetStatementResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *pb.GetStatementResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.GetStatementRequest, ...grpc.CallOption) (*pb.GetStatementResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.GetStatementRequest, ...grpc.CallOption) *pb.GetStatementResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.GetStatementResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.GetStatementRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SimpleBankClient_GetStatement_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStatement'
+type SimpleBankClient_GetStatement_Call struct {
+	*mock.Call
+}
+
+// GetStatement is a helper method to define mock.On call
+//  - ctx context.Context
+//  - in *pb.GetStatementRequest
+//  - opts ...grpc.CallOption
+func (_e *SimpleBankClient_Expecter) GetStatement(ctx interface{}, in interface{}, opts ...interface{}) *SimpleBankClient_GetStatement_Call {
+	return &SimpleBankClient_GetStatement_Call{Call: _e.mock.On("GetStatement",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *SimpleBankClient_GetStatement_Call) Run(run func(ctx context.Context, in *pb.GetStatementRequest, opts ...grpc.CallOption)) *SimpleBankClient_GetStatement_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*pb.GetStatementRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *SimpleBankClient_GetStatement_Call) Return(_a0 *pb.GetStatementResponse, _a1 error) *SimpleBankClient_GetStatement_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SimpleBankClient_GetStatement_Call) RunAndReturn(run func(context.Context, *pb.GetStatementRequest, ...grpc.CallOption) (*pb.GetStatementResponse, error)) *SimpleBankClient_GetStatement_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListAccountTransfers provides a mock function with given fields: ctx, in, opts
+func (_m *SimpleBankClient) ListAccountTransfers(ctx context.Context, in *pb.ListAccountTransfersRequest, opts ...grpc.CallOption) (*pb.ListAccountTransfersResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *pb.ListAccountTransfersResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.ListAccountTransfersRequest, ...grpc.CallOption) (*pb.ListAccountTransfersResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.ListAccountTransfersRequest, ...grpc.CallOption) *pb.ListAccountTransfersResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.ListAccountTransfersResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.ListAccountTransfersRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SimpleBankClient_ListAccountTransfers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListAccountTransfers'
+type SimpleBankClient_ListAccountTransfers_Call struct {
+	*mock.Call
+}
+
+// ListAccountTransfers is a helper method to define mock.On call
+//  - ctx context.Context
+//  - in *pb.ListAccountTransfersRequest
+//  - opts ...grpc.CallOption
+func (_e *SimpleBankClient_Expecter) ListAccountTransfers(ctx interface{}, in interface{}, opts ...interface{}) *SimpleBankClient_ListAccountTransfers_Call {
+	return &SimpleBankClient_ListAccountTransfers_Call{Call: _e.mock.On("ListAccountTransfers",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *SimpleBankClient_ListAccountTransfers_Call) Run(run func(ctx context.Context, in *pb.ListAccountTransfersRequest, opts ...grpc.CallOption)) *SimpleBankClient_ListAccountTransfers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*pb.ListAccountTransfersRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *SimpleBankClient_ListAccountTransfers_Call) Return(_a0 *pb.ListAccountTransfersResponse, _a1 error) *SimpleBankClient_ListAccountTransfers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SimpleBankClient_ListAccountTransfers_Call) RunAndReturn(run func(context.Context, *pb.ListAccountTransfersRequest, ...grpc.CallOption) (*pb.ListAccountTransfersResponse, error)) *SimpleBankClient_ListAccountTransfers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListAccounts provides a mock function with given fields: ctx, in, opts
+func (_m *SimpleBankClient) ListAccounts(ctx context.Context, in *pb.ListAccountsRequest, opts ...grpc.CallOption) (*pb.ListAccountsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *pb.ListAccountsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.ListAccountsRequest, ...grpc.CallOption) (*pb.ListAccountsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.ListAccountsRequest, ...grpc.CallOption) *pb.ListAccountsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.ListAccountsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.ListAccountsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SimpleBankClient_ListAccounts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListAccounts'
+type SimpleBankClient_ListAccounts_Call struct {
+	*mock.Call
+}
+
+// ListAccounts is a helper method to define mock.On call
+//  - ctx context.Context
+//  - in *pb.ListAccountsRequest
+//  - opts ...grpc.CallOption
+func (_e *SimpleBankClient_Expecter) ListAccounts(ctx interface{}, in interface{}, opts ...interface{}) *SimpleBankClient_ListAccounts_Call {
+	return &SimpleBankClient_ListAccounts_Call{Call: _e.mock.On("ListAccounts",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *SimpleBankClient_ListAccounts_Call) Run(run func(ctx context.Context, in *pb.ListAccountsRequest, opts ...grpc.CallOption)) *SimpleBankClient_ListAccounts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*pb.ListAccountsRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *SimpleBankClient_ListAccounts_Call) Return(_a0 *pb.ListAccountsResponse, _a1 error) *SimpleBankClient_ListAccounts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SimpleBankClient_ListAccounts_Call) RunAndReturn(run func(context.Context, *pb.ListAccountsRequest, ...grpc.CallOption) (*pb.ListAccountsResponse, error)) *SimpleBankClient_ListAccounts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListEntries provides a mock function with given fields: ctx, in, opts
+func (_m *SimpleBankClient) ListEntries(ctx context.Context, in *pb.ListEntriesRequest, opts ...grpc.CallOption) (*pb.ListEntriesResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *pb.ListEntriesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.ListEntriesRequest, ...grpc.CallOption) (*pb.ListEntriesResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.ListEntriesRequest, ...grpc.CallOption) *pb.ListEntriesResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.ListEntriesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.ListEntriesRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SimpleBankClient_ListEntries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListEntries'
+type SimpleBankClient_ListEntries_Call struct {
+	*mock.Call
+}
+
+// ListEntries is a helper method to define mock.On call
+//  - ctx context.Context
+//  - in *pb.ListEntriesRequest
+//  - opts ...grpc.CallOption
+func (_e *SimpleBankClient_Expecter) ListEntries(ctx interface{}, in interface{}, opts ...interface{}) *SimpleBankClient_ListEntries_Call {
+	return &SimpleBankClient_ListEntries_Call{Call: _e.mock.On("ListEntries",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *SimpleBankClient_ListEntries_Call) Run(run func(ctx context.Context, in *pb.ListEntriesRequest, opts ...grpc.CallOption)) *SimpleBankClient_ListEntries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*pb.ListEntriesRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *SimpleBankClient_ListEntries_Call) Return(_a0 *pb.ListEntriesResponse, _a1 error) *SimpleBankClient_ListEntries_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SimpleBankClient_ListEntries_Call) RunAndReturn(run func(context.Context, *pb.ListEntriesRequest, ...grpc.CallOption) (*pb.ListEntriesResponse, error)) *SimpleBankClient_ListEntries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListMyTransfers provides a mock function with given fields: ctx, in, opts
+func (_m *SimpleBankClient) ListMyTransfers(ctx context.Context, in *pb.ListMyTransfersRequest, opts ...grpc.CallOption) (*pb.ListMyTransfersResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *pb.ListMyTransfersResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.ListMyTransfersRequest, ...grpc.CallOption) (*pb.ListMyTransfersResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.ListMyTransfersRequest, ...grpc.CallOption) *pb.ListMyTransfersResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.ListMyTransfersResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.ListMyTransfersRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SimpleBankClient_ListMyTransfers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListMyTransfers'
+type SimpleBankClient_ListMyTransfers_Call struct {
+	*mock.Call
+}
+
+// ListMyTransfers is a helper method to define mock.On call
+//  - ctx context.Context
+//  - in *pb.ListMyTransfersRequest
+//  - opts ...grpc.CallOption
+func (_e *SimpleBankClient_Expecter) ListMyTransfers(ctx interface{}, in interface{}, opts ...interface{}) *SimpleBankClient_ListMyTransfers_Call {
+	return &SimpleBankClient_ListMyTransfers_Call{Call: _e.mock.On("ListMyTransfers",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *SimpleBankClient_ListMyTransfers_Call) Run(run func(ctx context.Context, in *pb.ListMyTransfersRequest, opts ...grpc.CallOption)) *SimpleBankClient_ListMyTransfers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*pb.ListMyTransfersRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *SimpleBankClient_ListMyTransfers_Call) Return(_a0 *pb.ListMyTransfersResponse, _a1 error) *SimpleBankClient_ListMyTransfers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SimpleBankClient_ListMyTransfers_Call) RunAndReturn(run func(context.Context, *pb.ListMyTransfersRequest, ...grpc.CallOption) (*pb.ListMyTransfersResponse, error)) *SimpleBankClient_ListMyTransfers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListReconciliationReports provides a mock function with given fields: ctx, in, opts
+func (_m *SimpleBankClient) ListReconciliationReports(ctx context.Context, in *pb.ListReconciliationReportsRequest, opts ...grpc.CallOption) (*pb.ListReconciliationReportsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *pb.ListReconciliationReportsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.ListReconciliationReportsRequest, ...grpc.CallOption) (*pb.ListReconciliationReportsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.ListReconciliationReportsRequest, ...grpc.CallOption) *pb.ListReconciliationReportsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.ListReconciliationReportsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.ListReconciliationReportsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SimpleBankClient_ListReconciliationReports_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListReconciliationReports'
+type SimpleBankClient_ListReconciliationReports_Call struct {
+	*mock.Call
+}
+
+// ListReconciliationReports is a helper method to define mock.On call
+//  - ctx context.Context
+//  - in *pb.ListReconciliationReportsRequest
+//  - opts ...grpc.CallOption
+func (_e *SimpleBankClient_Expecter) ListReconciliationReports(ctx interface{}, in interface{}, opts ...interface{}) *SimpleBankClient_ListReconciliationReports_Call {
+	return &SimpleBankClient_ListReconciliationReports_Call{Call: _e.mock.On("ListReconciliationReports",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *SimpleBankClient_ListReconciliationReports_Call) Run(run func(ctx context.Context, in *pb.ListReconciliationReportsRequest, opts ...grpc.CallOption)) *SimpleBankClient_ListReconciliationReports_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*pb.ListReconciliationReportsRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *SimpleBankClient_ListReconciliationReports_Call) Return(_a0 *pb.ListReconciliationReportsResponse, _a1 error) *SimpleBankClient_ListReconciliationReports_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SimpleBankClient_ListReconciliationReports_Call) RunAndReturn(run func(context.Context, *pb.ListReconciliationReportsRequest, ...grpc.CallOption) (*pb.ListReconciliationReportsResponse, error)) *SimpleBankClient_ListReconciliationReports_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListScheduledTransferRuns provides a mock function with given fields: ctx, in, opts
+func (_m *SimpleBankClient) ListScheduledTransferRuns(ctx context.Context, in *pb.ListScheduledTransferRunsRequest, opts ...grpc.CallOption) (*pb.ListScheduledTransferRunsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *pb.ListScheduledTransferRunsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.ListScheduledTransferRunsRequest, ...grpc.CallOption) (*pb.ListScheduledTransferRunsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.ListScheduledTransferRunsRequest, ...grpc.CallOption) *pb.ListScheduledTransferRunsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.ListScheduledTransferRunsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.ListScheduledTransferRunsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SimpleBankClient_ListScheduledTransferRuns_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListScheduledTransferRuns'
+type SimpleBankClient_ListScheduledTransferRuns_Call struct {
+	*mock.Call
+}
+
+// ListScheduledTransferRuns is a helper method to define mock.On call
+//  - ctx context.Context
+//  - in *pb.ListScheduledTransferRunsRequest
+//  - opts ...grpc.CallOption
+func (_e *SimpleBankClient_Expecter) ListScheduledTransferRuns(ctx interface{}, in interface{}, opts ...interface{}) *SimpleBankClient_ListScheduledTransferRuns_Call {
+	return &SimpleBankClient_ListScheduledTransferRuns_Call{Call: _e.mock.On("ListScheduledTransferRuns",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *SimpleBankClient_ListScheduledTransferRuns_Call) Run(run func(ctx context.Context, in *pb.ListScheduledTransferRunsRequest, opts ...grpc.CallOption)) *SimpleBankClient_ListScheduledTransferRuns_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*pb.ListScheduledTransferRunsRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *SimpleBankClient_ListScheduledTransferRuns_Call) Return(_a0 *pb.ListScheduledTransferRunsResponse, _a1 error) *SimpleBankClient_ListScheduledTransferRuns_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SimpleBankClient_ListScheduledTransferRuns_Call) RunAndReturn(run func(context.Context, *pb.ListScheduledTransferRunsRequest, ...grpc.CallOption) (*pb.ListScheduledTransferRunsResponse, error)) *SimpleBankClient_ListScheduledTransferRuns_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListScheduledTransfers provides a mock function with given fields: ctx, in, opts
+func (_m *SimpleBankClient) ListScheduledTransfers(ctx context.Context, in *pb.ListScheduledTransfersRequest, opts ...grpc.CallOption) (*pb.ListScheduledTransfersResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *pb.ListScheduledTransfersResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.ListScheduledTransfersRequest, ...grpc.CallOption) (*pb.ListScheduledTransfersResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.ListScheduledTransfersRequest, ...grpc.CallOption) *pb.ListScheduledTransfersResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.ListScheduledTransfersResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.ListScheduledTransfersRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SimpleBankClient_ListScheduledTransfers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListScheduledTransfers'
+type SimpleBankClient_ListScheduledTransfers_Call struct {
+	*mock.Call
+}
+
+// ListScheduledTransfers is a helper method to define mock.On call
+//  - ctx context.Context
+//  - in *pb.ListScheduledTransfersRequest
+//  - opts ...grpc.CallOption
+func (_e *SimpleBankClient_Expecter) ListScheduledTransfers(ctx interface{}, in interface{}, opts ...interface{}) *SimpleBankClient_ListScheduledTransfers_Call {
+	return &SimpleBankClient_ListScheduledTransfers_Call{Call: _e.mock.On("ListScheduledTransfers",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *SimpleBankClient_ListScheduledTransfers_Call) Run(run func(ctx context.Context, in *pb.ListScheduledTransfersRequest, opts ...grpc.CallOption)) *SimpleBankClient_ListScheduledTransfers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*pb.ListScheduledTransfersRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *SimpleBankClient_ListScheduledTransfers_Call) Return(_a0 *pb.ListScheduledTransfersResponse, _a1 error) *SimpleBankClient_ListScheduledTransfers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SimpleBankClient_ListScheduledTransfers_Call) RunAndReturn(run func(context.Context, *pb.ListScheduledTransfersRequest, ...grpc.CallOption) (*pb.ListScheduledTransfersResponse, error)) *SimpleBankClient_ListScheduledTransfers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LoginUser provides a mock function with given fields: ctx, in, opts
+func (_m *SimpleBankClient) LoginUser(ctx context.Context, in *pb.LoginUserRequest, opts ...grpc.CallOption) (*pb.LoginUserResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *pb.LoginUserResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.LoginUserRequest, ...grpc.CallOption) (*pb.LoginUserResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.LoginUserRequest, ...grpc.CallOption) *pb.LoginUserResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.LoginUserResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.LoginUserRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SimpleBankClient_LoginUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LoginUser'
+type SimpleBankClient_LoginUser_Call struct {
+	*mock.Call
+}
+
+// LoginUser is a helper method to define mock.On call
+//  - ctx context.Context
+//  - in *pb.LoginUserRequest
+//  - opts ...grpc.CallOption
+func (_e *SimpleBankClient_Expecter) LoginUser(ctx interface{}, in interface{}, opts ...interface{}) *SimpleBankClient_LoginUser_Call {
+	return &SimpleBankClient_LoginUser_Call{Call: _e.mock.On("LoginUser",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *SimpleBankClient_LoginUser_Call) Run(run func(ctx context.Context, in *pb.LoginUserRequest, opts ...grpc.CallOption)) *SimpleBankClient_LoginUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*pb.LoginUserRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *SimpleBankClient_LoginUser_Call) Return(_a0 *pb.LoginUserResponse, _a1 error) *SimpleBankClient_LoginUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SimpleBankClient_LoginUser_Call) RunAndReturn(run func(context.Context, *pb.LoginUserRequest, ...grpc.CallOption) (*pb.LoginUserResponse, error)) *SimpleBankClient_LoginUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Logout provides a mock function with given fields: ctx, in, opts
+func (_m *SimpleBankClient) Logout(ctx context.Context, in *pb.LogoutRequest, opts ...grpc.CallOption) (*pb.LogoutResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *pb.LogoutResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.LogoutRequest, ...grpc.CallOption) (*pb.LogoutResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.LogoutRequest, ...grpc.CallOption) *pb.LogoutResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.LogoutResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.LogoutRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SimpleBankClient_Logout_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Logout'
+type SimpleBankClient_Logout_Call struct {
+	*mock.Call
+}
+
+// Logout is a helper method to define mock.On call
+//  - ctx context.Context
+//  - in *pb.LogoutRequest
+//  - opts ...grpc.CallOption
+func (_e *SimpleBankClient_Expecter) Logout(ctx interface{}, in interface{}, opts ...interface{}) *SimpleBankClient_Logout_Call {
+	return &SimpleBankClient_Logout_Call{Call: _e.mock.On("Logout",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *SimpleBankClient_Logout_Call) Run(run func(ctx context.Context, in *pb.LogoutRequest, opts ...grpc.CallOption)) *SimpleBankClient_Logout_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*pb.LogoutRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *SimpleBankClient_Logout_Call) Return(_a0 *pb.LogoutResponse, _a1 error) *SimpleBankClient_Logout_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SimpleBankClient_Logout_Call) RunAndReturn(run func(context.Context, *pb.LogoutRequest, ...grpc.CallOption) (*pb.LogoutResponse, error)) *SimpleBankClient_Logout_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LogoutAllSessions provides a mock function with given fields: ctx, in, opts
+func (_m *SimpleBankClient) LogoutAllSessions(ctx context.Context, in *pb.LogoutAllSessionsRequest, opts ...grpc.CallOption) (*pb.LogoutAllSessionsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *pb.LogoutAllSessionsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.LogoutAllSessionsRequest, ...grpc.CallOption) (*pb.LogoutAllSessionsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.LogoutAllSessionsRequest, ...grpc.CallOption) *pb.LogoutAllSessionsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.LogoutAllSessionsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.LogoutAllSessionsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SimpleBankClient_LogoutAllSessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LogoutAllSessions'
+type SimpleBankClient_LogoutAllSessions_Call struct {
+	*mock.Call
+}
+
+// LogoutAllSessions is a helper method to define mock.On call
+//  - ctx context.Context
+//  - in *pb.LogoutAllSessionsRequest
+//  - opts ...grpc.CallOption
+func (_e *SimpleBankClient_Expecter) LogoutAllSessions(ctx interface{}, in interface{}, opts ...interface{}) *SimpleBankClient_LogoutAllSessions_Call {
+	return &SimpleBankClient_LogoutAllSessions_Call{Call: _e.mock.On("LogoutAllSessions",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *SimpleBankClient_LogoutAllSessions_Call) Run(run func(ctx context.Context, in *pb.LogoutAllSessionsRequest, opts ...grpc.CallOption)) *SimpleBankClient_LogoutAllSessions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*pb.LogoutAllSessionsRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *SimpleBankClient_LogoutAllSessions_Call) Return(_a0 *pb.LogoutAllSessionsResponse, _a1 error) *SimpleBankClient_LogoutAllSessions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SimpleBankClient_LogoutAllSessions_Call) RunAndReturn(run func(context.Context, *pb.LogoutAllSessionsRequest, ...grpc.CallOption) (*pb.LogoutAllSessionsResponse, error)) *SimpleBankClient_LogoutAllSessions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PauseScheduledTransfer provides a mock function with given fields: ctx, in, opts
+func (_m *SimpleBankClient) PauseScheduledTransfer(ctx context.Context, in *pb.PauseScheduledTransferRequest, opts ...grpc.CallOption) (*pb.PauseScheduledTransferResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *pb.PauseScheduledTransferResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *pb.CreateUserRequest, ...grpc.CallOption) (*pb.CreateUserResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.PauseScheduledTransferRequest, ...grpc.CallOption) (*pb.PauseScheduledTransferResponse, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *pb.CreateUserRequest, ...grpc.CallOption) *pb.CreateUserResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.PauseScheduledTransferRequest, ...grpc.CallOption) *pb.PauseScheduledTransferResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*pb.CreateUserResponse)
+			r0 = ret.Get(0).(*pb.PauseScheduledTransferResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *pb.CreateUserRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.PauseScheduledTransferRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -58,21 +1458,21 @@ func (_m *SimpleBankClient) CreateUser(ctx context.Context, in *pb.CreateUserReq
 	return r0, r1
 }
 
-// SimpleBankClient_CreateUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateUser'
-type SimpleBankClient_CreateUser_Call struct {
+// SimpleBankClient_PauseScheduledTransfer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PauseScheduledTransfer'
+type SimpleBankClient_PauseScheduledTransfer_Call struct {
 	*mock.Call
 }
 
-// CreateUser is a helper method to define mock.On call
+// PauseScheduledTransfer is a helper method to define mock.On call
 //  - ctx context.Context
-//  - in *pb.CreateUserRequest
+//  - in *pb.PauseScheduledTransferRequest
 //  - opts ...grpc.CallOption
-func (_e *SimpleBankClient_Expecter) CreateUser(ctx interface{}, in interface{}, opts ...interface{}) *SimpleBankClient_CreateUser_Call {
-	return &SimpleBankClient_CreateUser_Call{Call: _e.mock.On("CreateUser",
+func (_e *SimpleBankClient_Expecter) PauseScheduledTransfer(ctx interface{}, in interface{}, opts ...interface{}) *SimpleBankClient_PauseScheduledTransfer_Call {
+	return &SimpleBankClient_PauseScheduledTransfer_Call{Call: _e.mock.On("PauseScheduledTransfer",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *SimpleBankClient_CreateUser_Call) Run(run func(ctx context.Context, in *pb.CreateUserRequest, opts ...grpc.CallOption)) *SimpleBankClient_CreateUser_Call {
+func (_c *SimpleBankClient_PauseScheduledTransfer_Call) Run(run func(ctx context.Context, in *pb.PauseScheduledTransferRequest, opts ...grpc.CallOption)) *SimpleBankClient_PauseScheduledTransfer_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -80,23 +1480,23 @@ func (_c *SimpleBankClient_CreateUser_Call) Run(run func(ctx context.Context, in
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*pb.CreateUserRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*pb.PauseScheduledTransferRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *SimpleBankClient_CreateUser_Call) Return(_a0 *pb.CreateUserResponse, _a1 error) *SimpleBankClient_CreateUser_Call {
+func (_c *SimpleBankClient_PauseScheduledTransfer_Call) Return(_a0 *pb.PauseScheduledTransferResponse, _a1 error) *SimpleBankClient_PauseScheduledTransfer_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *SimpleBankClient_CreateUser_Call) RunAndReturn(run func(context.Context, *pb.CreateUserRequest, ...grpc.CallOption) (*pb.CreateUserResponse, error)) *SimpleBankClient_CreateUser_Call {
+func (_c *SimpleBankClient_PauseScheduledTransfer_Call) RunAndReturn(run func(context.Context, *pb.PauseScheduledTransferRequest, ...grpc.CallOption) (*pb.PauseScheduledTransferResponse, error)) *SimpleBankClient_PauseScheduledTransfer_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// LoginUser provides a mock function with given fields: ctx, in, opts
-func (_m *SimpleBankClient) LoginUser(ctx context.Context, in *pb.LoginUserRequest, opts ...grpc.CallOption) (*pb.LoginUserResponse, error) {
+// RenewAccessToken provides a mock function with given fields: ctx, in, opts
+func (_m *SimpleBankClient) RenewAccessToken(ctx context.Context, in *pb.RenewAccessTokenRequest, opts ...grpc.CallOption) (*pb.RenewAccessTokenResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -106,20 +1506,20 @@ func (_m *SimpleBankClient) LoginUser(ctx context.Context, in *pb.LoginUserReque
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *pb.LoginUserResponse
+	var r0 *pb.RenewAccessTokenResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *pb.LoginUserRequest, ...grpc.CallOption) (*pb.LoginUserResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.RenewAccessTokenRequest, ...grpc.CallOption) (*pb.RenewAccessTokenResponse, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *pb.LoginUserRequest, ...grpc.CallOption) *pb.LoginUserResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.RenewAccessTokenRequest, ...grpc.CallOption) *pb.RenewAccessTokenResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*pb.LoginUserResponse)
+			r0 = ret.Get(0).(*pb.RenewAccessTokenResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *pb.LoginUserRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.RenewAccessTokenRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -128,21 +1528,21 @@ func (_m *SimpleBankClient) LoginUser(ctx context.Context, in *pb.LoginUserReque
 	return r0, r1
 }
 
-// SimpleBankClient_LoginUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LoginUser'
-type SimpleBankClient_LoginUser_Call struct {
+// SimpleBankClient_RenewAccessToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RenewAccessToken'
+type SimpleBankClient_RenewAccessToken_Call struct {
 	*mock.Call
 }
 
-// LoginUser is a helper method to define mock.On call
+// RenewAccessToken is a helper method to define mock.On call
 //  - ctx context.Context
-//  - in *pb.LoginUserRequest
+//  - in *pb.RenewAccessTokenRequest
 //  - opts ...grpc.CallOption
-func (_e *SimpleBankClient_Expecter) LoginUser(ctx interface{}, in interface{}, opts ...interface{}) *SimpleBankClient_LoginUser_Call {
-	return &SimpleBankClient_LoginUser_Call{Call: _e.mock.On("LoginUser",
+func (_e *SimpleBankClient_Expecter) RenewAccessToken(ctx interface{}, in interface{}, opts ...interface{}) *SimpleBankClient_RenewAccessToken_Call {
+	return &SimpleBankClient_RenewAccessToken_Call{Call: _e.mock.On("RenewAccessToken",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *SimpleBankClient_LoginUser_Call) Run(run func(ctx context.Context, in *pb.LoginUserRequest, opts ...grpc.CallOption)) *SimpleBankClient_LoginUser_Call {
+func (_c *SimpleBankClient_RenewAccessToken_Call) Run(run func(ctx context.Context, in *pb.RenewAccessTokenRequest, opts ...grpc.CallOption)) *SimpleBankClient_RenewAccessToken_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -150,17 +1550,297 @@ func (_c *SimpleBankClient_LoginUser_Call) Run(run func(ctx context.Context, in
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*pb.LoginUserRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*pb.RenewAccessTokenRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *SimpleBankClient_LoginUser_Call) Return(_a0 *pb.LoginUserResponse, _a1 error) *SimpleBankClient_LoginUser_Call {
+func (_c *SimpleBankClient_RenewAccessToken_Call) Return(_a0 *pb.RenewAccessTokenResponse, _a1 error) *SimpleBankClient_RenewAccessToken_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *SimpleBankClient_LoginUser_Call) RunAndReturn(run func(context.Context, *pb.LoginUserRequest, ...grpc.CallOption) (*pb.LoginUserResponse, error)) *SimpleBankClient_LoginUser_Call {
+func (_c *SimpleBankClient_RenewAccessToken_Call) RunAndReturn(run func(context.Context, *pb.RenewAccessTokenRequest, ...grpc.CallOption) (*pb.RenewAccessTokenResponse, error)) *SimpleBankClient_RenewAccessToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReopenAccount provides a mock function with given fields: ctx, in, opts
+func (_m *SimpleBankClient) ReopenAccount(ctx context.Context, in *pb.ReopenAccountRequest, opts ...grpc.CallOption) (*pb.ReopenAccountResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *pb.ReopenAccountResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.ReopenAccountRequest, ...grpc.CallOption) (*pb.ReopenAccountResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.ReopenAccountRequest, ...grpc.CallOption) *pb.ReopenAccountResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.ReopenAccountResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.ReopenAccountRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SimpleBankClient_ReopenAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReopenAccount'
+type SimpleBankClient_ReopenAccount_Call struct {
+	*mock.Call
+}
+
+// ReopenAccount is a helper method to define mock.On call
+//  - ctx context.Context
+//  - in *pb.ReopenAccountRequest
+//  - opts ...grpc.CallOption
+func (_e *SimpleBankClient_Expecter) ReopenAccount(ctx interface{}, in interface{}, opts ...interface{}) *SimpleBankClient_ReopenAccount_Call {
+	return &SimpleBankClient_ReopenAccount_Call{Call: _e.mock.On("ReopenAccount",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *SimpleBankClient_ReopenAccount_Call) Run(run func(ctx context.Context, in *pb.ReopenAccountRequest, opts ...grpc.CallOption)) *SimpleBankClient_ReopenAccount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*pb.ReopenAccountRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *SimpleBankClient_ReopenAccount_Call) Return(_a0 *pb.ReopenAccountResponse, _a1 error) *SimpleBankClient_ReopenAccount_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SimpleBankClient_ReopenAccount_Call) RunAndReturn(run func(context.Context, *pb.ReopenAccountRequest, ...grpc.CallOption) (*pb.ReopenAccountResponse, error)) *SimpleBankClient_ReopenAccount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResumeScheduledTransfer provides a mock function with given fields: ctx, in, opts
+func (_m *SimpleBankClient) ResumeScheduledTransfer(ctx context.Context, in *pb.ResumeScheduledTransferRequest, opts ...grpc.CallOption) (*pb.ResumeScheduledTransferResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *pb.ResumeScheduledTransferResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.ResumeScheduledTransferRequest, ...grpc.CallOption) (*pb.ResumeScheduledTransferResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.ResumeScheduledTransferRequest, ...grpc.CallOption) *pb.ResumeScheduledTransferResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.ResumeScheduledTransferResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.ResumeScheduledTransferRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SimpleBankClient_ResumeScheduledTransfer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResumeScheduledTransfer'
+type SimpleBankClient_ResumeScheduledTransfer_Call struct {
+	*mock.Call
+}
+
+// ResumeScheduledTransfer is a helper method to define mock.On call
+//  - ctx context.Context
+//  - in *pb.ResumeScheduledTransferRequest
+//  - opts ...grpc.CallOption
+func (_e *SimpleBankClient_Expecter) ResumeScheduledTransfer(ctx interface{}, in interface{}, opts ...interface{}) *SimpleBankClient_ResumeScheduledTransfer_Call {
+	return &SimpleBankClient_ResumeScheduledTransfer_Call{Call: _e.mock.On("ResumeScheduledTransfer",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *SimpleBankClient_ResumeScheduledTransfer_Call) Run(run func(ctx context.Context, in *pb.ResumeScheduledTransferRequest, opts ...grpc.CallOption)) *SimpleBankClient_ResumeScheduledTransfer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*pb.ResumeScheduledTransferRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *SimpleBankClient_ResumeScheduledTransfer_Call) Return(_a0 *pb.ResumeScheduledTransferResponse, _a1 error) *SimpleBankClient_ResumeScheduledTransfer_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SimpleBankClient_ResumeScheduledTransfer_Call) RunAndReturn(run func(context.Context, *pb.ResumeScheduledTransferRequest, ...grpc.CallOption) (*pb.ResumeScheduledTransferResponse, error)) *SimpleBankClient_ResumeScheduledTransfer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReverseTransfer provides a mock function with given fields: ctx, in, opts
+func (_m *SimpleBankClient) ReverseTransfer(ctx context.Context, in *pb.ReverseTransferRequest, opts ...grpc.CallOption) (*pb.ReverseTransferResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *pb.ReverseTransferResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.ReverseTransferRequest, ...grpc.CallOption) (*pb.ReverseTransferResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.ReverseTransferRequest, ...grpc.CallOption) *pb.ReverseTransferResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.ReverseTransferResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.ReverseTransferRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SimpleBankClient_ReverseTransfer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReverseTransfer'
+type SimpleBankClient_ReverseTransfer_Call struct {
+	*mock.Call
+}
+
+// ReverseTransfer is a helper method to define mock.On call
+//  - ctx context.Context
+//  - in *pb.ReverseTransferRequest
+//  - opts ...grpc.CallOption
+func (_e *SimpleBankClient_Expecter) ReverseTransfer(ctx interface{}, in interface{}, opts ...interface{}) *SimpleBankClient_ReverseTransfer_Call {
+	return &SimpleBankClient_ReverseTransfer_Call{Call: _e.mock.On("ReverseTransfer",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *SimpleBankClient_ReverseTransfer_Call) Run(run func(ctx context.Context, in *pb.ReverseTransferRequest, opts ...grpc.CallOption)) *SimpleBankClient_ReverseTransfer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*pb.ReverseTransferRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *SimpleBankClient_ReverseTransfer_Call) Return(_a0 *pb.ReverseTransferResponse, _a1 error) *SimpleBankClient_ReverseTransfer_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SimpleBankClient_ReverseTransfer_Call) RunAndReturn(run func(context.Context, *pb.ReverseTransferRequest, ...grpc.CallOption) (*pb.ReverseTransferResponse, error)) *SimpleBankClient_ReverseTransfer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UnfreezeAccount provides a mock function with given fields: ctx, in, opts
+func (_m *SimpleBankClient) UnfreezeAccount(ctx context.Context, in *pb.UnfreezeAccountRequest, opts ...grpc.CallOption) (*pb.UnfreezeAccountResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *pb.UnfreezeAccountResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.UnfreezeAccountRequest, ...grpc.CallOption) (*pb.UnfreezeAccountResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.UnfreezeAccountRequest, ...grpc.CallOption) *pb.UnfreezeAccountResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.UnfreezeAccountResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.UnfreezeAccountRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SimpleBankClient_UnfreezeAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnfreezeAccount'
+type SimpleBankClient_UnfreezeAccount_Call struct {
+	*mock.Call
+}
+
+// UnfreezeAccount is a helper method to define mock.On call
+//  - ctx context.Context
+//  - in *pb.UnfreezeAccountRequest
+//  - opts ...grpc.CallOption
+func (_e *SimpleBankClient_Expecter) UnfreezeAccount(ctx interface{}, in interface{}, opts ...interface{}) *SimpleBankClient_UnfreezeAccount_Call {
+	return &SimpleBankClient_UnfreezeAccount_Call{Call: _e.mock.On("UnfreezeAccount",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *SimpleBankClient_UnfreezeAccount_Call) Run(run func(ctx context.Context, in *pb.UnfreezeAccountRequest, opts ...grpc.CallOption)) *SimpleBankClient_UnfreezeAccount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*pb.UnfreezeAccountRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *SimpleBankClient_UnfreezeAccount_Call) Return(_a0 *pb.UnfreezeAccountResponse, _a1 error) *SimpleBankClient_UnfreezeAccount_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SimpleBankClient_UnfreezeAccount_Call) RunAndReturn(run func(context.Context, *pb.UnfreezeAccountRequest, ...grpc.CallOption) (*pb.UnfreezeAccountResponse, error)) *SimpleBankClient_UnfreezeAccount_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -22,6 +22,281 @@ func (_m *SimpleBankServer) EXPECT() *SimpleBankServer_Expecter {
 	return &SimpleBankServer_Expecter{mock: &_m.Mock}
 }
 
+// CancelScheduledTransfer provides a mock function with given fields: _a0, _a1
+func (_m *SimpleBankServer) CancelScheduledTransfer(_a0 context.Context, _a1 *pb.CancelScheduledTransferRequest) (*pb.CancelScheduledTransferResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *pb.CancelScheduledTransferResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.CancelScheduledTransferRequest) (*pb.CancelScheduledTransferResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.CancelScheduledTransferRequest) *pb.CancelScheduledTransferResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.CancelScheduledTransferResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.CancelScheduledTransferRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SimpleBankServer_CancelScheduledTransfer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelScheduledTransfer'
+type SimpleBankServer_CancelScheduledTransfer_Call struct {
+	*mock.Call
+}
+
+// CancelScheduledTransfer is a helper method to define mock.On call
+//  - _a0 context.Context
+//  - _a1 *pb.CancelScheduledTransferRequest
+func (_e *SimpleBankServer_Expecter) CancelScheduledTransfer(_a0 interface{}, _a1 interface{}) *SimpleBankServer_CancelScheduledTransfer_Call {
+	return &SimpleBankServer_CancelScheduledTransfer_Call{Call: _e.mock.On("CancelScheduledTransfer", _a0, _a1)}
+}
+
+func (_c *SimpleBankServer_CancelScheduledTransfer_Call) Run(run func(_a0 context.Context, _a1 *pb.CancelScheduledTransferRequest)) *SimpleBankServer_CancelScheduledTransfer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*pb.CancelScheduledTransferRequest))
+	})
+	return _c
+}
+
+func (_c *SimpleBankServer_CancelScheduledTransfer_Call) Return(_a0 *pb.CancelScheduledTransferResponse, _a1 error) *SimpleBankServer_CancelScheduledTransfer_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SimpleBankServer_CancelScheduledTransfer_Call) RunAndReturn(run func(context.Context, *pb.CancelScheduledTransferRequest) (*pb.CancelScheduledTransferResponse, error)) *SimpleBankServer_CancelScheduledTransfer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CloseAccount provides a mock function with given fields: _a0, _a1
+func (_m *SimpleBankServer) CloseAccount(_a0 context.Context, _a1 *pb.CloseAccountRequest) (*pb.CloseAccountResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *pb.CloseAccountResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.CloseAccountRequest) (*pb.CloseAccountResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.CloseAccountRequest) *pb.CloseAccountResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.CloseAccountResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.CloseAccountRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SimpleBankServer_CloseAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CloseAccount'
+type SimpleBankServer_CloseAccount_Call struct {
+	*mock.Call
+}
+
+// CloseAccount is a helper method to define mock.On call
+//  - _a0 context.Context
+//  - _a1 *pb.CloseAccountRequest
+func (_e *SimpleBankServer_Expecter) CloseAccount(_a0 interface{}, _a1 interface{}) *SimpleBankServer_CloseAccount_Call {
+	return &SimpleBankServer_CloseAccount_Call{Call: _e.mock.On("CloseAccount", _a0, _a1)}
+}
+
+func (_c *SimpleBankServer_CloseAccount_Call) Run(run func(_a0 context.Context, _a1 *pb.CloseAccountRequest)) *SimpleBankServer_CloseAccount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*pb.CloseAccountRequest))
+	})
+	return _c
+}
+
+func (_c *SimpleBankServer_CloseAccount_Call) Return(_a0 *pb.CloseAccountResponse, _a1 error) *SimpleBankServer_CloseAccount_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SimpleBankServer_CloseAccount_Call) RunAndReturn(run func(context.Context, *pb.CloseAccountRequest) (*pb.CloseAccountResponse, error)) *SimpleBankServer_CloseAccount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateAccount provides a mock function with given fields: _a0, _a1
+func (_m *SimpleBankServer) CreateAccount(_a0 context.Context, _a1 *pb.CreateAccountRequest) (*pb.CreateAccountResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *pb.CreateAccountResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.CreateAccountRequest) (*pb.CreateAccountResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.CreateAccountRequest) *pb.CreateAccountResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.CreateAccountResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.CreateAccountRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SimpleBankServer_CreateAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateAccount'
+type SimpleBankServer_CreateAccount_Call struct {
+	*mock.Call
+}
+
+// CreateAccount is a helper method to define mock.On call
+//  - _a0 context.Context
+//  - _a1 *pb.CreateAccountRequest
+func (_e *SimpleBankServer_Expecter) CreateAccount(_a0 interface{}, _a1 interface{}) *SimpleBankServer_CreateAccount_Call {
+	return &SimpleBankServer_CreateAccount_Call{Call: _e.mock.On("CreateAccount", _a0, _a1)}
+}
+
+func (_c *SimpleBankServer_CreateAccount_Call) Run(run func(_a0 context.Context, _a1 *pb.CreateAccountRequest)) *SimpleBankServer_CreateAccount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*pb.CreateAccountRequest))
+	})
+	return _c
+}
+
+func (_c *SimpleBankServer_CreateAccount_Call) Return(_a0 *pb.CreateAccountResponse, _a1 error) *SimpleBankServer_CreateAccount_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SimpleBankServer_CreateAccount_Call) RunAndReturn(run func(context.Context, *pb.CreateAccountRequest) (*pb.CreateAccountResponse, error)) *SimpleBankServer_CreateAccount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateScheduledTransfer provides a mock function with given fields: _a0, _a1
+func (_m *SimpleBankServer) CreateScheduledTransfer(_a0 context.Context, _a1 *pb.CreateScheduledTransferRequest) (*pb.CreateScheduledTransferResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *pb.CreateScheduledTransferResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.CreateScheduledTransferRequest) (*pb.CreateScheduledTransferResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.CreateScheduledTransferRequest) *pb.CreateScheduledTransferResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.CreateScheduledTransferResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.CreateScheduledTransferRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SimpleBankServer_CreateScheduledTransfer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateScheduledTransfer'
+type SimpleBankServer_CreateScheduledTransfer_Call struct {
+	*mock.Call
+}
+
+// CreateScheduledTransfer is a helper method to define mock.On call
+//  - _a0 context.Context
+//  - _a1 *pb.CreateScheduledTransferRequest
+func (_e *SimpleBankServer_Expecter) CreateScheduledTransfer(_a0 interface{}, _a1 interface{}) *SimpleBankServer_CreateScheduledTransfer_Call {
+	return &SimpleBankServer_CreateScheduledTransfer_Call{Call: _e.mock.On("CreateScheduledTransfer", _a0, _a1)}
+}
+
+func (_c *SimpleBankServer_CreateScheduledTransfer_Call) Run(run func(_a0 context.Context, _a1 *pb.CreateScheduledTransferRequest)) *SimpleBankServer_CreateScheduledTransfer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*pb.CreateScheduledTransferRequest))
+	})
+	return _c
+}
+
+func (_c *SimpleBankServer_CreateScheduledTransfer_Call) Return(_a0 *pb.CreateScheduledTransferResponse, _a1 error) *SimpleBankServer_CreateScheduledTransfer_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SimpleBankServer_CreateScheduledTransfer_Call) RunAndReturn(run func(context.Context, *pb.CreateScheduledTransferRequest) (*pb.CreateScheduledTransferResponse, error)) *SimpleBankServer_CreateScheduledTransfer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateTransfer provides a mock function with given fields: _a0, _a1
+func (_m *SimpleBankServer) CreateTransfer(_a0 context.Context, _a1 *pb.CreateTransferRequest) (*pb.CreateTransferResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *pb.CreateTransferResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.CreateTransferRequest) (*pb.CreateTransferResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.CreateTransferRequest) *pb.CreateTransferResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.CreateTransferResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.CreateTransferRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SimpleBankServer_CreateTransfer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateTransfer'
+type SimpleBankServer_CreateTransfer_Call struct {
+	*mock.Call
+}
+
+// CreateTransfer is a helper method to define mock.On call
+//  - _a0 context.Context
+//  - _a1 *pb.CreateTransferRequest
+func (_e *SimpleBankServer_Expecter) CreateTransfer(_a0 interface{}, _a1 interface{}) *SimpleBankServer_CreateTransfer_Call {
+	return &SimpleBankServer_CreateTransfer_Call{Call: _e.mock.On("CreateTransfer", _a0, _a1)}
+}
+
+func (_c *SimpleBankServer_CreateTransfer_Call) Run(run func(_a0 context.Context, _a1 *pb.CreateTransferRequest)) *SimpleBankServer_CreateTransfer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*pb.CreateTransferRequest))
+	})
+	return _c
+}
+
+func (_c *SimpleBankServer_CreateTransfer_Call) Return(_a0 *pb.CreateTransferResponse, _a1 error) *SimpleBankServer_CreateTransfer_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SimpleBankServer_CreateTransfer_Call) RunAndReturn(run func(context.Context, *pb.CreateTransferRequest) (*pb.CreateTransferResponse, error)) *SimpleBankServer_CreateTransfer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateUser provides a mock function with given fields: _a0, _a1
 func (_m *SimpleBankServer) CreateUser(_a0 context.Context, _a1 *pb.CreateUserRequest) (*pb.CreateUserResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -77,24 +352,24 @@ func (_c *SimpleBankServer_CreateUser_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
-// LoginUser provides a mock function with given fields: _a0, _a1
-func (_m *SimpleBankServer) LoginUser(_a0 context.Context, _a1 *pb.LoginUserRequest) (*pb.LoginUserResponse, error) {
+// DeleteAccount provides a mock function with given fields: _a0, _a1
+func (_m *SimpleBankServer) DeleteAccount(_a0 context.Context, _a1 *pb.DeleteAccountRequest) (*pb.DeleteAccountResponse, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *pb.LoginUserResponse
+	var r0 *pb.DeleteAccountResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *pb.LoginUserRequest) (*pb.LoginUserResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.DeleteAccountRequest) (*pb.DeleteAccountResponse, error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *pb.LoginUserRequest) *pb.LoginUserResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.DeleteAccountRequest) *pb.DeleteAccountResponse); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*pb.LoginUserResponse)
+			r0 = ret.Get(0).(*pb.DeleteAccountResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *pb.LoginUserRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.DeleteAccountRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -103,31 +378,1076 @@ func (_m *SimpleBankServer) LoginUser(_a0 context.Context, _a1 *pb.LoginUserRequ
 	return r0, r1
 }
 
-// SimpleBankServer_LoginUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LoginUser'
-type SimpleBankServer_LoginUser_Call struct {
+// SimpleBankServer_DeleteAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAccount'
+type SimpleBankServer_DeleteAccount_Call struct {
 	*mock.Call
 }
 
-// LoginUser is a helper method to define mock.On call
+// DeleteAccount is a helper method to define mock.On call
 //  - _a0 context.Context
-//  - _a1 *pb.LoginUserRequest
-func (_e *SimpleBankServer_Expecter) LoginUser(_a0 interface{}, _a1 interface{}) *SimpleBankServer_LoginUser_Call {
-	return &SimpleBankServer_LoginUser_Call{Call: _e.mock.On("LoginUser", _a0, _a1)}
+//  - _a1 *pb.DeleteAccountRequest
+func (_e *SimpleBankServer_Expecter) DeleteAccount(_a0 interface{}, _a1 interface{}) *SimpleBankServer_DeleteAccount_Call {
+	return &SimpleBankServer_DeleteAccount_Call{Call: _e.mock.On("DeleteAccount", _a0, _a1)}
 }
 
-func (_c *SimpleBankServer_LoginUser_Call) Run(run func(_a0 context.Context, _a1 *pb.LoginUserRequest)) *SimpleBankServer_LoginUser_Call {
+func (_c *SimpleBankServer_DeleteAccount_Call) Run(run func(_a0 context.Context, _a1 *pb.DeleteAccountRequest)) *SimpleBankServer_DeleteAccount_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*pb.LoginUserRequest))
+		run(args[0].(context.Context), args[1].(*pb.DeleteAccountRequest))
 	})
 	return _c
 }
 
-func (_c *SimpleBankServer_LoginUser_Call) Return(_a0 *pb.LoginUserResponse, _a1 error) *SimpleBankServer_LoginUser_Call {
+func (_c *SimpleBankServer_DeleteAccount_Call) Return(_a0 *pb.DeleteAccountResponse, _a1 error) *SimpleBankServer_DeleteAccount_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *SimpleBankServer_LoginUser_Call) RunAndReturn(run func(context.Context, *pb.LoginUserRequest) (*pb.LoginUserResponse, error)) *SimpleBankServer_LoginUser_Call {
+func (_c *SimpleBankServer_DeleteAccount_Call) RunAndReturn(run func(context.Context, *pb.DeleteAccountRequest) (*pb.DeleteAccountResponse, error)) *SimpleBankServer_DeleteAccount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FreezeAccount provides a mock function with given fields: _a0, _a1
+func (_m *SimpleBankServer) FreezeAccount(_a0 context.Context, _a1 *pb.FreezeAccountRequest) (*pb.FreezeAccountResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *pb.FreezeAccountResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.FreezeAccountRequest) (*pb.FreezeAccountResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.FreezeAccountRequest) *pb.FreezeAccountResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.FreezeAccountResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.FreezeAccountRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SimpleBankServer_FreezeAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FreezeAccount'
+type SimpleBankServer_FreezeAccount_Call struct {
+	*mock.Call
+}
+
+// FreezeAccount is a helper method to define mock.On call
+//  - _a0 context.Context
+//  - _a1 *pb.FreezeAccountRequest
+func (_e *SimpleBankServer_Expecter) FreezeAccount(_a0 interface{}, _a1 interface{}) *SimpleBankServer_FreezeAccount_Call {
+	return &SimpleBankServer_FreezeAccount_Call{Call: _e.mock.On("FreezeAccount", _a0, _a1)}
+}
+
+func (_c *SimpleBankServer_FreezeAccount_Call) Run(run func(_a0 context.Context, _a1 *pb.FreezeAccountRequest)) *SimpleBankServer_FreezeAccount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*pb.FreezeAccountRequest))
+	})
+	return _c
+}
+
+func (_c *SimpleBankServer_FreezeAccount_Call) Return(_a0 *pb.FreezeAccountResponse, _a1 error) *SimpleBankServer_FreezeAccount_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SimpleBankServer_FreezeAccount_Call) RunAndReturn(run func(context.Context, *pb.FreezeAccountRequest) (*pb.FreezeAccountResponse, error)) *SimpleBankServer_FreezeAccount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAccount provides a mock function with given fields: _a0, _a1
+func (_m *SimpleBankServer) GetAccount(_a0 context.Context, _a1 *pb.GetAccountRequest) (*pb.GetAccountResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *pb.GetAccountResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.GetAccountRequest) (*pb.GetAccountResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.GetAccountRequest) *pb.GetAccountResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.GetAccountResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.GetAccountRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SimpleBankServer_GetAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAccount'
+type SimpleBankServer_GetAccount_Call struct {
+	*mock.Call
+}
+
+// GetAccount is a helper method to define mock.On call
+//  - _a0 context.Context
+//  - _a1 *pb.GetAccountRequest
+func (_e *SimpleBankServer_Expecter) GetAccount(_a0 interface{}, _a1 interface{}) *SimpleBankServer_GetAccount_Call {
+	return &SimpleBankServer_GetAccount_Call{Call: _e.mock.On("GetAccount", _a0, _a1)}
+}
+
+func (_c *SimpleBankServer_GetAccount_Call) Run(run func(_a0 context.Context, _a1 *pb.GetAccountRequest)) *SimpleBankServer_GetAccount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*pb.GetAccountRequest))
+	})
+	return _c
+}
+
+func (_c *SimpleBankServer_GetAccount_Call) Return(_a0 *pb.GetAccountResponse, _a1 error) *SimpleBankServer_GetAccount_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SimpleBankServer_GetAccount_Call) RunAndReturn(run func(context.Context, *pb.GetAccountRequest) (*pb.GetAccountResponse, error)) *SimpleBankServer_GetAccount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetStatement provides a mock function with given fields: _a0, _a1
+func (_m *SimpleBankServer) GetStatement(_a0 context.Context, _a1 *pb.GetStatementRequest) (*pb.GetStatementResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *pb.GetStatementResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.GetStatementRequest) (*pb.GetStatementResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.GetStatementRequest) *pb.GetStatementResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.GetStatementResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.GetStatementRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SimpleBankServer_GetStatement_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStatement'
+type SimpleBankServer_GetStatement_Call struct {
+	*mock.Call
+}
+
+// GetStatement is a helper method to define mock.On call
+//  - _a0 context.Context
+//  - _a1 *pb.GetStatementRequest
+func (_e *SimpleBankServer_Expecter) GetStatement(_a0 interface{}, _a1 interface{}) *SimpleBankServer_GetStatement_Call {
+	return &SimpleBankServer_GetStatement_Call{Call: _e.mock.On("GetStatement", _a0, _a1)}
+}
+
+func (_c *SimpleBankServer_GetStatement_Call) Run(run func(_a0 context.Context, _a1 *pb.GetStatementRequest)) *SimpleBankServer_GetStatement_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*pb.GetStatementRequest))
+	})
+	return _c
+}
+
+func (_c *SimpleBankServer_GetStatement_Call) Return(_a0 *pb.GetStatementResponse, _a1 error) *SimpleBankServer_GetStatement_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SimpleBankServer_GetStatement_Call) RunAndReturn(run func(context.Context, *pb.GetStatementRequest) (*pb.GetStatementResponse, error)) *SimpleBankServer_GetStatement_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListAccountTransfers provides a mock function with given fields: _a0, _a1
+func (_m *SimpleBankServer) ListAccountTransfers(_a0 context.Context, _a1 *pb.ListAccountTransfersRequest) (*pb.ListAccountTransfersResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *pb.ListAccountTransfersResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.ListAccountTransfersRequest) (*pb.ListAccountTransfersResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.ListAccountTransfersRequest) *pb.ListAccountTransfersResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.ListAccountTransfersResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.ListAccountTransfersRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SimpleBankServer_ListAccountTransfers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListAccountTransfers'
+type SimpleBankServer_ListAccountTransfers_Call struct {
+	*mock.Call
+}
+
+// ListAccountTransfers is a helper method to define mock.On call
+//  - _a0 context.Context
+//  - _a1 *pb.ListAccountTransfersRequest
+func (_e *SimpleBankServer_Expecter) ListAccountTransfers(_a0 interface{}, _a1 interface{}) *SimpleBankServer_ListAccountTransfers_Call {
+	return &SimpleBankServer_ListAccountTransfers_Call{Call: _e.mock.On("ListAccountTransfers", _a0, _a1)}
+}
+
+func (_c *SimpleBankServer_ListAccountTransfers_Call) Run(run func(_a0 context.Context, _a1 *pb.ListAccountTransfersRequest)) *SimpleBankServer_ListAccountTransfers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*pb.ListAccountTransfersRequest))
+	})
+	return _c
+}
+
+func (_c *SimpleBankServer_ListAccountTransfers_Call) Return(_a0 *pb.ListAccountTransfersResponse, _a1 error) *SimpleBankServer_ListAccountTransfers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SimpleBankServer_ListAccountTransfers_Call) RunAndReturn(run func(context.Context, *pb.ListAccountTransfersRequest) (*pb.ListAccountTransfersResponse, error)) *SimpleBankServer_ListAccountTransfers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListAccounts provides a mock function with given fields: _a0, _a1
+func (_m *SimpleBankServer) ListAccounts(_a0 context.Context, _a1 *pb.ListAccountsRequest) (*pb.ListAccountsResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *pb.ListAccountsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.ListAccountsRequest) (*pb.ListAccountsResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.ListAccountsRequest) *pb.ListAccountsResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.ListAccountsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.ListAccountsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SimpleBankServer_ListAccounts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListAccounts'
+type SimpleBankServer_ListAccounts_Call struct {
+	*mock.Call
+}
+
+// ListAccounts is a helper method to define mock.On call
+//  - _a0 context.Context
+//  - _a1 *pb.ListAccountsRequest
+func (_e *SimpleBankServer_Expecter) ListAccounts(_a0 interface{}, _a1 interface{}) *SimpleBankServer_ListAccounts_Call {
+	return &SimpleBankServer_ListAccounts_Call{Call: _e.mock.On("ListAccounts", _a0, _a1)}
+}
+
+func (_c *SimpleBankServer_ListAccounts_Call) Run(run func(_a0 context.Context, _a1 *pb.ListAccountsRequest)) *SimpleBankServer_ListAccounts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*pb.ListAccountsRequest))
+	})
+	return _c
+}
+
+func (_c *SimpleBankServer_ListAccounts_Call) Return(_a0 *pb.ListAccountsResponse, _a1 error) *SimpleBankServer_ListAccounts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SimpleBankServer_ListAccounts_Call) RunAndReturn(run func(context.Context, *pb.ListAccountsRequest) (*pb.ListAccountsResponse, error)) *SimpleBankServer_ListAccounts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListEntries provides a mock function with given fields: _a0, _a1
+func (_m *SimpleBankServer) ListEntries(_a0 context.Context, _a1 *pb.ListEntriesRequest) (*pb.ListEntriesResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *pb.ListEntriesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.ListEntriesRequest) (*pb.ListEntriesResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.ListEntriesRequest) *pb.ListEntriesResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.ListEntriesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.ListEntriesRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SimpleBankServer_ListEntries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListEntries'
+type SimpleBankServer_ListEntries_Call struct {
+	*mock.Call
+}
+
+// ListEntries is a helper method to define mock.On call
+//  - _a0 context.Context
+//  - _a1 *pb.ListEntriesRequest
+func (_e *SimpleBankServer_Expecter) ListEntries(_a0 interface{}, _a1 interface{}) *SimpleBankServer_ListEntries_Call {
+	return &SimpleBankServer_ListEntries_Call{Call: _e.mock.On("ListEntries", _a0, _a1)}
+}
+
+func (_c *SimpleBankServer_ListEntries_Call) Run(run func(_a0 context.Context, _a1 *pb.ListEntriesRequest)) *SimpleBankServer_ListEntries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*pb.ListEntriesRequest))
+	})
+	return _c
+}
+
+func (_c *SimpleBankServer_ListEntries_Call) Return(_a0 *pb.ListEntriesResponse, _a1 error) *SimpleBankServer_ListEntries_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SimpleBankServer_ListEntries_Call) RunAndReturn(run func(context.Context, *pb.ListEntriesRequest) (*pb.ListEntriesResponse, error)) *SimpleBankServer_ListEntries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListMyTransfers provides a mock function with given fields: _a0, _a1
+func (_m *SimpleBankServer) ListMyTransfers(_a0 context.Context, _a1 *pb.ListMyTransfersRequest) (*pb.ListMyTransfersResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *pb.ListMyTransfersResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.ListMyTransfersRequest) (*pb.ListMyTransfersResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.ListMyTransfersRequest) *pb.ListMyTransfersResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.ListMyTransfersResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.ListMyTransfersRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SimpleBankServer_ListMyTransfers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListMyTransfers'
+type SimpleBankServer_ListMyTransfers_Call struct {
+	*mock.Call
+}
+
+// ListMyTransfers is a helper method to define mock.On call
+//  - _a0 context.Context
+//  - _a1 *pb.ListMyTransfersRequest
+func (_e *SimpleBankServer_Expecter) ListMyTransfers(_a0 interface{}, _a1 interface{}) *SimpleBankServer_ListMyTransfers_Call {
+	return &SimpleBankServer_ListMyTransfers_Call{Call: _e.mock.On("ListMyTransfers", _a0, _a1)}
+}
+
+func (_c *SimpleBankServer_ListMyTransfers_Call) Run(run func(_a0 context.Context, _a1 *pb.ListMyTransfersRequest)) *SimpleBankServer_ListMyTransfers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*pb.ListMyTransfersRequest))
+	})
+	return _c
+}
+
+func (_c *SimpleBankServer_ListMyTransfers_Call) Return(_a0 *pb.ListMyTransfersResponse, _a1 error) *SimpleBankServer_ListMyTransfers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SimpleBankServer_ListMyTransfers_Call) RunAndReturn(run func(context.Context, *pb.ListMyTransfersRequest) (*pb.ListMyTransfersResponse, error)) *SimpleBankServer_ListMyTransfers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListReconciliationReports provides a mock function with given fields: _a0, _a1
+func (_m *SimpleBankServer) ListReconciliationReports(_a0 context.Context, _a1 *pb.ListReconciliationReportsRequest) (*pb.ListReconciliationReportsResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *pb.ListReconciliationReportsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.ListReconciliationReportsRequest) (*pb.ListReconciliationReportsResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.ListReconciliationReportsRequest) *pb.ListReconciliationReportsResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.ListReconciliationReportsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.ListReconciliationReportsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SimpleBankServer_ListReconciliationReports_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListReconciliationReports'
+type SimpleBankServer_ListReconciliationReports_Call struct {
+	*mock.Call
+}
+
+// ListReconciliationReports is a helper method to define mock.On call
+//  - _a0 context.Context
+//  - _a1 *pb.ListReconciliationReportsRequest
+func (_e *SimpleBankServer_Expecter) ListReconciliationReports(_a0 interface{}, _a1 interface{}) *SimpleBankServer_ListReconciliationReports_Call {
+	return &SimpleBankServer_ListReconciliationReports_Call{Call: _e.mock.On("ListReconciliationReports", _a0, _a1)}
+}
+
+func (_c *SimpleBankServer_ListReconciliationReports_Call) Run(run func(_a0 context.Context, _a1 *pb.ListReconciliationReportsRequest)) *SimpleBankServer_ListReconciliationReports_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*pb.ListReconciliationReportsRequest))
+	})
+	return _c
+}
+
+func (_c *SimpleBankServer_ListReconciliationReports_Call) Return(_a0 *pb.ListReconciliationReportsResponse, _a1 error) *SimpleBankServer_ListReconciliationReports_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SimpleBankServer_ListReconciliationReports_Call) RunAndReturn(run func(context.Context, *pb.ListReconciliationReportsRequest) (*pb.ListReconciliationReportsResponse, error)) *SimpleBankServer_ListReconciliationReports_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListScheduledTransferRuns provides a mock function with given fields: _a0, _a1
+func (_m *SimpleBankServer) ListScheduledTransferRuns(_a0 context.Context, _a1 *pb.ListScheduledTransferRunsRequest) (*pb.ListScheduledTransferRunsResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *pb.ListScheduledTransferRunsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.ListScheduledTransferRunsRequest) (*pb.ListScheduledTransferRunsResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.ListScheduledTransferRunsRequest) *pb.ListScheduledTransferRunsResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.ListScheduledTransferRunsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.ListScheduledTransferRunsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SimpleBankServer_ListScheduledTransferRuns_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListScheduledTransferRuns'
+type SimpleBankServer_ListScheduledTransferRuns_Call struct {
+	*mock.Call
+}
+
+// ListScheduledTransferRuns is a helper method to define mock.On call
+//  - _a0 context.Context
+//  - _a1 *pb.ListScheduledTransferRunsRequest
+func (_e *SimpleBankServer_Expecter) ListScheduledTransferRuns(_a0 interface{}, _a1 interface{}) *SimpleBankServer_ListScheduledTransferRuns_Call {
+	return &SimpleBankServer_ListScheduledTransferRuns_Call{Call: _e.mock.On("ListScheduledTransferRuns", _a0, _a1)}
+}
+
+func (_c *SimpleBankServer_ListScheduledTransferRuns_Call) Run(run func(_a0 context.Context, _a1 *pb.ListScheduledTransferRunsRequest)) *SimpleBankServer_ListScheduledTransferRuns_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*pb.ListScheduledTransferRunsRequest))
+	})
+	return _c
+}
+
+func (_c *SimpleBankServer_ListScheduledTransferRuns_Call) Return(_a0 *pb.ListScheduledTransferRunsResponse, _a1 error) *SimpleBankServer_ListScheduledTransferRuns_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SimpleBankServer_ListScheduledTransferRuns_Call) RunAndReturn(run func(context.Context, *pb.ListScheduledTransferRunsRequest) (*pb.ListScheduledTransferRunsResponse, error)) *SimpleBankServer_ListScheduledTransferRuns_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListScheduledTransfers provides a mock function with given fields: _a0, _a1
+func (_m *SimpleBankServer) ListScheduledTransfers(_a0 context.Context, _a1 *pb.ListScheduledTransfersRequest) (*pb.ListScheduledTransfersResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *pb.ListScheduledTransfersResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.ListScheduledTransfersRequest) (*pb.ListScheduledTransfersResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.ListScheduledTransfersRequest) *pb.ListScheduledTransfersResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.ListScheduledTransfersResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.ListScheduledTransfersRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SimpleBankServer_ListScheduledTransfers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListScheduledTransfers'
+type SimpleBankServer_ListScheduledTransfers_Call struct {
+	*mock.Call
+}
+
+// ListScheduledTransfers is a helper method to define mock.On call
+//  - _a0 context.Context
+//  - _a1 *pb.ListScheduledTransfersRequest
+func (_e *SimpleBankServer_Expecter) ListScheduledTransfers(_a0 interface{}, _a1 interface{}) *SimpleBankServer_ListScheduledTransfers_Call {
+	return &SimpleBankServer_ListScheduledTransfers_Call{Call: _e.mock.On("ListScheduledTransfers", _a0, _a1)}
+}
+
+func (_c *SimpleBankServer_ListScheduledTransfers_Call) Run(run func(_a0 context.Context, _a1 *pb.ListScheduledTransfersRequest)) *SimpleBankServer_ListScheduledTransfers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*pb.ListScheduledTransfersRequest))
+	})
+	return _c
+}
+
+func (_c *SimpleBankServer_ListScheduledTransfers_Call) Return(_a0 *pb.ListScheduledTransfersResponse, _a1 error) *SimpleBankServer_ListScheduledTransfers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SimpleBankServer_ListScheduledTransfers_Call) RunAndReturn(run func(context.Context, *pb.ListScheduledTransfersRequest) (*pb.ListScheduledTransfersResponse, error)) *SimpleBankServer_ListScheduledTransfers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LoginUser provides a mock function with given fields: _a0, _a1
+func (_m *SimpleBankServer) LoginUser(_a0 context.Context, _a1 *pb.LoginUserRequest) (*pb.LoginUserResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *pb.LoginUserResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.LoginUserRequest) (*pb.LoginUserResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.LoginUserRequest) *pb.LoginUserResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.LoginUserResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.LoginUserRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SimpleBankServer_LoginUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LoginUser'
+type SimpleBankServer_LoginUser_Call struct {
+	*mock.Call
+}
+
+// LoginUser is a helper method to define mock.On call
+//  - _a0 context.Context
+//  - _a1 *pb.LoginUserRequest
+func (_e *SimpleBankServer_Expecter) LoginUser(_a0 interface{}, _a1 interface{}) *SimpleBankServer_LoginUser_Call {
+	return &SimpleBankServer_LoginUser_Call{Call: _e.mock.On("LoginUser", _a0, _a1)}
+}
+
+func (_c *SimpleBankServer_LoginUser_Call) Run(run func(_a0 context.Context, _a1 *pb.LoginUserRequest)) *SimpleBankServer_LoginUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*pb.LoginUserRequest))
+	})
+	return _c
+}
+
+func (_c *SimpleBankServer_LoginUser_Call) Return(_a0 *pb.LoginUserResponse, _a1 error) *SimpleBankServer_LoginUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SimpleBankServer_LoginUser_Call) RunAndReturn(run func(context.Context, *pb.LoginUserRequest) (*pb.LoginUserResponse, error)) *SimpleBankServer_LoginUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Logout provides a mock function with given fields: _a0, _a1
+func (_m *SimpleBankServer) Logout(_a0 context.Context, _a1 *pb.LogoutRequest) (*pb.LogoutResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *pb.LogoutResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.LogoutRequest) (*pb.LogoutResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.LogoutRequest) *pb.LogoutResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.LogoutResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.LogoutRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SimpleBankServer_Logout_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Logout'
+type SimpleBankServer_Logout_Call struct {
+	*mock.Call
+}
+
+// Logout is a helper method to define mock.On call
+//  - _a0 context.Context
+//  - _a1 *pb.LogoutRequest
+func (_e *SimpleBankServer_Expecter) Logout(_a0 interface{}, _a1 interface{}) *SimpleBankServer_Logout_Call {
+	return &SimpleBankServer_Logout_Call{Call: _e.mock.On("Logout", _a0, _a1)}
+}
+
+func (_c *SimpleBankServer_Logout_Call) Run(run func(_a0 context.Context, _a1 *pb.LogoutRequest)) *SimpleBankServer_Logout_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*pb.LogoutRequest))
+	})
+	return _c
+}
+
+func (_c *SimpleBankServer_Logout_Call) Return(_a0 *pb.LogoutResponse, _a1 error) *SimpleBankServer_Logout_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SimpleBankServer_Logout_Call) RunAndReturn(run func(context.Context, *pb.LogoutRequest) (*pb.LogoutResponse, error)) *SimpleBankServer_Logout_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LogoutAllSessions provides a mock function with given fields: _a0, _a1
+func (_m *SimpleBankServer) LogoutAllSessions(_a0 context.Context, _a1 *pb.LogoutAllSessionsRequest) (*pb.LogoutAllSessionsResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *pb.LogoutAllSessionsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.LogoutAllSessionsRequest) (*pb.LogoutAllSessionsResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.LogoutAllSessionsRequest) *pb.LogoutAllSessionsResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.LogoutAllSessionsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.LogoutAllSessionsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SimpleBankServer_LogoutAllSessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LogoutAllSessions'
+type SimpleBankServer_LogoutAllSessions_Call struct {
+	*mock.Call
+}
+
+// LogoutAllSessions is a helper method to define mock.On call
+//  - _a0 context.Context
+//  - _a1 *pb.LogoutAllSessionsRequest
+func (_e *SimpleBankServer_Expecter) LogoutAllSessions(_a0 interface{}, _a1 interface{}) *SimpleBankServer_LogoutAllSessions_Call {
+	return &SimpleBankServer_LogoutAllSessions_Call{Call: _e.mock.On("LogoutAllSessions", _a0, _a1)}
+}
+
+func (_c *SimpleBankServer_LogoutAllSessions_Call) Run(run func(_a0 context.Context, _a1 *pb.LogoutAllSessionsRequest)) *SimpleBankServer_LogoutAllSessions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*pb.LogoutAllSessionsRequest))
+	})
+	return _c
+}
+
+func (_c *SimpleBankServer_LogoutAllSessions_Call) Return(_a0 *pb.LogoutAllSessionsResponse, _a1 error) *SimpleBankServer_LogoutAllSessions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SimpleBankServer_LogoutAllSessions_Call) RunAndReturn(run func(context.Context, *pb.LogoutAllSessionsRequest) (*pb.LogoutAllSessionsResponse, error)) *SimpleBankServer_LogoutAllSessions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PauseScheduledTransfer provides a mock function with given fields: _a0, _a1
+func (_m *SimpleBankServer) PauseScheduledTransfer(_a0 context.Context, _a1 *pb.PauseScheduledTransferRequest) (*pb.PauseScheduledTransferResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *pb.PauseScheduledTransferResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.PauseScheduledTransferRequest) (*pb.PauseScheduledTransferResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.PauseScheduledTransferRequest) *pb.PauseScheduledTransferResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.PauseScheduledTransferResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.PauseScheduledTransferRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SimpleBankServer_PauseScheduledTransfer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PauseScheduledTransfer'
+type SimpleBankServer_PauseScheduledTransfer_Call struct {
+	*mock.Call
+}
+
+// PauseScheduledTransfer is a helper method to define mock.On call
+//  - _a0 context.Context
+//  - _a1 *pb.PauseScheduledTransferRequest
+func (_e *SimpleBankServer_Expecter) PauseScheduledTransfer(_a0 interface{}, _a1 interface{}) *SimpleBankServer_PauseScheduledTransfer_Call {
+	return &SimpleBankServer_PauseScheduledTransfer_Call{Call: _e.mock.On("PauseScheduledTransfer", _a0, _a1)}
+}
+
+func (_c *SimpleBankServer_PauseScheduledTransfer_Call) Run(run func(_a0 context.Context, _a1 *pb.PauseScheduledTransferRequest)) *SimpleBankServer_PauseScheduledTransfer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*pb.PauseScheduledTransferRequest))
+	})
+	return _c
+}
+
+func (_c *SimpleBankServer_PauseScheduledTransfer_Call) Return(_a0 *pb.PauseScheduledTransferResponse, _a1 error) *SimpleBankServer_PauseScheduledTransfer_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SimpleBankServer_PauseScheduledTransfer_Call) RunAndReturn(run func(context.Context, *pb.PauseScheduledTransferRequest) (*pb.PauseScheduledTransferResponse, error)) *SimpleBankServer_PauseScheduledTransfer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RenewAccessToken provides a mock function with given fields: _a0, _a1
+func (_m *SimpleBankServer) RenewAccessToken(_a0 context.Context, _a1 *pb.RenewAccessTokenRequest) (*pb.RenewAccessTokenResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *pb.RenewAccessTokenResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.RenewAccessTokenRequest) (*pb.RenewAccessTokenResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.RenewAccessTokenRequest) *pb.RenewAccessTokenResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.RenewAccessTokenResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.RenewAccessTokenRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SimpleBankServer_RenewAccessToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RenewAccessToken'
+type SimpleBankServer_RenewAccessToken_Call struct {
+	*mock.Call
+}
+
+// RenewAccessToken is a helper method to define mock.On call
+//  - _a0 context.Context
+//  - _a1 *pb.RenewAccessTokenRequest
+func (_e *SimpleBankServer_Expecter) RenewAccessToken(_a0 interface{}, _a1 interface{}) *SimpleBankServer_RenewAccessToken_Call {
+	return &SimpleBankServer_RenewAccessToken_Call{Call: _e.mock.On("RenewAccessToken", _a0, _a1)}
+}
+
+func (_c *SimpleBankServer_RenewAccessToken_Call) Run(run func(_a0 context.Context, _a1 *pb.RenewAccessTokenRequest)) *SimpleBankServer_RenewAccessToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*pb.RenewAccessTokenRequest))
+	})
+	return _c
+}
+
+func (_c *SimpleBankServer_RenewAccessToken_Call) Return(_a0 *pb.RenewAccessTokenResponse, _a1 error) *SimpleBankServer_RenewAccessToken_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SimpleBankServer_RenewAccessToken_Call) RunAndReturn(run func(context.Context, *pb.RenewAccessTokenRequest) (*pb.RenewAccessTokenResponse, error)) *SimpleBankServer_RenewAccessToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReopenAccount provides a mock function with given fields: _a0, _a1
+func (_m *SimpleBankServer) ReopenAccount(_a0 context.Context, _a1 *pb.ReopenAccountRequest) (*pb.ReopenAccountResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *pb.ReopenAccountResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.ReopenAccountRequest) (*pb.ReopenAccountResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.ReopenAccountRequest) *pb.ReopenAccountResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.ReopenAccountResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.ReopenAccountRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SimpleBankServer_ReopenAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReopenAccount'
+type SimpleBankServer_ReopenAccount_Call struct {
+	*mock.Call
+}
+
+// ReopenAccount is a helper method to define mock.On call
+//  - _a0 context.Context
+//  - _a1 *pb.ReopenAccountRequest
+func (_e *SimpleBankServer_Expecter) ReopenAccount(_a0 interface{}, _a1 interface{}) *SimpleBankServer_ReopenAccount_Call {
+	return &SimpleBankServer_ReopenAccount_Call{Call: _e.mock.On("ReopenAccount", _a0, _a1)}
+}
+
+func (_c *SimpleBankServer_ReopenAccount_Call) Run(run func(_a0 context.Context, _a1 *pb.ReopenAccountRequest)) *SimpleBankServer_ReopenAccount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*pb.ReopenAccountRequest))
+	})
+	return _c
+}
+
+func (_c *SimpleBankServer_ReopenAccount_Call) Return(_a0 *pb.ReopenAccountResponse, _a1 error) *SimpleBankServer_ReopenAccount_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SimpleBankServer_ReopenAccount_Call) RunAndReturn(run func(context.Context, *pb.ReopenAccountRequest) (*pb.ReopenAccountResponse, error)) *SimpleBankServer_ReopenAccount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResumeScheduledTransfer provides a mock function with given fields: _a0, _a1
+func (_m *SimpleBankServer) ResumeScheduledTransfer(_a0 context.Context, _a1 *pb.ResumeScheduledTransferRequest) (*pb.ResumeScheduledTransferResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *pb.ResumeScheduledTransferResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.ResumeScheduledTransferRequest) (*pb.ResumeScheduledTransferResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.ResumeScheduledTransferRequest) *pb.ResumeScheduledTransferResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.ResumeScheduledTransferResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.ResumeScheduledTransferRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SimpleBankServer_ResumeScheduledTransfer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResumeScheduledTransfer'
+type SimpleBankServer_ResumeScheduledTransfer_Call struct {
+	*mock.Call
+}
+
+// ResumeScheduledTransfer is a helper method to define mock.On call
+//  - _a0 context.Context
+//  - _a1 *pb.ResumeScheduledTransferRequest
+func (_e *SimpleBankServer_Expecter) ResumeScheduledTransfer(_a0 interface{}, _a1 interface{}) *SimpleBankServer_ResumeScheduledTransfer_Call {
+	return &SimpleBankServer_ResumeScheduledTransfer_Call{Call: _e.mock.On("ResumeScheduledTransfer", _a0, _a1)}
+}
+
+func (_c *SimpleBankServer_ResumeScheduledTransfer_Call) Run(run func(_a0 context.Context, _a1 *pb.ResumeScheduledTransferRequest)) *SimpleBankServer_ResumeScheduledTransfer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*pb.ResumeScheduledTransferRequest))
+	})
+	return _c
+}
+
+func (_c *SimpleBankServer_ResumeScheduledTransfer_Call) Return(_a0 *pb.ResumeScheduledTransferResponse, _a1 error) *SimpleBankServer_ResumeScheduledTransfer_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SimpleBankServer_ResumeScheduledTransfer_Call) RunAndReturn(run func(context.Context, *pb.ResumeScheduledTransferRequest) (*pb.ResumeScheduledTransferResponse, error)) *SimpleBankServer_ResumeScheduledTransfer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReverseTransfer provides a mock function with given fields: _a0, _a1
+func (_m *SimpleBankServer) ReverseTransfer(_a0 context.Context, _a1 *pb.ReverseTransferRequest) (*pb.ReverseTransferResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *pb.ReverseTransferResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.ReverseTransferRequest) (*pb.ReverseTransferResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.ReverseTransferRequest) *pb.ReverseTransferResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.ReverseTransferResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.ReverseTransferRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SimpleBankServer_ReverseTransfer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReverseTransfer'
+type SimpleBankServer_ReverseTransfer_Call struct {
+	*mock.Call
+}
+
+// ReverseTransfer is a helper method to define mock.On call
+//  - _a0 context.Context
+//  - _a1 *pb.ReverseTransferRequest
+func (_e *SimpleBankServer_Expecter) ReverseTransfer(_a0 interface{}, _a1 interface{}) *SimpleBankServer_ReverseTransfer_Call {
+	return &SimpleBankServer_ReverseTransfer_Call{Call: _e.mock.On("ReverseTransfer", _a0, _a1)}
+}
+
+func (_c *SimpleBankServer_ReverseTransfer_Call) Run(run func(_a0 context.Context, _a1 *pb.ReverseTransferRequest)) *SimpleBankServer_ReverseTransfer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*pb.ReverseTransferRequest))
+	})
+	return _c
+}
+
+func (_c *SimpleBankServer_ReverseTransfer_Call) Return(_a0 *pb.ReverseTransferResponse, _a1 error) *SimpleBankServer_ReverseTransfer_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SimpleBankServer_ReverseTransfer_Call) RunAndReturn(run func(context.Context, *pb.ReverseTransferRequest) (*pb.ReverseTransferResponse, error)) *SimpleBankServer_ReverseTransfer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UnfreezeAccount provides a mock function with given fields: _a0, _a1
+func (_m *SimpleBankServer) UnfreezeAccount(_a0 context.Context, _a1 *pb.UnfreezeAccountRequest) (*pb.UnfreezeAccountResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *pb.UnfreezeAccountResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.UnfreezeAccountRequest) (*pb.UnfreezeAccountResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.UnfreezeAccountRequest) *pb.UnfreezeAccountResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.UnfreezeAccountResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.UnfreezeAccountRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SimpleBankServer_UnfreezeAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnfreezeAccount'
+type SimpleBankServer_UnfreezeAccount_Call struct {
+	*mock.Call
+}
+
+// UnfreezeAccount is a helper method to define mock.On call
+//  - _a0 context.Context
+//  - _a1 *pb.UnfreezeAccountRequest
+func (_e *SimpleBankServer_Expecter) UnfreezeAccount(_a0 interface{}, _a1 interface{}) *SimpleBankServer_UnfreezeAccount_Call {
+	return &SimpleBankServer_UnfreezeAccount_Call{Call: _e.mock.On("UnfreezeAccount", _a0, _a1)}
+}
+
+func (_c *SimpleBankServer_UnfreezeAccount_Call) Run(run func(_a0 context.Context, _a1 *pb.UnfreezeAccountRequest)) *SimpleBankServer_UnfreezeAccount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*pb.UnfreezeAccountRequest))
+	})
+	return _c
+}
+
+func (_c *SimpleBankServer_UnfreezeAccount_Call) Return(_a0 *pb.UnfreezeAccountResponse, _a1 error) *SimpleBankServer_UnfreezeAccount_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SimpleBankServer_UnfreezeAccount_Call) RunAndReturn(run func(context.Context, *pb.UnfreezeAccountRequest) (*pb.UnfreezeAccountResponse, error)) *SimpleBankServer_UnfreezeAccount_Call {
 	_c.Call.Return(run)
 	return _c
 }
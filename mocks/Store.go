@@ -8,6 +8,8 @@ import (
 	mock "github.com/stretchr/testify/mock"
 	db "github.com/tgfukuda/be-master/db/sqlc"
 
+	sql "database/sql"
+
 	uuid "github.com/google/uuid"
 )
 
@@ -77,23 +79,23 @@ func (_c *Store_AddAccountBalance_Call) RunAndReturn(run func(context.Context, d
 	return _c
 }
 
-// CreateAccount provides a mock function with given fields: ctx, arg
-func (_m *Store) CreateAccount(ctx context.Context, arg db.CreateAccountParams) (db.Account, error) {
-	ret := _m.Called(ctx, arg)
+// BlockSessionFamily provides a mock function with given fields: ctx, familyID
+func (_m *Store) BlockSessionFamily(ctx context.Context, familyID uuid.UUID) (int64, error) {
+	ret := _m.Called(ctx, familyID)
 
-	var r0 db.Account
+	var r0 int64
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, db.CreateAccountParams) (db.Account, error)); ok {
-		return rf(ctx, arg)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (int64, error)); ok {
+		return rf(ctx, familyID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, db.CreateAccountParams) db.Account); ok {
-		r0 = rf(ctx, arg)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) int64); ok {
+		r0 = rf(ctx, familyID)
 	} else {
-		r0 = ret.Get(0).(db.Account)
+		r0 = ret.Get(0).(int64)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, db.CreateAccountParams) error); ok {
-		r1 = rf(ctx, arg)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, familyID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -101,52 +103,52 @@ func (_m *Store) CreateAccount(ctx context.Context, arg db.CreateAccountParams)
 	return r0, r1
 }
 
-// Store_CreateAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateAccount'
-type Store_CreateAccount_Call struct {
+// Store_BlockSessionFamily_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BlockSessionFamily'
+type Store_BlockSessionFamily_Call struct {
 	*mock.Call
 }
 
-// CreateAccount is a helper method to define mock.On call
+// BlockSessionFamily is a helper method to define mock.On call
 //  - ctx context.Context
-//  - arg db.CreateAccountParams
-func (_e *Store_Expecter) CreateAccount(ctx interface{}, arg interface{}) *Store_CreateAccount_Call {
-	return &Store_CreateAccount_Call{Call: _e.mock.On("CreateAccount", ctx, arg)}
+//  - familyID uuid.UUID
+func (_e *Store_Expecter) BlockSessionFamily(ctx interface{}, familyID interface{}) *Store_BlockSessionFamily_Call {
+	return &Store_BlockSessionFamily_Call{Call: _e.mock.On("BlockSessionFamily", ctx, familyID)}
 }
 
-func (_c *Store_CreateAccount_Call) Run(run func(ctx context.Context, arg db.CreateAccountParams)) *Store_CreateAccount_Call {
+func (_c *Store_BlockSessionFamily_Call) Run(run func(ctx context.Context, familyID uuid.UUID)) *Store_BlockSessionFamily_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(db.CreateAccountParams))
+		run(args[0].(context.Context), args[1].(uuid.UUID))
 	})
 	return _c
 }
 
-func (_c *Store_CreateAccount_Call) Return(_a0 db.Account, _a1 error) *Store_CreateAccount_Call {
+func (_c *Store_BlockSessionFamily_Call) Return(_a0 int64, _a1 error) *Store_BlockSessionFamily_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *Store_CreateAccount_Call) RunAndReturn(run func(context.Context, db.CreateAccountParams) (db.Account, error)) *Store_CreateAccount_Call {
+func (_c *Store_BlockSessionFamily_Call) RunAndReturn(run func(context.Context, uuid.UUID) (int64, error)) *Store_BlockSessionFamily_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateEntry provides a mock function with given fields: ctx, arg
-func (_m *Store) CreateEntry(ctx context.Context, arg db.CreateEntryParams) (db.Entry, error) {
-	ret := _m.Called(ctx, arg)
+// BlockUserSessions provides a mock function with given fields: ctx, username
+func (_m *Store) BlockUserSessions(ctx context.Context, username string) (int64, error) {
+	ret := _m.Called(ctx, username)
 
-	var r0 db.Entry
+	var r0 int64
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, db.CreateEntryParams) (db.Entry, error)); ok {
-		return rf(ctx, arg)
+	if rf, ok := ret.Get(0).(func(context.Context, string) (int64, error)); ok {
+		return rf(ctx, username)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, db.CreateEntryParams) db.Entry); ok {
-		r0 = rf(ctx, arg)
+	if rf, ok := ret.Get(0).(func(context.Context, string) int64); ok {
+		r0 = rf(ctx, username)
 	} else {
-		r0 = ret.Get(0).(db.Entry)
+		r0 = ret.Get(0).(int64)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, db.CreateEntryParams) error); ok {
-		r1 = rf(ctx, arg)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, username)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -154,51 +156,51 @@ func (_m *Store) CreateEntry(ctx context.Context, arg db.CreateEntryParams) (db.
 	return r0, r1
 }
 
-// Store_CreateEntry_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateEntry'
-type Store_CreateEntry_Call struct {
+// Store_BlockUserSessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BlockUserSessions'
+type Store_BlockUserSessions_Call struct {
 	*mock.Call
 }
 
-// CreateEntry is a helper method to define mock.On call
+// BlockUserSessions is a helper method to define mock.On call
 //  - ctx context.Context
-//  - arg db.CreateEntryParams
-func (_e *Store_Expecter) CreateEntry(ctx interface{}, arg interface{}) *Store_CreateEntry_Call {
-	return &Store_CreateEntry_Call{Call: _e.mock.On("CreateEntry", ctx, arg)}
+//  - username string
+func (_e *Store_Expecter) BlockUserSessions(ctx interface{}, username interface{}) *Store_BlockUserSessions_Call {
+	return &Store_BlockUserSessions_Call{Call: _e.mock.On("BlockUserSessions", ctx, username)}
 }
 
-func (_c *Store_CreateEntry_Call) Run(run func(ctx context.Context, arg db.CreateEntryParams)) *Store_CreateEntry_Call {
+func (_c *Store_BlockUserSessions_Call) Run(run func(ctx context.Context, username string)) *Store_BlockUserSessions_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(db.CreateEntryParams))
+		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *Store_CreateEntry_Call) Return(_a0 db.Entry, _a1 error) *Store_CreateEntry_Call {
+func (_c *Store_BlockUserSessions_Call) Return(_a0 int64, _a1 error) *Store_BlockUserSessions_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *Store_CreateEntry_Call) RunAndReturn(run func(context.Context, db.CreateEntryParams) (db.Entry, error)) *Store_CreateEntry_Call {
+func (_c *Store_BlockUserSessions_Call) RunAndReturn(run func(context.Context, string) (int64, error)) *Store_BlockUserSessions_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateNewSession provides a mock function with given fields: ctx, arg
-func (_m *Store) CreateNewSession(ctx context.Context, arg db.CreateNewSessionParams) (db.Session, error) {
+// CheckLedgerTx provides a mock function with given fields: ctx, arg
+func (_m *Store) CheckLedgerTx(ctx context.Context, arg db.CheckLedgerTxParams) (db.CheckLedgerTxResult, error) {
 	ret := _m.Called(ctx, arg)
 
-	var r0 db.Session
+	var r0 db.CheckLedgerTxResult
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, db.CreateNewSessionParams) (db.Session, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.CheckLedgerTxParams) (db.CheckLedgerTxResult, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, db.CreateNewSessionParams) db.Session); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.CheckLedgerTxParams) db.CheckLedgerTxResult); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(db.Session)
+		r0 = ret.Get(0).(db.CheckLedgerTxResult)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, db.CreateNewSessionParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, db.CheckLedgerTxParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -207,51 +209,53 @@ func (_m *Store) CreateNewSession(ctx context.Context, arg db.CreateNewSessionPa
 	return r0, r1
 }
 
-// Store_CreateNewSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateNewSession'
-type Store_CreateNewSession_Call struct {
+// Store_CheckLedgerTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckLedgerTx'
+type Store_CheckLedgerTx_Call struct {
 	*mock.Call
 }
 
-// CreateNewSession is a helper method to define mock.On call
+// CheckLedgerTx is a helper method to define mock.On call
 //  - ctx context.Context
-//  - arg db.CreateNewSessionParams
-func (_e *Store_Expecter) CreateNewSession(ctx interface{}, arg interface{}) *Store_CreateNewSession_Call {
-	return &Store_CreateNewSession_Call{Call: _e.mock.On("CreateNewSession", ctx, arg)}
+//  - arg db.CheckLedgerTxParams
+func (_e *Store_Expecter) CheckLedgerTx(ctx interface{}, arg interface{}) *Store_CheckLedgerTx_Call {
+	return &Store_CheckLedgerTx_Call{Call: _e.mock.On("CheckLedgerTx", ctx, arg)}
 }
 
-func (_c *Store_CreateNewSession_Call) Run(run func(ctx context.Context, arg db.CreateNewSessionParams)) *Store_CreateNewSession_Call {
+func (_c *Store_CheckLedgerTx_Call) Run(run func(ctx context.Context, arg db.CheckLedgerTxParams)) *Store_CheckLedgerTx_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(db.CreateNewSessionParams))
+		run(args[0].(context.Context), args[1].(db.CheckLedgerTxParams))
 	})
 	return _c
 }
 
-func (_c *Store_CreateNewSession_Call) Return(_a0 db.Session, _a1 error) *Store_CreateNewSession_Call {
+func (_c *Store_CheckLedgerTx_Call) Return(_a0 db.CheckLedgerTxResult, _a1 error) *Store_CheckLedgerTx_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *Store_CreateNewSession_Call) RunAndReturn(run func(context.Context, db.CreateNewSessionParams) (db.Session, error)) *Store_CreateNewSession_Call {
+func (_c *Store_CheckLedgerTx_Call) RunAndReturn(run func(context.Context, db.CheckLedgerTxParams) (db.CheckLedgerTxResult, error)) *Store_CheckLedgerTx_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateTransfer provides a mock function with given fields: ctx, arg
-func (_m *Store) CreateTransfer(ctx context.Context, arg db.CreateTransferParams) (db.Transfer, error) {
+// ClaimPendingOutbox provides a mock function with given fields: ctx, arg
+func (_m *Store) ClaimPendingOutbox(ctx context.Context, arg db.ClaimPendingOutboxParams) ([]db.Outbox, error) {
 	ret := _m.Called(ctx, arg)
 
-	var r0 db.Transfer
+	var r0 []db.Outbox
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, db.CreateTransferParams) (db.Transfer, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.ClaimPendingOutboxParams) ([]db.Outbox, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, db.CreateTransferParams) db.Transfer); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.ClaimPendingOutboxParams) []db.Outbox); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(db.Transfer)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.Outbox)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, db.CreateTransferParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, db.ClaimPendingOutboxParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -260,51 +264,51 @@ func (_m *Store) CreateTransfer(ctx context.Context, arg db.CreateTransferParams
 	return r0, r1
 }
 
-// Store_CreateTransfer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateTransfer'
-type Store_CreateTransfer_Call struct {
+// Store_ClaimPendingOutbox_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimPendingOutbox'
+type Store_ClaimPendingOutbox_Call struct {
 	*mock.Call
 }
 
-// CreateTransfer is a helper method to define mock.On call
+// ClaimPendingOutbox is a helper method to define mock.On call
 //  - ctx context.Context
-//  - arg db.CreateTransferParams
-func (_e *Store_Expecter) CreateTransfer(ctx interface{}, arg interface{}) *Store_CreateTransfer_Call {
-	return &Store_CreateTransfer_Call{Call: _e.mock.On("CreateTransfer", ctx, arg)}
+//  - arg db.ClaimPendingOutboxParams
+func (_e *Store_Expecter) ClaimPendingOutbox(ctx interface{}, arg interface{}) *Store_ClaimPendingOutbox_Call {
+	return &Store_ClaimPendingOutbox_Call{Call: _e.mock.On("ClaimPendingOutbox", ctx, arg)}
 }
 
-func (_c *Store_CreateTransfer_Call) Run(run func(ctx context.Context, arg db.CreateTransferParams)) *Store_CreateTransfer_Call {
+func (_c *Store_ClaimPendingOutbox_Call) Run(run func(ctx context.Context, arg db.ClaimPendingOutboxParams)) *Store_ClaimPendingOutbox_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(db.CreateTransferParams))
+		run(args[0].(context.Context), args[1].(db.ClaimPendingOutboxParams))
 	})
 	return _c
 }
 
-func (_c *Store_CreateTransfer_Call) Return(_a0 db.Transfer, _a1 error) *Store_CreateTransfer_Call {
+func (_c *Store_ClaimPendingOutbox_Call) Return(_a0 []db.Outbox, _a1 error) *Store_ClaimPendingOutbox_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *Store_CreateTransfer_Call) RunAndReturn(run func(context.Context, db.CreateTransferParams) (db.Transfer, error)) *Store_CreateTransfer_Call {
+func (_c *Store_ClaimPendingOutbox_Call) RunAndReturn(run func(context.Context, db.ClaimPendingOutboxParams) ([]db.Outbox, error)) *Store_ClaimPendingOutbox_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateUser provides a mock function with given fields: ctx, arg
-func (_m *Store) CreateUser(ctx context.Context, arg db.CreateUserParams) (db.User, error) {
+// CreateAccount provides a mock function with given fields: ctx, arg
+func (_m *Store) CreateAccount(ctx context.Context, arg db.CreateAccountParams) (db.Account, error) {
 	ret := _m.Called(ctx, arg)
 
-	var r0 db.User
+	var r0 db.Account
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, db.CreateUserParams) (db.User, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateAccountParams) (db.Account, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, db.CreateUserParams) db.User); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateAccountParams) db.Account); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(db.User)
+		r0 = ret.Get(0).(db.Account)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, db.CreateUserParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, db.CreateAccountParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -313,51 +317,51 @@ func (_m *Store) CreateUser(ctx context.Context, arg db.CreateUserParams) (db.Us
 	return r0, r1
 }
 
-// Store_CreateUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateUser'
-type Store_CreateUser_Call struct {
+// Store_CreateAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateAccount'
+type Store_CreateAccount_Call struct {
 	*mock.Call
 }
 
-// CreateUser is a helper method to define mock.On call
+// CreateAccount is a helper method to define mock.On call
 //  - ctx context.Context
-//  - arg db.CreateUserParams
-func (_e *Store_Expecter) CreateUser(ctx interface{}, arg interface{}) *Store_CreateUser_Call {
-	return &Store_CreateUser_Call{Call: _e.mock.On("CreateUser", ctx, arg)}
+//  - arg db.CreateAccountParams
+func (_e *Store_Expecter) CreateAccount(ctx interface{}, arg interface{}) *Store_CreateAccount_Call {
+	return &Store_CreateAccount_Call{Call: _e.mock.On("CreateAccount", ctx, arg)}
 }
 
-func (_c *Store_CreateUser_Call) Run(run func(ctx context.Context, arg db.CreateUserParams)) *Store_CreateUser_Call {
+func (_c *Store_CreateAccount_Call) Run(run func(ctx context.Context, arg db.CreateAccountParams)) *Store_CreateAccount_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(db.CreateUserParams))
+		run(args[0].(context.Context), args[1].(db.CreateAccountParams))
 	})
 	return _c
 }
 
-func (_c *Store_CreateUser_Call) Return(_a0 db.User, _a1 error) *Store_CreateUser_Call {
+func (_c *Store_CreateAccount_Call) Return(_a0 db.Account, _a1 error) *Store_CreateAccount_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *Store_CreateUser_Call) RunAndReturn(run func(context.Context, db.CreateUserParams) (db.User, error)) *Store_CreateUser_Call {
+func (_c *Store_CreateAccount_Call) RunAndReturn(run func(context.Context, db.CreateAccountParams) (db.Account, error)) *Store_CreateAccount_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateUserTx provides a mock function with given fields: ctx, arg
-func (_m *Store) CreateUserTx(ctx context.Context, arg db.CreateUserTxParams) (db.CreateUserTxResult, error) {
+// CreateAccountStatusEvent provides a mock function with given fields: ctx, arg
+func (_m *Store) CreateAccountStatusEvent(ctx context.Context, arg db.CreateAccountStatusEventParams) (db.AccountStatusEvent, error) {
 	ret := _m.Called(ctx, arg)
 
-	var r0 db.CreateUserTxResult
+	var r0 db.AccountStatusEvent
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, db.CreateUserTxParams) (db.CreateUserTxResult, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateAccountStatusEventParams) (db.AccountStatusEvent, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, db.CreateUserTxParams) db.CreateUserTxResult); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateAccountStatusEventParams) db.AccountStatusEvent); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(db.CreateUserTxResult)
+		r0 = ret.Get(0).(db.AccountStatusEvent)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, db.CreateUserTxParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, db.CreateAccountStatusEventParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -366,51 +370,51 @@ func (_m *Store) CreateUserTx(ctx context.Context, arg db.CreateUserTxParams) (d
 	return r0, r1
 }
 
-// Store_CreateUserTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateUserTx'
-type Store_CreateUserTx_Call struct {
+// Store_CreateAccountStatusEvent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateAccountStatusEvent'
+type Store_CreateAccountStatusEvent_Call struct {
 	*mock.Call
 }
 
-// CreateUserTx is a helper method to define mock.On call
+// CreateAccountStatusEvent is a helper method to define mock.On call
 //  - ctx context.Context
-//  - arg db.CreateUserTxParams
-func (_e *Store_Expecter) CreateUserTx(ctx interface{}, arg interface{}) *Store_CreateUserTx_Call {
-	return &Store_CreateUserTx_Call{Call: _e.mock.On("CreateUserTx", ctx, arg)}
+//  - arg db.CreateAccountStatusEventParams
+func (_e *Store_Expecter) CreateAccountStatusEvent(ctx interface{}, arg interface{}) *Store_CreateAccountStatusEvent_Call {
+	return &Store_CreateAccountStatusEvent_Call{Call: _e.mock.On("CreateAccountStatusEvent", ctx, arg)}
 }
 
-func (_c *Store_CreateUserTx_Call) Run(run func(ctx context.Context, arg db.CreateUserTxParams)) *Store_CreateUserTx_Call {
+func (_c *Store_CreateAccountStatusEvent_Call) Run(run func(ctx context.Context, arg db.CreateAccountStatusEventParams)) *Store_CreateAccountStatusEvent_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(db.CreateUserTxParams))
+		run(args[0].(context.Context), args[1].(db.CreateAccountStatusEventParams))
 	})
 	return _c
 }
 
-func (_c *Store_CreateUserTx_Call) Return(_a0 db.CreateUserTxResult, _a1 error) *Store_CreateUserTx_Call {
+func (_c *Store_CreateAccountStatusEvent_Call) Return(_a0 db.AccountStatusEvent, _a1 error) *Store_CreateAccountStatusEvent_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *Store_CreateUserTx_Call) RunAndReturn(run func(context.Context, db.CreateUserTxParams) (db.CreateUserTxResult, error)) *Store_CreateUserTx_Call {
+func (_c *Store_CreateAccountStatusEvent_Call) RunAndReturn(run func(context.Context, db.CreateAccountStatusEventParams) (db.AccountStatusEvent, error)) *Store_CreateAccountStatusEvent_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateVerifyEmail provides a mock function with given fields: ctx, arg
-func (_m *Store) CreateVerifyEmail(ctx context.Context, arg db.CreateVerifyEmailParams) (db.VerifyEmail, error) {
+// CreateEntry provides a mock function with given fields: ctx, arg
+func (_m *Store) CreateEntry(ctx context.Context, arg db.CreateEntryParams) (db.Entry, error) {
 	ret := _m.Called(ctx, arg)
 
-	var r0 db.VerifyEmail
+	var r0 db.Entry
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, db.CreateVerifyEmailParams) (db.VerifyEmail, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateEntryParams) (db.Entry, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, db.CreateVerifyEmailParams) db.VerifyEmail); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateEntryParams) db.Entry); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(db.VerifyEmail)
+		r0 = ret.Get(0).(db.Entry)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, db.CreateVerifyEmailParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, db.CreateEntryParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -419,181 +423,740 @@ func (_m *Store) CreateVerifyEmail(ctx context.Context, arg db.CreateVerifyEmail
 	return r0, r1
 }
 
-// Store_CreateVerifyEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateVerifyEmail'
-type Store_CreateVerifyEmail_Call struct {
+// Store_CreateEntry_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateEntry'
+type Store_CreateEntry_Call struct {
 	*mock.Call
 }
 
-// CreateVerifyEmail is a helper method to define mock.On call
+// CreateEntry is a helper method to define mock.On call
 //  - ctx context.Context
-//  - arg db.CreateVerifyEmailParams
-func (_e *Store_Expecter) CreateVerifyEmail(ctx interface{}, arg interface{}) *Store_CreateVerifyEmail_Call {
-	return &Store_CreateVerifyEmail_Call{Call: _e.mock.On("CreateVerifyEmail", ctx, arg)}
+//  - arg db.CreateEntryParams
+func (_e *Store_Expecter) CreateEntry(ctx interface{}, arg interface{}) *Store_CreateEntry_Call {
+	return &Store_CreateEntry_Call{Call: _e.mock.On("CreateEntry", ctx, arg)}
 }
 
-func (_c *Store_CreateVerifyEmail_Call) Run(run func(ctx context.Context, arg db.CreateVerifyEmailParams)) *Store_CreateVerifyEmail_Call {
+func (_c *Store_CreateEntry_Call) Run(run func(ctx context.Context, arg db.CreateEntryParams)) *Store_CreateEntry_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(db.CreateVerifyEmailParams))
+		run(args[0].(context.Context), args[1].(db.CreateEntryParams))
 	})
 	return _c
 }
 
-func (_c *Store_CreateVerifyEmail_Call) Return(_a0 db.VerifyEmail, _a1 error) *Store_CreateVerifyEmail_Call {
+func (_c *Store_CreateEntry_Call) Return(_a0 db.Entry, _a1 error) *Store_CreateEntry_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *Store_CreateVerifyEmail_Call) RunAndReturn(run func(context.Context, db.CreateVerifyEmailParams) (db.VerifyEmail, error)) *Store_CreateVerifyEmail_Call {
+func (_c *Store_CreateEntry_Call) RunAndReturn(run func(context.Context, db.CreateEntryParams) (db.Entry, error)) *Store_CreateEntry_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteAccount provides a mock function with given fields: ctx, id
-func (_m *Store) DeleteAccount(ctx context.Context, id int64) error {
-	ret := _m.Called(ctx, id)
+// CreateExchangeAccount provides a mock function with given fields: ctx, arg
+func (_m *Store) CreateExchangeAccount(ctx context.Context, arg db.CreateExchangeAccountParams) (db.Account, error) {
+	ret := _m.Called(ctx, arg)
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
-		r0 = rf(ctx, id)
+	var r0 db.Account
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateExchangeAccountParams) (db.Account, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateExchangeAccountParams) db.Account); ok {
+		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(db.Account)
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, db.CreateExchangeAccountParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// Store_DeleteAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAccount'
-type Store_DeleteAccount_Call struct {
+// Store_CreateExchangeAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateExchangeAccount'
+type Store_CreateExchangeAccount_Call struct {
 	*mock.Call
 }
 
-// DeleteAccount is a helper method to define mock.On call
+// CreateExchangeAccount is a helper method to define mock.On call
 //  - ctx context.Context
-//  - id int64
-func (_e *Store_Expecter) DeleteAccount(ctx interface{}, id interface{}) *Store_DeleteAccount_Call {
-	return &Store_DeleteAccount_Call{Call: _e.mock.On("DeleteAccount", ctx, id)}
+//  - arg db.CreateExchangeAccountParams
+func (_e *Store_Expecter) CreateExchangeAccount(ctx interface{}, arg interface{}) *Store_CreateExchangeAccount_Call {
+	return &Store_CreateExchangeAccount_Call{Call: _e.mock.On("CreateExchangeAccount", ctx, arg)}
 }
 
-func (_c *Store_DeleteAccount_Call) Run(run func(ctx context.Context, id int64)) *Store_DeleteAccount_Call {
+func (_c *Store_CreateExchangeAccount_Call) Run(run func(ctx context.Context, arg db.CreateExchangeAccountParams)) *Store_CreateExchangeAccount_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int64))
+		run(args[0].(context.Context), args[1].(db.CreateExchangeAccountParams))
 	})
 	return _c
 }
 
-func (_c *Store_DeleteAccount_Call) Return(_a0 error) *Store_DeleteAccount_Call {
-	_c.Call.Return(_a0)
+func (_c *Store_CreateExchangeAccount_Call) Return(_a0 db.Account, _a1 error) *Store_CreateExchangeAccount_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *Store_DeleteAccount_Call) RunAndReturn(run func(context.Context, int64) error) *Store_DeleteAccount_Call {
+func (_c *Store_CreateExchangeAccount_Call) RunAndReturn(run func(context.Context, db.CreateExchangeAccountParams) (db.Account, error)) *Store_CreateExchangeAccount_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteEntry provides a mock function with given fields: ctx, id
-func (_m *Store) DeleteEntry(ctx context.Context, id int64) error {
-	ret := _m.Called(ctx, id)
+// CreateIdempotencyKey provides a mock function with given fields: ctx, arg
+func (_m *Store) CreateIdempotencyKey(ctx context.Context, arg db.CreateIdempotencyKeyParams) (db.IdempotencyKey, error) {
+	ret := _m.Called(ctx, arg)
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
-		r0 = rf(ctx, id)
+	var r0 db.IdempotencyKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateIdempotencyKeyParams) (db.IdempotencyKey, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateIdempotencyKeyParams) db.IdempotencyKey); ok {
+		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(db.IdempotencyKey)
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, db.CreateIdempotencyKeyParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// Store_DeleteEntry_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteEntry'
-type Store_DeleteEntry_Call struct {
+// Store_CreateIdempotencyKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateIdempotencyKey'
+type Store_CreateIdempotencyKey_Call struct {
 	*mock.Call
 }
 
-// DeleteEntry is a helper method to define mock.On call
+// CreateIdempotencyKey is a helper method to define mock.On call
 //  - ctx context.Context
-//  - id int64
-func (_e *Store_Expecter) DeleteEntry(ctx interface{}, id interface{}) *Store_DeleteEntry_Call {
-	return &Store_DeleteEntry_Call{Call: _e.mock.On("DeleteEntry", ctx, id)}
+//  - arg db.CreateIdempotencyKeyParams
+func (_e *Store_Expecter) CreateIdempotencyKey(ctx interface{}, arg interface{}) *Store_CreateIdempotencyKey_Call {
+	return &Store_CreateIdempotencyKey_Call{Call: _e.mock.On("CreateIdempotencyKey", ctx, arg)}
 }
 
-func (_c *Store_DeleteEntry_Call) Run(run func(ctx context.Context, id int64)) *Store_DeleteEntry_Call {
+func (_c *Store_CreateIdempotencyKey_Call) Run(run func(ctx context.Context, arg db.CreateIdempotencyKeyParams)) *Store_CreateIdempotencyKey_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int64))
+		run(args[0].(context.Context), args[1].(db.CreateIdempotencyKeyParams))
 	})
 	return _c
 }
 
-func (_c *Store_DeleteEntry_Call) Return(_a0 error) *Store_DeleteEntry_Call {
-	_c.Call.Return(_a0)
+func (_c *Store_CreateIdempotencyKey_Call) Return(_a0 db.IdempotencyKey, _a1 error) *Store_CreateIdempotencyKey_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *Store_DeleteEntry_Call) RunAndReturn(run func(context.Context, int64) error) *Store_DeleteEntry_Call {
+func (_c *Store_CreateIdempotencyKey_Call) RunAndReturn(run func(context.Context, db.CreateIdempotencyKeyParams) (db.IdempotencyKey, error)) *Store_CreateIdempotencyKey_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteTransfer provides a mock function with given fields: ctx, id
-func (_m *Store) DeleteTransfer(ctx context.Context, id int64) error {
-	ret := _m.Called(ctx, id)
+// CreateJournal provides a mock function with given fields: ctx
+func (_m *Store) CreateJournal(ctx context.Context) (db.Journal, error) {
+	ret := _m.Called(ctx)
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
-		r0 = rf(ctx, id)
+	var r0 db.Journal
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (db.Journal, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) db.Journal); ok {
+		r0 = rf(ctx)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(db.Journal)
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// Store_DeleteTransfer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteTransfer'
-type Store_DeleteTransfer_Call struct {
+// Store_CreateJournal_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateJournal'
+type Store_CreateJournal_Call struct {
 	*mock.Call
 }
 
-// DeleteTransfer is a helper method to define mock.On call
+// CreateJournal is a helper method to define mock.On call
 //  - ctx context.Context
-//  - id int64
-func (_e *Store_Expecter) DeleteTransfer(ctx interface{}, id interface{}) *Store_DeleteTransfer_Call {
-	return &Store_DeleteTransfer_Call{Call: _e.mock.On("DeleteTransfer", ctx, id)}
+func (_e *Store_Expecter) CreateJournal(ctx interface{}) *Store_CreateJournal_Call {
+	return &Store_CreateJournal_Call{Call: _e.mock.On("CreateJournal", ctx)}
 }
 
-func (_c *Store_DeleteTransfer_Call) Run(run func(ctx context.Context, id int64)) *Store_DeleteTransfer_Call {
+func (_c *Store_CreateJournal_Call) Run(run func(ctx context.Context)) *Store_CreateJournal_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int64))
+		run(args[0].(context.Context))
 	})
 	return _c
 }
 
-func (_c *Store_DeleteTransfer_Call) Return(_a0 error) *Store_DeleteTransfer_Call {
-	_c.Call.Return(_a0)
+func (_c *Store_CreateJournal_Call) Return(_a0 db.Journal, _a1 error) *Store_CreateJournal_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *Store_DeleteTransfer_Call) RunAndReturn(run func(context.Context, int64) error) *Store_DeleteTransfer_Call {
+func (_c *Store_CreateJournal_Call) RunAndReturn(run func(context.Context) (db.Journal, error)) *Store_CreateJournal_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetAccount provides a mock function with given fields: ctx, id
-func (_m *Store) GetAccount(ctx context.Context, id int64) (db.Account, error) {
-	ret := _m.Called(ctx, id)
+// CreateNewSession provides a mock function with given fields: ctx, arg
+func (_m *Store) CreateNewSession(ctx context.Context, arg db.CreateNewSessionParams) (db.Session, error) {
+	ret := _m.Called(ctx, arg)
 
-	var r0 db.Account
+	var r0 db.Session
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64) (db.Account, error)); ok {
-		return rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateNewSessionParams) (db.Session, error)); ok {
+		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int64) db.Account); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateNewSessionParams) db.Session); ok {
+		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(db.Account)
+		r0 = ret.Get(0).(db.Session)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
-		r1 = rf(ctx, id)
+	if rf, ok := ret.Get(1).(func(context.Context, db.CreateNewSessionParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Store_CreateNewSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateNewSession'
+type Store_CreateNewSession_Call struct {
+	*mock.Call
+}
+
+// CreateNewSession is a helper method to define mock.On call
+//  - ctx context.Context
+//  - arg db.CreateNewSessionParams
+func (_e *Store_Expecter) CreateNewSession(ctx interface{}, arg interface{}) *Store_CreateNewSession_Call {
+	return &Store_CreateNewSession_Call{Call: _e.mock.On("CreateNewSession", ctx, arg)}
+}
+
+func (_c *Store_CreateNewSession_Call) Run(run func(ctx context.Context, arg db.CreateNewSessionParams)) *Store_CreateNewSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.CreateNewSessionParams))
+	})
+	return _c
+}
+
+func (_c *Store_CreateNewSession_Call) Return(_a0 db.Session, _a1 error) *Store_CreateNewSession_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Store_CreateNewSession_Call) RunAndReturn(run func(context.Context, db.CreateNewSessionParams) (db.Session, error)) *Store_CreateNewSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateOutbox provides a mock function with given fields: ctx, arg
+func (_m *Store) CreateOutbox(ctx context.Context, arg db.CreateOutboxParams) (db.Outbox, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 db.Outbox
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateOutboxParams) (db.Outbox, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateOutboxParams) db.Outbox); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(db.Outbox)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.CreateOutboxParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Store_CreateOutbox_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateOutbox'
+type Store_CreateOutbox_Call struct {
+	*mock.Call
+}
+
+// CreateOutbox is a helper method to define mock.On call
+//  - ctx context.Context
+//  - arg db.CreateOutboxParams
+func (_e *Store_Expecter) CreateOutbox(ctx interface{}, arg interface{}) *Store_CreateOutbox_Call {
+	return &Store_CreateOutbox_Call{Call: _e.mock.On("CreateOutbox", ctx, arg)}
+}
+
+func (_c *Store_CreateOutbox_Call) Run(run func(ctx context.Context, arg db.CreateOutboxParams)) *Store_CreateOutbox_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.CreateOutboxParams))
+	})
+	return _c
+}
+
+func (_c *Store_CreateOutbox_Call) Return(_a0 db.Outbox, _a1 error) *Store_CreateOutbox_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Store_CreateOutbox_Call) RunAndReturn(run func(context.Context, db.CreateOutboxParams) (db.Outbox, error)) *Store_CreateOutbox_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateReconciliationReport provides a mock function with given fields: ctx, arg
+func (_m *Store) CreateReconciliationReport(ctx context.Context, arg db.CreateReconciliationReportParams) (db.ReconciliationReport, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 db.ReconciliationReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateReconciliationReportParams) (db.ReconciliationReport, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateReconciliationReportParams) db.ReconciliationReport); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(db.ReconciliationReport)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.CreateReconciliationReportParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Store_CreateReconciliationReport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateReconciliationReport'
+type Store_CreateReconciliationReport_Call struct {
+	*mock.Call
+}
+
+// CreateReconciliationReport is a helper method to define mock.On call
+//  - ctx context.Context
+//  - arg db.CreateReconciliationReportParams
+func (_e *Store_Expecter) CreateReconciliationReport(ctx interface{}, arg interface{}) *Store_CreateReconciliationReport_Call {
+	return &Store_CreateReconciliationReport_Call{Call: _e.mock.On("CreateReconciliationReport", ctx, arg)}
+}
+
+func (_c *Store_CreateReconciliationReport_Call) Run(run func(ctx context.Context, arg db.CreateReconciliationReportParams)) *Store_CreateReconciliationReport_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.CreateReconciliationReportParams))
+	})
+	return _c
+}
+
+func (_c *Store_CreateReconciliationReport_Call) Return(_a0 db.ReconciliationReport, _a1 error) *Store_CreateReconciliationReport_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Store_CreateReconciliationReport_Call) RunAndReturn(run func(context.Context, db.CreateReconciliationReportParams) (db.ReconciliationReport, error)) *Store_CreateReconciliationReport_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateScheduledTransfer provides a mock function with given fields: ctx, arg
+func (_m *Store) CreateScheduledTransfer(ctx context.Context, arg db.CreateScheduledTransferParams) (db.ScheduledTransfer, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 db.ScheduledTransfer
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateScheduledTransferParams) (db.ScheduledTransfer, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateScheduledTransferParams) db.ScheduledTransfer); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(db.ScheduledTransfer)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.CreateScheduledTransferParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Store_CreateScheduledTransfer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateScheduledTransfer'
+type Store_CreateScheduledTransfer_Call struct {
+	*mock.Call
+}
+
+// CreateScheduledTransfer is a helper method to define mock.On call
+//  - ctx context.Context
+//  - arg db.CreateScheduledTransferParams
+func (_e *Store_Expecter) CreateScheduledTransfer(ctx interface{}, arg interface{}) *Store_CreateScheduledTransfer_Call {
+	return &Store_CreateScheduledTransfer_Call{Call: _e.mock.On("CreateScheduledTransfer", ctx, arg)}
+}
+
+func (_c *Store_CreateScheduledTransfer_Call) Run(run func(ctx context.Context, arg db.CreateScheduledTransferParams)) *Store_CreateScheduledTransfer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.CreateScheduledTransferParams))
+	})
+	return _c
+}
+
+func (_c *Store_CreateScheduledTransfer_Call) Return(_a0 db.ScheduledTransfer, _a1 error) *Store_CreateScheduledTransfer_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Store_CreateScheduledTransfer_Call) RunAndReturn(run func(context.Context, db.CreateScheduledTransferParams) (db.ScheduledTransfer, error)) *Store_CreateScheduledTransfer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateScheduledTransferRun provides a mock function with given fields: ctx, arg
+func (_m *Store) CreateScheduledTransferRun(ctx context.Context, arg db.CreateScheduledTransferRunParams) (db.ScheduledTransferRun, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 db.ScheduledTransferRun
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateScheduledTransferRunParams) (db.ScheduledTransferRun, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateScheduledTransferRunParams) db.ScheduledTransferRun); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(db.ScheduledTransferRun)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.CreateScheduledTransferRunParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Store_CreateScheduledTransferRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateScheduledTransferRun'
+type Store_CreateScheduledTransferRun_Call struct {
+	*mock.Call
+}
+
+// CreateScheduledTransferRun is a helper method to define mock.On call
+//  - ctx context.Context
+//  - arg db.CreateScheduledTransferRunParams
+func (_e *Store_Expecter) CreateScheduledTransferRun(ctx interface{}, arg interface{}) *Store_CreateScheduledTransferRun_Call {
+	return &Store_CreateScheduledTransferRun_Call{Call: _e.mock.On("CreateScheduledTransferRun", ctx, arg)}
+}
+
+func (_c *Store_CreateScheduledTransferRun_Call) Run(run func(ctx context.Context, arg db.CreateScheduledTransferRunParams)) *Store_CreateScheduledTransferRun_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.CreateScheduledTransferRunParams))
+	})
+	return _c
+}
+
+func (_c *Store_CreateScheduledTransferRun_Call) Return(_a0 db.ScheduledTransferRun, _a1 error) *Store_CreateScheduledTransferRun_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Store_CreateScheduledTransferRun_Call) RunAndReturn(run func(context.Context, db.CreateScheduledTransferRunParams) (db.ScheduledTransferRun, error)) *Store_CreateScheduledTransferRun_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateTransfer provides a mock function with given fields: ctx, arg
+func (_m *Store) CreateTransfer(ctx context.Context, arg db.CreateTransferParams) (db.Transfer, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 db.Transfer
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateTransferParams) (db.Transfer, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateTransferParams) db.Transfer); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(db.Transfer)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.CreateTransferParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Store_CreateTransfer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateTransfer'
+type Store_CreateTransfer_Call struct {
+	*mock.Call
+}
+
+// CreateTransfer is a helper method to define mock.On call
+//  - ctx context.Context
+//  - arg db.CreateTransferParams
+func (_e *Store_Expecter) CreateTransfer(ctx interface{}, arg interface{}) *Store_CreateTransfer_Call {
+	return &Store_CreateTransfer_Call{Call: _e.mock.On("CreateTransfer", ctx, arg)}
+}
+
+func (_c *Store_CreateTransfer_Call) Run(run func(ctx context.Context, arg db.CreateTransferParams)) *Store_CreateTransfer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.CreateTransferParams))
+	})
+	return _c
+}
+
+func (_c *Store_CreateTransfer_Call) Return(_a0 db.Transfer, _a1 error) *Store_CreateTransfer_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Store_CreateTransfer_Call) RunAndReturn(run func(context.Context, db.CreateTransferParams) (db.Transfer, error)) *Store_CreateTransfer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateUser provides a mock function with given fields: ctx, arg
+func (_m *Store) CreateUser(ctx context.Context, arg db.CreateUserParams) (db.User, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 db.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateUserParams) (db.User, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateUserParams) db.User); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(db.User)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.CreateUserParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Store_CreateUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateUser'
+type Store_CreateUser_Call struct {
+	*mock.Call
+}
+
+// CreateUser is a helper method to define mock.On call
+//  - ctx context.Context
+//  - arg db.CreateUserParams
+func (_e *Store_Expecter) CreateUser(ctx interface{}, arg interface{}) *Store_CreateUser_Call {
+	return &Store_CreateUser_Call{Call: _e.mock.On("CreateUser", ctx, arg)}
+}
+
+func (_c *Store_CreateUser_Call) Run(run func(ctx context.Context, arg db.CreateUserParams)) *Store_CreateUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.CreateUserParams))
+	})
+	return _c
+}
+
+func (_c *Store_CreateUser_Call) Return(_a0 db.User, _a1 error) *Store_CreateUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Store_CreateUser_Call) RunAndReturn(run func(context.Context, db.CreateUserParams) (db.User, error)) *Store_CreateUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateUserTx provides a mock function with given fields: ctx, arg
+func (_m *Store) CreateUserTx(ctx context.Context, arg db.CreateUserTxParams) (db.CreateUserTxResult, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 db.CreateUserTxResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateUserTxParams) (db.CreateUserTxResult, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateUserTxParams) db.CreateUserTxResult); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(db.CreateUserTxResult)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.CreateUserTxParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Store_CreateUserTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateUserTx'
+type Store_CreateUserTx_Call struct {
+	*mock.Call
+}
+
+// CreateUserTx is a helper method to define mock.On call
+//  - ctx context.Context
+//  - arg db.CreateUserTxParams
+func (_e *Store_Expecter) CreateUserTx(ctx interface{}, arg interface{}) *Store_CreateUserTx_Call {
+	return &Store_CreateUserTx_Call{Call: _e.mock.On("CreateUserTx", ctx, arg)}
+}
+
+func (_c *Store_CreateUserTx_Call) Run(run func(ctx context.Context, arg db.CreateUserTxParams)) *Store_CreateUserTx_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.CreateUserTxParams))
+	})
+	return _c
+}
+
+func (_c *Store_CreateUserTx_Call) Return(_a0 db.CreateUserTxResult, _a1 error) *Store_CreateUserTx_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Store_CreateUserTx_Call) RunAndReturn(run func(context.Context, db.CreateUserTxParams) (db.CreateUserTxResult, error)) *Store_CreateUserTx_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateVerifyEmail provides a mock function with given fields: ctx, arg
+func (_m *Store) CreateVerifyEmail(ctx context.Context, arg db.CreateVerifyEmailParams) (db.VerifyEmail, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 db.VerifyEmail
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateVerifyEmailParams) (db.VerifyEmail, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateVerifyEmailParams) db.VerifyEmail); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(db.VerifyEmail)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.CreateVerifyEmailParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Store_CreateVerifyEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateVerifyEmail'
+type Store_CreateVerifyEmail_Call struct {
+	*mock.Call
+}
+
+// CreateVerifyEmail is a helper method to define mock.On call
+//  - ctx context.Context
+//  - arg db.CreateVerifyEmailParams
+func (_e *Store_Expecter) CreateVerifyEmail(ctx interface{}, arg interface{}) *Store_CreateVerifyEmail_Call {
+	return &Store_CreateVerifyEmail_Call{Call: _e.mock.On("CreateVerifyEmail", ctx, arg)}
+}
+
+func (_c *Store_CreateVerifyEmail_Call) Run(run func(ctx context.Context, arg db.CreateVerifyEmailParams)) *Store_CreateVerifyEmail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.CreateVerifyEmailParams))
+	})
+	return _c
+}
+
+func (_c *Store_CreateVerifyEmail_Call) Return(_a0 db.VerifyEmail, _a1 error) *Store_CreateVerifyEmail_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Store_CreateVerifyEmail_Call) RunAndReturn(run func(context.Context, db.CreateVerifyEmailParams) (db.VerifyEmail, error)) *Store_CreateVerifyEmail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CrossCurrencyTransferTx provides a mock function with given fields: ctx, arg
+func (_m *Store) CrossCurrencyTransferTx(ctx context.Context, arg db.CrossCurrencyTransferTxParams) (db.TransferTxResult, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 db.TransferTxResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.CrossCurrencyTransferTxParams) (db.TransferTxResult, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.CrossCurrencyTransferTxParams) db.TransferTxResult); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(db.TransferTxResult)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.CrossCurrencyTransferTxParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Store_CrossCurrencyTransferTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CrossCurrencyTransferTx'
+type Store_CrossCurrencyTransferTx_Call struct {
+	*mock.Call
+}
+
+// CrossCurrencyTransferTx is a helper method to define mock.On call
+//  - ctx context.Context
+//  - arg db.CrossCurrencyTransferTxParams
+func (_e *Store_Expecter) CrossCurrencyTransferTx(ctx interface{}, arg interface{}) *Store_CrossCurrencyTransferTx_Call {
+	return &Store_CrossCurrencyTransferTx_Call{Call: _e.mock.On("CrossCurrencyTransferTx", ctx, arg)}
+}
+
+func (_c *Store_CrossCurrencyTransferTx_Call) Run(run func(ctx context.Context, arg db.CrossCurrencyTransferTxParams)) *Store_CrossCurrencyTransferTx_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.CrossCurrencyTransferTxParams))
+	})
+	return _c
+}
+
+func (_c *Store_CrossCurrencyTransferTx_Call) Return(_a0 db.TransferTxResult, _a1 error) *Store_CrossCurrencyTransferTx_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Store_CrossCurrencyTransferTx_Call) RunAndReturn(run func(context.Context, db.CrossCurrencyTransferTxParams) (db.TransferTxResult, error)) *Store_CrossCurrencyTransferTx_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAccount provides a mock function with given fields: ctx, id
+func (_m *Store) GetAccount(ctx context.Context, id int64) (db.Account, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 db.Account
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (db.Account, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) db.Account); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(db.Account)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -606,47 +1169,1502 @@ type Store_GetAccount_Call struct {
 	*mock.Call
 }
 
-// GetAccount is a helper method to define mock.On call
+// GetAccount is a helper method to define mock.On call
+//  - ctx context.Context
+//  - id int64
+func (_e *Store_Expecter) GetAccount(ctx interface{}, id interface{}) *Store_GetAccount_Call {
+	return &Store_GetAccount_Call{Call: _e.mock.On("GetAccount", ctx, id)}
+}
+
+func (_c *Store_GetAccount_Call) Run(run func(ctx context.Context, id int64)) *Store_GetAccount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *Store_GetAccount_Call) Return(_a0 db.Account, _a1 error) *Store_GetAccount_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Store_GetAccount_Call) RunAndReturn(run func(context.Context, int64) (db.Account, error)) *Store_GetAccount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAccountByCurrency provides a mock function with given fields: ctx, arg
+func (_m *Store) GetAccountByCurrency(ctx context.Context, arg db.GetAccountByCurrencyParams) (db.Account, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 db.Account
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.GetAccountByCurrencyParams) (db.Account, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.GetAccountByCurrencyParams) db.Account); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(db.Account)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.GetAccountByCurrencyParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Store_GetAccountByCurrency_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAccountByCurrency'
+type Store_GetAccountByCurrency_Call struct {
+	*mock.Call
+}
+
+// GetAccountByCurrency is a helper method to define mock.On call
+//  - ctx context.Context
+//  - arg db.GetAccountByCurrencyParams
+func (_e *Store_Expecter) GetAccountByCurrency(ctx interface{}, arg interface{}) *Store_GetAccountByCurrency_Call {
+	return &Store_GetAccountByCurrency_Call{Call: _e.mock.On("GetAccountByCurrency", ctx, arg)}
+}
+
+func (_c *Store_GetAccountByCurrency_Call) Run(run func(ctx context.Context, arg db.GetAccountByCurrencyParams)) *Store_GetAccountByCurrency_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.GetAccountByCurrencyParams))
+	})
+	return _c
+}
+
+func (_c *Store_GetAccountByCurrency_Call) Return(_a0 db.Account, _a1 error) *Store_GetAccountByCurrency_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Store_GetAccountByCurrency_Call) RunAndReturn(run func(context.Context, db.GetAccountByCurrencyParams) (db.Account, error)) *Store_GetAccountByCurrency_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAccountForUpdate provides a mock function with given fields: ctx, id
+func (_m *Store) GetAccountForUpdate(ctx context.Context, id int64) (db.Account, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 db.Account
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (db.Account, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) db.Account); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(db.Account)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Store_GetAccountForUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAccountForUpdate'
+type Store_GetAccountForUpdate_Call struct {
+	*mock.Call
+}
+
+// GetAccountForUpdate is a helper method to define mock.On call
+//  - ctx context.Context
+//  - id int64
+func (_e *Store_Expecter) GetAccountForUpdate(ctx interface{}, id interface{}) *Store_GetAccountForUpdate_Call {
+	return &Store_GetAccountForUpdate_Call{Call: _e.mock.On("GetAccountForUpdate", ctx, id)}
+}
+
+func (_c *Store_GetAccountForUpdate_Call) Run(run func(ctx context.Context, id int64)) *Store_GetAccountForUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *Store_GetAccountForUpdate_Call) Return(_a0 db.Account, _a1 error) *Store_GetAccountForUpdate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Store_GetAccountForUpdate_Call) RunAndReturn(run func(context.Context, int64) (db.Account, error)) *Store_GetAccountForUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAccountForUpdateUnsafe provides a mock function with given fields: ctx, id
+func (_m *Store) GetAccountForUpdateUnsafe(ctx context.Context, id int64) (db.Account, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 db.Account
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (db.Account, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) db.Account); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(db.Account)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Store_GetAccountForUpdateUnsafe_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAccountForUpdateUnsafe'
+type Store_GetAccountForUpdateUnsafe_Call struct {
+	*mock.Call
+}
+
+// GetAccountForUpdateUnsafe is a helper method to define mock.On call
+//  - ctx context.Context
+//  - id int64
+func (_e *Store_Expecter) GetAccountForUpdateUnsafe(ctx interface{}, id interface{}) *Store_GetAccountForUpdateUnsafe_Call {
+	return &Store_GetAccountForUpdateUnsafe_Call{Call: _e.mock.On("GetAccountForUpdateUnsafe", ctx, id)}
+}
+
+func (_c *Store_GetAccountForUpdateUnsafe_Call) Run(run func(ctx context.Context, id int64)) *Store_GetAccountForUpdateUnsafe_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *Store_GetAccountForUpdateUnsafe_Call) Return(_a0 db.Account, _a1 error) *Store_GetAccountForUpdateUnsafe_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Store_GetAccountForUpdateUnsafe_Call) RunAndReturn(run func(context.Context, int64) (db.Account, error)) *Store_GetAccountForUpdateUnsafe_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetBalanceBefore provides a mock function with given fields: ctx, arg
+func (_m *Store) GetBalanceBefore(ctx context.Context, arg db.GetBalanceBeforeParams) (int64, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.GetBalanceBeforeParams) (int64, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.GetBalanceBeforeParams) int64); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.GetBalanceBeforeParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Store_GetBalanceBefore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBalanceBefore'
+type Store_GetBalanceBefore_Call struct {
+	*mock.Call
+}
+
+// GetBalanceBefore is a helper method to define mock.On call
+//  - ctx context.Context
+//  - arg db.GetBalanceBeforeParams
+func (_e *Store_Expecter) GetBalanceBefore(ctx interface{}, arg interface{}) *Store_GetBalanceBefore_Call {
+	return &Store_GetBalanceBefore_Call{Call: _e.mock.On("GetBalanceBefore", ctx, arg)}
+}
+
+func (_c *Store_GetBalanceBefore_Call) Run(run func(ctx context.Context, arg db.GetBalanceBeforeParams)) *Store_GetBalanceBefore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.GetBalanceBeforeParams))
+	})
+	return _c
+}
+
+func (_c *Store_GetBalanceBefore_Call) Return(_a0 int64, _a1 error) *Store_GetBalanceBefore_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Store_GetBalanceBefore_Call) RunAndReturn(run func(context.Context, db.GetBalanceBeforeParams) (int64, error)) *Store_GetBalanceBefore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetEntry provides a mock function with given fields: ctx, id
+func (_m *Store) GetEntry(ctx context.Context, id int64) (db.Entry, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 db.Entry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (db.Entry, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) db.Entry); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(db.Entry)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Store_GetEntry_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetEntry'
+type Store_GetEntry_Call struct {
+	*mock.Call
+}
+
+// GetEntry is a helper method to define mock.On call
+//  - ctx context.Context
+//  - id int64
+func (_e *Store_Expecter) GetEntry(ctx interface{}, id interface{}) *Store_GetEntry_Call {
+	return &Store_GetEntry_Call{Call: _e.mock.On("GetEntry", ctx, id)}
+}
+
+func (_c *Store_GetEntry_Call) Run(run func(ctx context.Context, id int64)) *Store_GetEntry_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *Store_GetEntry_Call) Return(_a0 db.Entry, _a1 error) *Store_GetEntry_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Store_GetEntry_Call) RunAndReturn(run func(context.Context, int64) (db.Entry, error)) *Store_GetEntry_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetIdempotencyKey provides a mock function with given fields: ctx, arg
+func (_m *Store) GetIdempotencyKey(ctx context.Context, arg db.GetIdempotencyKeyParams) (db.IdempotencyKey, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 db.IdempotencyKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.GetIdempotencyKeyParams) (db.IdempotencyKey, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.GetIdempotencyKeyParams) db.IdempotencyKey); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(db.IdempotencyKey)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.GetIdempotencyKeyParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Store_GetIdempotencyKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetIdempotencyKey'
+type Store_GetIdempotencyKey_Call struct {
+	*mock.Call
+}
+
+// GetIdempotencyKey is a helper method to define mock.On call
+//  - ctx context.Context
+//  - arg db.GetIdempotencyKeyParams
+func (_e *Store_Expecter) GetIdempotencyKey(ctx interface{}, arg interface{}) *Store_GetIdempotencyKey_Call {
+	return &Store_GetIdempotencyKey_Call{Call: _e.mock.On("GetIdempotencyKey", ctx, arg)}
+}
+
+func (_c *Store_GetIdempotencyKey_Call) Run(run func(ctx context.Context, arg db.GetIdempotencyKeyParams)) *Store_GetIdempotencyKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.GetIdempotencyKeyParams))
+	})
+	return _c
+}
+
+func (_c *Store_GetIdempotencyKey_Call) Return(_a0 db.IdempotencyKey, _a1 error) *Store_GetIdempotencyKey_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Store_GetIdempotencyKey_Call) RunAndReturn(run func(context.Context, db.GetIdempotencyKeyParams) (db.IdempotencyKey, error)) *Store_GetIdempotencyKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetJournal provides a mock function with given fields: ctx, id
+func (_m *Store) GetJournal(ctx context.Context, id int64) (db.Journal, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 db.Journal
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (db.Journal, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) db.Journal); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(db.Journal)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Store_GetJournal_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJournal'
+type Store_GetJournal_Call struct {
+	*mock.Call
+}
+
+// GetJournal is a helper method to define mock.On call
+//  - ctx context.Context
+//  - id int64
+func (_e *Store_Expecter) GetJournal(ctx interface{}, id interface{}) *Store_GetJournal_Call {
+	return &Store_GetJournal_Call{Call: _e.mock.On("GetJournal", ctx, id)}
+}
+
+func (_c *Store_GetJournal_Call) Run(run func(ctx context.Context, id int64)) *Store_GetJournal_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *Store_GetJournal_Call) Return(_a0 db.Journal, _a1 error) *Store_GetJournal_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Store_GetJournal_Call) RunAndReturn(run func(context.Context, int64) (db.Journal, error)) *Store_GetJournal_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetReversedAmount provides a mock function with given fields: ctx, reversalOf
+func (_m *Store) GetReversedAmount(ctx context.Context, reversalOf sql.NullInt64) (int64, error) {
+	ret := _m.Called(ctx, reversalOf)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, sql.NullInt64) (int64, error)); ok {
+		return rf(ctx, reversalOf)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sql.NullInt64) int64); ok {
+		r0 = rf(ctx, reversalOf)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, sql.NullInt64) error); ok {
+		r1 = rf(ctx, reversalOf)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Store_GetReversedAmount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReversedAmount'
+type Store_GetReversedAmount_Call struct {
+	*mock.Call
+}
+
+// GetReversedAmount is a helper method to define mock.On call
+//  - ctx context.Context
+//  - reversalOf sql.NullInt64
+func (_e *Store_Expecter) GetReversedAmount(ctx interface{}, reversalOf interface{}) *Store_GetReversedAmount_Call {
+	return &Store_GetReversedAmount_Call{Call: _e.mock.On("GetReversedAmount", ctx, reversalOf)}
+}
+
+func (_c *Store_GetReversedAmount_Call) Run(run func(ctx context.Context, reversalOf sql.NullInt64)) *Store_GetReversedAmount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(sql.NullInt64))
+	})
+	return _c
+}
+
+func (_c *Store_GetReversedAmount_Call) Return(_a0 int64, _a1 error) *Store_GetReversedAmount_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Store_GetReversedAmount_Call) RunAndReturn(run func(context.Context, sql.NullInt64) (int64, error)) *Store_GetReversedAmount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetScheduledTransfer provides a mock function with given fields: ctx, id
+func (_m *Store) GetScheduledTransfer(ctx context.Context, id int64) (db.ScheduledTransfer, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 db.ScheduledTransfer
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (db.ScheduledTransfer, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) db.ScheduledTransfer); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(db.ScheduledTransfer)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Store_GetScheduledTransfer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetScheduledTransfer'
+type Store_GetScheduledTransfer_Call struct {
+	*mock.Call
+}
+
+// GetScheduledTransfer is a helper method to define mock.On call
+//  - ctx context.Context
+//  - id int64
+func (_e *Store_Expecter) GetScheduledTransfer(ctx interface{}, id interface{}) *Store_GetScheduledTransfer_Call {
+	return &Store_GetScheduledTransfer_Call{Call: _e.mock.On("GetScheduledTransfer", ctx, id)}
+}
+
+func (_c *Store_GetScheduledTransfer_Call) Run(run func(ctx context.Context, id int64)) *Store_GetScheduledTransfer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *Store_GetScheduledTransfer_Call) Return(_a0 db.ScheduledTransfer, _a1 error) *Store_GetScheduledTransfer_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Store_GetScheduledTransfer_Call) RunAndReturn(run func(context.Context, int64) (db.ScheduledTransfer, error)) *Store_GetScheduledTransfer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetScheduledTransferForUpdate provides a mock function with given fields: ctx, id
+func (_m *Store) GetScheduledTransferForUpdate(ctx context.Context, id int64) (db.ScheduledTransfer, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 db.ScheduledTransfer
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (db.ScheduledTransfer, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) db.ScheduledTransfer); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(db.ScheduledTransfer)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Store_GetScheduledTransferForUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetScheduledTransferForUpdate'
+type Store_GetScheduledTransferForUpdate_Call struct {
+	*mock.Call
+}
+
+// GetScheduledTransferForUpdate is a helper method to define mock.On call
+//  - ctx context.Context
+//  - id int64
+func (_e *Store_Expecter) GetScheduledTransferForUpdate(ctx interface{}, id interface{}) *Store_GetScheduledTransferForUpdate_Call {
+	return &Store_GetScheduledTransferForUpdate_Call{Call: _e.mock.On("GetScheduledTransferForUpdate", ctx, id)}
+}
+
+func (_c *Store_GetScheduledTransferForUpdate_Call) Run(run func(ctx context.Context, id int64)) *Store_GetScheduledTransferForUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *Store_GetScheduledTransferForUpdate_Call) Return(_a0 db.ScheduledTransfer, _a1 error) *Store_GetScheduledTransferForUpdate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Store_GetScheduledTransferForUpdate_Call) RunAndReturn(run func(context.Context, int64) (db.ScheduledTransfer, error)) *Store_GetScheduledTransferForUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSession provides a mock function with given fields: ctx, id
+func (_m *Store) GetSession(ctx context.Context, id uuid.UUID) (db.Session, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 db.Session
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (db.Session, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) db.Session); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(db.Session)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Store_GetSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSession'
+type Store_GetSession_Call struct {
+	*mock.Call
+}
+
+// GetSession is a helper method to define mock.On call
+//  - ctx context.Context
+//  - id uuid.UUID
+func (_e *Store_Expecter) GetSession(ctx interface{}, id interface{}) *Store_GetSession_Call {
+	return &Store_GetSession_Call{Call: _e.mock.On("GetSession", ctx, id)}
+}
+
+func (_c *Store_GetSession_Call) Run(run func(ctx context.Context, id uuid.UUID)) *Store_GetSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *Store_GetSession_Call) Return(_a0 db.Session, _a1 error) *Store_GetSession_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Store_GetSession_Call) RunAndReturn(run func(context.Context, uuid.UUID) (db.Session, error)) *Store_GetSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSessionForUpdate provides a mock function with given fields: ctx, id
+func (_m *Store) GetSessionForUpdate(ctx context.Context, id uuid.UUID) (db.Session, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 db.Session
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (db.Session, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) db.Session); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(db.Session)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Store_GetSessionForUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSessionForUpdate'
+type Store_GetSessionForUpdate_Call struct {
+	*mock.Call
+}
+
+// GetSessionForUpdate is a helper method to define mock.On call
+//  - ctx context.Context
+//  - id uuid.UUID
+func (_e *Store_Expecter) GetSessionForUpdate(ctx interface{}, id interface{}) *Store_GetSessionForUpdate_Call {
+	return &Store_GetSessionForUpdate_Call{Call: _e.mock.On("GetSessionForUpdate", ctx, id)}
+}
+
+func (_c *Store_GetSessionForUpdate_Call) Run(run func(ctx context.Context, id uuid.UUID)) *Store_GetSessionForUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *Store_GetSessionForUpdate_Call) Return(_a0 db.Session, _a1 error) *Store_GetSessionForUpdate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Store_GetSessionForUpdate_Call) RunAndReturn(run func(context.Context, uuid.UUID) (db.Session, error)) *Store_GetSessionForUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetStatementTx provides a mock function with given fields: ctx, arg
+func (_m *Store) GetStatementTx(ctx context.Context, arg db.GetStatementTxParams) (db.GetStatementTxResult, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 db.GetStatementTxResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.GetStatementTxParams) (db.GetStatementTxResult, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.GetStatementTxParams) db.GetStatementTxResult); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(db.GetStatementTxResult)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.GetStatementTxParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Store_GetStatementTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStatementTx'
+type Store_GetStatementTx_Call struct {
+	*mock.Call
+}
+
+// GetStatementTx is a helper method to define mock.On call
+//  - ctx context.Context
+//  - arg db.GetStatementTxParams
+func (_e *Store_Expecter) GetStatementTx(ctx interface{}, arg interface{}) *Store_GetStatementTx_Call {
+	return &Store_GetStatementTx_Call{Call: _e.mock.On("GetStatementTx", ctx, arg)}
+}
+
+func (_c *Store_GetStatementTx_Call) Run(run func(ctx context.Context, arg db.GetStatementTxParams)) *Store_GetStatementTx_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.GetStatementTxParams))
+	})
+	return _c
+}
+
+func (_c *Store_GetStatementTx_Call) Return(_a0 db.GetStatementTxResult, _a1 error) *Store_GetStatementTx_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Store_GetStatementTx_Call) RunAndReturn(run func(context.Context, db.GetStatementTxParams) (db.GetStatementTxResult, error)) *Store_GetStatementTx_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTransfer provides a mock function with given fields: ctx, id
+func (_m *Store) GetTransfer(ctx context.Context, id int64) (db.Transfer, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 db.Transfer
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (db.Transfer, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) db.Transfer); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(db.Transfer)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Store_GetTransfer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTransfer'
+type Store_GetTransfer_Call struct {
+	*mock.Call
+}
+
+// GetTransfer is a helper method to define mock.On call
+//  - ctx context.Context
+//  - id int64
+func (_e *Store_Expecter) GetTransfer(ctx interface{}, id interface{}) *Store_GetTransfer_Call {
+	return &Store_GetTransfer_Call{Call: _e.mock.On("GetTransfer", ctx, id)}
+}
+
+func (_c *Store_GetTransfer_Call) Run(run func(ctx context.Context, id int64)) *Store_GetTransfer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *Store_GetTransfer_Call) Return(_a0 db.Transfer, _a1 error) *Store_GetTransfer_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Store_GetTransfer_Call) RunAndReturn(run func(context.Context, int64) (db.Transfer, error)) *Store_GetTransfer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTransferForUpdate provides a mock function with given fields: ctx, id
+func (_m *Store) GetTransferForUpdate(ctx context.Context, id int64) (db.Transfer, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 db.Transfer
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (db.Transfer, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) db.Transfer); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(db.Transfer)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Store_GetTransferForUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTransferForUpdate'
+type Store_GetTransferForUpdate_Call struct {
+	*mock.Call
+}
+
+// GetTransferForUpdate is a helper method to define mock.On call
+//  - ctx context.Context
+//  - id int64
+func (_e *Store_Expecter) GetTransferForUpdate(ctx interface{}, id interface{}) *Store_GetTransferForUpdate_Call {
+	return &Store_GetTransferForUpdate_Call{Call: _e.mock.On("GetTransferForUpdate", ctx, id)}
+}
+
+func (_c *Store_GetTransferForUpdate_Call) Run(run func(ctx context.Context, id int64)) *Store_GetTransferForUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *Store_GetTransferForUpdate_Call) Return(_a0 db.Transfer, _a1 error) *Store_GetTransferForUpdate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Store_GetTransferForUpdate_Call) RunAndReturn(run func(context.Context, int64) (db.Transfer, error)) *Store_GetTransferForUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUser provides a mock function with given fields: ctx, username
+func (_m *Store) GetUser(ctx context.Context, username string) (db.User, error) {
+	ret := _m.Called(ctx, username)
+
+	var r0 db.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (db.User, error)); ok {
+		return rf(ctx, username)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) db.User); ok {
+		r0 = rf(ctx, username)
+	} else {
+		r0 = ret.Get(0).(db.User)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, username)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Store_GetUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUser'
+type Store_GetUser_Call struct {
+	*mock.Call
+}
+
+// GetUser is a helper method to define mock.On call
+//  - ctx context.Context
+//  - username string
+func (_e *Store_Expecter) GetUser(ctx interface{}, username interface{}) *Store_GetUser_Call {
+	return &Store_GetUser_Call{Call: _e.mock.On("GetUser", ctx, username)}
+}
+
+func (_c *Store_GetUser_Call) Run(run func(ctx context.Context, username string)) *Store_GetUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Store_GetUser_Call) Return(_a0 db.User, _a1 error) *Store_GetUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Store_GetUser_Call) RunAndReturn(run func(context.Context, string) (db.User, error)) *Store_GetUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListAccountIDs provides a mock function with given fields: ctx, arg
+func (_m *Store) ListAccountIDs(ctx context.Context, arg db.ListAccountIDsParams) ([]int64, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 []int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListAccountIDsParams) ([]int64, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListAccountIDsParams) []int64); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.ListAccountIDsParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Store_ListAccountIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListAccountIDs'
+type Store_ListAccountIDs_Call struct {
+	*mock.Call
+}
+
+// ListAccountIDs is a helper method to define mock.On call
+//  - ctx context.Context
+//  - arg db.ListAccountIDsParams
+func (_e *Store_Expecter) ListAccountIDs(ctx interface{}, arg interface{}) *Store_ListAccountIDs_Call {
+	return &Store_ListAccountIDs_Call{Call: _e.mock.On("ListAccountIDs", ctx, arg)}
+}
+
+func (_c *Store_ListAccountIDs_Call) Run(run func(ctx context.Context, arg db.ListAccountIDsParams)) *Store_ListAccountIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.ListAccountIDsParams))
+	})
+	return _c
+}
+
+func (_c *Store_ListAccountIDs_Call) Return(_a0 []int64, _a1 error) *Store_ListAccountIDs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Store_ListAccountIDs_Call) RunAndReturn(run func(context.Context, db.ListAccountIDsParams) ([]int64, error)) *Store_ListAccountIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListAccountStatusEvents provides a mock function with given fields: ctx, arg
+func (_m *Store) ListAccountStatusEvents(ctx context.Context, arg db.ListAccountStatusEventsParams) ([]db.AccountStatusEvent, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 []db.AccountStatusEvent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListAccountStatusEventsParams) ([]db.AccountStatusEvent, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListAccountStatusEventsParams) []db.AccountStatusEvent); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.AccountStatusEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.ListAccountStatusEventsParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Store_ListAccountStatusEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListAccountStatusEvents'
+type Store_ListAccountStatusEvents_Call struct {
+	*mock.Call
+}
+
+// ListAccountStatusEvents is a helper method to define mock.On call
+//  - ctx context.Context
+//  - arg db.ListAccountStatusEventsParams
+func (_e *Store_Expecter) ListAccountStatusEvents(ctx interface{}, arg interface{}) *Store_ListAccountStatusEvents_Call {
+	return &Store_ListAccountStatusEvents_Call{Call: _e.mock.On("ListAccountStatusEvents", ctx, arg)}
+}
+
+func (_c *Store_ListAccountStatusEvents_Call) Run(run func(ctx context.Context, arg db.ListAccountStatusEventsParams)) *Store_ListAccountStatusEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.ListAccountStatusEventsParams))
+	})
+	return _c
+}
+
+func (_c *Store_ListAccountStatusEvents_Call) Return(_a0 []db.AccountStatusEvent, _a1 error) *Store_ListAccountStatusEvents_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Store_ListAccountStatusEvents_Call) RunAndReturn(run func(context.Context, db.ListAccountStatusEventsParams) ([]db.AccountStatusEvent, error)) *Store_ListAccountStatusEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListAccounts provides a mock function with given fields: ctx, arg
+func (_m *Store) ListAccounts(ctx context.Context, arg db.ListAccountsParams) ([]db.Account, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 []db.Account
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListAccountsParams) ([]db.Account, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListAccountsParams) []db.Account); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.Account)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.ListAccountsParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Store_ListAccounts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListAccounts'
+type Store_ListAccounts_Call struct {
+	*mock.Call
+}
+
+// ListAccounts is a helper method to define mock.On call
+//  - ctx context.Context
+//  - arg db.ListAccountsParams
+func (_e *Store_Expecter) ListAccounts(ctx interface{}, arg interface{}) *Store_ListAccounts_Call {
+	return &Store_ListAccounts_Call{Call: _e.mock.On("ListAccounts", ctx, arg)}
+}
+
+func (_c *Store_ListAccounts_Call) Run(run func(ctx context.Context, arg db.ListAccountsParams)) *Store_ListAccounts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.ListAccountsParams))
+	})
+	return _c
+}
+
+func (_c *Store_ListAccounts_Call) Return(_a0 []db.Account, _a1 error) *Store_ListAccounts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Store_ListAccounts_Call) RunAndReturn(run func(context.Context, db.ListAccountsParams) ([]db.Account, error)) *Store_ListAccounts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListBalanceMismatches provides a mock function with given fields: ctx, accountID
+func (_m *Store) ListBalanceMismatches(ctx context.Context, accountID sql.NullInt64) ([]db.ListBalanceMismatchesRow, error) {
+	ret := _m.Called(ctx, accountID)
+
+	var r0 []db.ListBalanceMismatchesRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, sql.NullInt64) ([]db.ListBalanceMismatchesRow, error)); ok {
+		return rf(ctx, accountID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sql.NullInt64) []db.ListBalanceMismatchesRow); ok {
+		r0 = rf(ctx, accountID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.ListBalanceMismatchesRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, sql.NullInt64) error); ok {
+		r1 = rf(ctx, accountID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Store_ListBalanceMismatches_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListBalanceMismatches'
+type Store_ListBalanceMismatches_Call struct {
+	*mock.Call
+}
+
+// ListBalanceMismatches is a helper method to define mock.On call
+//  - ctx context.Context
+//  - accountID sql.NullInt64
+func (_e *Store_Expecter) ListBalanceMismatches(ctx interface{}, accountID interface{}) *Store_ListBalanceMismatches_Call {
+	return &Store_ListBalanceMismatches_Call{Call: _e.mock.On("ListBalanceMismatches", ctx, accountID)}
+}
+
+func (_c *Store_ListBalanceMismatches_Call) Run(run func(ctx context.Context, accountID sql.NullInt64)) *Store_ListBalanceMismatches_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(sql.NullInt64))
+	})
+	return _c
+}
+
+func (_c *Store_ListBalanceMismatches_Call) Return(_a0 []db.ListBalanceMismatchesRow, _a1 error) *Store_ListBalanceMismatches_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Store_ListBalanceMismatches_Call) RunAndReturn(run func(context.Context, sql.NullInt64) ([]db.ListBalanceMismatchesRow, error)) *Store_ListBalanceMismatches_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListDueScheduledTransferIDs provides a mock function with given fields: ctx, arg
+func (_m *Store) ListDueScheduledTransferIDs(ctx context.Context, arg db.ListDueScheduledTransferIDsParams) ([]int64, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 []int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListDueScheduledTransferIDsParams) ([]int64, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListDueScheduledTransferIDsParams) []int64); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.ListDueScheduledTransferIDsParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Store_ListDueScheduledTransferIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListDueScheduledTransferIDs'
+type Store_ListDueScheduledTransferIDs_Call struct {
+	*mock.Call
+}
+
+// ListDueScheduledTransferIDs is a helper method to define mock.On call
+//  - ctx context.Context
+//  - arg db.ListDueScheduledTransferIDsParams
+func (_e *Store_Expecter) ListDueScheduledTransferIDs(ctx interface{}, arg interface{}) *Store_ListDueScheduledTransferIDs_Call {
+	return &Store_ListDueScheduledTransferIDs_Call{Call: _e.mock.On("ListDueScheduledTransferIDs", ctx, arg)}
+}
+
+func (_c *Store_ListDueScheduledTransferIDs_Call) Run(run func(ctx context.Context, arg db.ListDueScheduledTransferIDsParams)) *Store_ListDueScheduledTransferIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.ListDueScheduledTransferIDsParams))
+	})
+	return _c
+}
+
+func (_c *Store_ListDueScheduledTransferIDs_Call) Return(_a0 []int64, _a1 error) *Store_ListDueScheduledTransferIDs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Store_ListDueScheduledTransferIDs_Call) RunAndReturn(run func(context.Context, db.ListDueScheduledTransferIDsParams) ([]int64, error)) *Store_ListDueScheduledTransferIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListEntries provides a mock function with given fields: ctx, arg
+func (_m *Store) ListEntries(ctx context.Context, arg db.ListEntriesParams) ([]db.Entry, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 []db.Entry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListEntriesParams) ([]db.Entry, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListEntriesParams) []db.Entry); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.Entry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.ListEntriesParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Store_ListEntries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListEntries'
+type Store_ListEntries_Call struct {
+	*mock.Call
+}
+
+// ListEntries is a helper method to define mock.On call
+//  - ctx context.Context
+//  - arg db.ListEntriesParams
+func (_e *Store_Expecter) ListEntries(ctx interface{}, arg interface{}) *Store_ListEntries_Call {
+	return &Store_ListEntries_Call{Call: _e.mock.On("ListEntries", ctx, arg)}
+}
+
+func (_c *Store_ListEntries_Call) Run(run func(ctx context.Context, arg db.ListEntriesParams)) *Store_ListEntries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.ListEntriesParams))
+	})
+	return _c
+}
+
+func (_c *Store_ListEntries_Call) Return(_a0 []db.Entry, _a1 error) *Store_ListEntries_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Store_ListEntries_Call) RunAndReturn(run func(context.Context, db.ListEntriesParams) ([]db.Entry, error)) *Store_ListEntries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListEntriesOf provides a mock function with given fields: ctx, arg
+func (_m *Store) ListEntriesOf(ctx context.Context, arg db.ListEntriesOfParams) ([]db.Entry, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 []db.Entry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListEntriesOfParams) ([]db.Entry, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListEntriesOfParams) []db.Entry); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.Entry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.ListEntriesOfParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Store_ListEntriesOf_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListEntriesOf'
+type Store_ListEntriesOf_Call struct {
+	*mock.Call
+}
+
+// ListEntriesOf is a helper method to define mock.On call
+//  - ctx context.Context
+//  - arg db.ListEntriesOfParams
+func (_e *Store_Expecter) ListEntriesOf(ctx interface{}, arg interface{}) *Store_ListEntriesOf_Call {
+	return &Store_ListEntriesOf_Call{Call: _e.mock.On("ListEntriesOf", ctx, arg)}
+}
+
+func (_c *Store_ListEntriesOf_Call) Run(run func(ctx context.Context, arg db.ListEntriesOfParams)) *Store_ListEntriesOf_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.ListEntriesOfParams))
+	})
+	return _c
+}
+
+func (_c *Store_ListEntriesOf_Call) Return(_a0 []db.Entry, _a1 error) *Store_ListEntriesOf_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Store_ListEntriesOf_Call) RunAndReturn(run func(context.Context, db.ListEntriesOfParams) ([]db.Entry, error)) *Store_ListEntriesOf_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListJournalEntries provides a mock function with given fields: ctx, journalID
+func (_m *Store) ListJournalEntries(ctx context.Context, journalID int64) ([]db.Entry, error) {
+	ret := _m.Called(ctx, journalID)
+
+	var r0 []db.Entry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]db.Entry, error)); ok {
+		return rf(ctx, journalID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []db.Entry); ok {
+		r0 = rf(ctx, journalID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.Entry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, journalID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Store_ListJournalEntries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListJournalEntries'
+type Store_ListJournalEntries_Call struct {
+	*mock.Call
+}
+
+// ListJournalEntries is a helper method to define mock.On call
+//  - ctx context.Context
+//  - journalID int64
+func (_e *Store_Expecter) ListJournalEntries(ctx interface{}, journalID interface{}) *Store_ListJournalEntries_Call {
+	return &Store_ListJournalEntries_Call{Call: _e.mock.On("ListJournalEntries", ctx, journalID)}
+}
+
+func (_c *Store_ListJournalEntries_Call) Run(run func(ctx context.Context, journalID int64)) *Store_ListJournalEntries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *Store_ListJournalEntries_Call) Return(_a0 []db.Entry, _a1 error) *Store_ListJournalEntries_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Store_ListJournalEntries_Call) RunAndReturn(run func(context.Context, int64) ([]db.Entry, error)) *Store_ListJournalEntries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListReconciliationReports provides a mock function with given fields: ctx, arg
+func (_m *Store) ListReconciliationReports(ctx context.Context, arg db.ListReconciliationReportsParams) ([]db.ReconciliationReport, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 []db.ReconciliationReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListReconciliationReportsParams) ([]db.ReconciliationReport, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListReconciliationReportsParams) []db.ReconciliationReport); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.ReconciliationReport)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.ListReconciliationReportsParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Store_ListReconciliationReports_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListReconciliationReports'
+type Store_ListReconciliationReports_Call struct {
+	*mock.Call
+}
+
+// ListReconciliationReports is a helper method to define mock.On call
+//  - ctx context.Context
+//  - arg db.ListReconciliationReportsParams
+func (_e *Store_Expecter) ListReconciliationReports(ctx interface{}, arg interface{}) *Store_ListReconciliationReports_Call {
+	return &Store_ListReconciliationReports_Call{Call: _e.mock.On("ListReconciliationReports", ctx, arg)}
+}
+
+func (_c *Store_ListReconciliationReports_Call) Run(run func(ctx context.Context, arg db.ListReconciliationReportsParams)) *Store_ListReconciliationReports_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.ListReconciliationReportsParams))
+	})
+	return _c
+}
+
+func (_c *Store_ListReconciliationReports_Call) Return(_a0 []db.ReconciliationReport, _a1 error) *Store_ListReconciliationReports_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Store_ListReconciliationReports_Call) RunAndReturn(run func(context.Context, db.ListReconciliationReportsParams) ([]db.ReconciliationReport, error)) *Store_ListReconciliationReports_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListScheduledTransferRuns provides a mock function with given fields: ctx, arg
+func (_m *Store) ListScheduledTransferRuns(ctx context.Context, arg db.ListScheduledTransferRunsParams) ([]db.ScheduledTransferRun, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 []db.ScheduledTransferRun
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListScheduledTransferRunsParams) ([]db.ScheduledTransferRun, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListScheduledTransferRunsParams) []db.ScheduledTransferRun); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.ScheduledTransferRun)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.ListScheduledTransferRunsParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Store_ListScheduledTransferRuns_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListScheduledTransferRuns'
+type Store_ListScheduledTransferRuns_Call struct {
+	*mock.Call
+}
+
+// ListScheduledTransferRuns is a helper method to define mock.On call
+//  - ctx context.Context
+//  - arg db.ListScheduledTransferRunsParams
+func (_e *Store_Expecter) ListScheduledTransferRuns(ctx interface{}, arg interface{}) *Store_ListScheduledTransferRuns_Call {
+	return &Store_ListScheduledTransferRuns_Call{Call: _e.mock.On("ListScheduledTransferRuns", ctx, arg)}
+}
+
+func (_c *Store_ListScheduledTransferRuns_Call) Run(run func(ctx context.Context, arg db.ListScheduledTransferRunsParams)) *Store_ListScheduledTransferRuns_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.ListScheduledTransferRunsParams))
+	})
+	return _c
+}
+
+func (_c *Store_ListScheduledTransferRuns_Call) Return(_a0 []db.ScheduledTransferRun, _a1 error) *Store_ListScheduledTransferRuns_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Store_ListScheduledTransferRuns_Call) RunAndReturn(run func(context.Context, db.ListScheduledTransferRunsParams) ([]db.ScheduledTransferRun, error)) *Store_ListScheduledTransferRuns_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListScheduledTransfers provides a mock function with given fields: ctx, arg
+func (_m *Store) ListScheduledTransfers(ctx context.Context, arg db.ListScheduledTransfersParams) ([]db.ScheduledTransfer, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 []db.ScheduledTransfer
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListScheduledTransfersParams) ([]db.ScheduledTransfer, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListScheduledTransfersParams) []db.ScheduledTransfer); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.ScheduledTransfer)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.ListScheduledTransfersParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Store_ListScheduledTransfers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListScheduledTransfers'
+type Store_ListScheduledTransfers_Call struct {
+	*mock.Call
+}
+
+// ListScheduledTransfers is a helper method to define mock.On call
 //  - ctx context.Context
-//  - id int64
-func (_e *Store_Expecter) GetAccount(ctx interface{}, id interface{}) *Store_GetAccount_Call {
-	return &Store_GetAccount_Call{Call: _e.mock.On("GetAccount", ctx, id)}
+//  - arg db.ListScheduledTransfersParams
+func (_e *Store_Expecter) ListScheduledTransfers(ctx interface{}, arg interface{}) *Store_ListScheduledTransfers_Call {
+	return &Store_ListScheduledTransfers_Call{Call: _e.mock.On("ListScheduledTransfers", ctx, arg)}
 }
 
-func (_c *Store_GetAccount_Call) Run(run func(ctx context.Context, id int64)) *Store_GetAccount_Call {
+func (_c *Store_ListScheduledTransfers_Call) Run(run func(ctx context.Context, arg db.ListScheduledTransfersParams)) *Store_ListScheduledTransfers_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int64))
+		run(args[0].(context.Context), args[1].(db.ListScheduledTransfersParams))
 	})
 	return _c
 }
 
-func (_c *Store_GetAccount_Call) Return(_a0 db.Account, _a1 error) *Store_GetAccount_Call {
+func (_c *Store_ListScheduledTransfers_Call) Return(_a0 []db.ScheduledTransfer, _a1 error) *Store_ListScheduledTransfers_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *Store_GetAccount_Call) RunAndReturn(run func(context.Context, int64) (db.Account, error)) *Store_GetAccount_Call {
+func (_c *Store_ListScheduledTransfers_Call) RunAndReturn(run func(context.Context, db.ListScheduledTransfersParams) ([]db.ScheduledTransfer, error)) *Store_ListScheduledTransfers_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetAccountForUpdate provides a mock function with given fields: ctx, id
-func (_m *Store) GetAccountForUpdate(ctx context.Context, id int64) (db.Account, error) {
-	ret := _m.Called(ctx, id)
+// ListStatementEntries provides a mock function with given fields: ctx, arg
+func (_m *Store) ListStatementEntries(ctx context.Context, arg db.ListStatementEntriesParams) ([]db.ListStatementEntriesRow, error) {
+	ret := _m.Called(ctx, arg)
 
-	var r0 db.Account
+	var r0 []db.ListStatementEntriesRow
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64) (db.Account, error)); ok {
-		return rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListStatementEntriesParams) ([]db.ListStatementEntriesRow, error)); ok {
+		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int64) db.Account); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListStatementEntriesParams) []db.ListStatementEntriesRow); ok {
+		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(db.Account)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.ListStatementEntriesRow)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
-		r1 = rf(ctx, id)
+	if rf, ok := ret.Get(1).(func(context.Context, db.ListStatementEntriesParams) error); ok {
+		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -654,52 +2672,54 @@ func (_m *Store) GetAccountForUpdate(ctx context.Context, id int64) (db.Account,
 	return r0, r1
 }
 
-// Store_GetAccountForUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAccountForUpdate'
-type Store_GetAccountForUpdate_Call struct {
+// Store_ListStatementEntries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListStatementEntries'
+type Store_ListStatementEntries_Call struct {
 	*mock.Call
 }
 
-// GetAccountForUpdate is a helper method to define mock.On call
+// ListStatementEntries is a helper method to define mock.On call
 //  - ctx context.Context
-//  - id int64
-func (_e *Store_Expecter) GetAccountForUpdate(ctx interface{}, id interface{}) *Store_GetAccountForUpdate_Call {
-	return &Store_GetAccountForUpdate_Call{Call: _e.mock.On("GetAccountForUpdate", ctx, id)}
+//  - arg db.ListStatementEntriesParams
+func (_e *Store_Expecter) ListStatementEntries(ctx interface{}, arg interface{}) *Store_ListStatementEntries_Call {
+	return &Store_ListStatementEntries_Call{Call: _e.mock.On("ListStatementEntries", ctx, arg)}
 }
 
-func (_c *Store_GetAccountForUpdate_Call) Run(run func(ctx context.Context, id int64)) *Store_GetAccountForUpdate_Call {
+func (_c *Store_ListStatementEntries_Call) Run(run func(ctx context.Context, arg db.ListStatementEntriesParams)) *Store_ListStatementEntries_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int64))
+		run(args[0].(context.Context), args[1].(db.ListStatementEntriesParams))
 	})
 	return _c
 }
 
-func (_c *Store_GetAccountForUpdate_Call) Return(_a0 db.Account, _a1 error) *Store_GetAccountForUpdate_Call {
+func (_c *Store_ListStatementEntries_Call) Return(_a0 []db.ListStatementEntriesRow, _a1 error) *Store_ListStatementEntries_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *Store_GetAccountForUpdate_Call) RunAndReturn(run func(context.Context, int64) (db.Account, error)) *Store_GetAccountForUpdate_Call {
+func (_c *Store_ListStatementEntries_Call) RunAndReturn(run func(context.Context, db.ListStatementEntriesParams) ([]db.ListStatementEntriesRow, error)) *Store_ListStatementEntries_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetAccountForUpdateUnsafe provides a mock function with given fields: ctx, id
-func (_m *Store) GetAccountForUpdateUnsafe(ctx context.Context, id int64) (db.Account, error) {
-	ret := _m.Called(ctx, id)
+// ListTransfers provides a mock function with given fields: ctx, arg
+func (_m *Store) ListTransfers(ctx context.Context, arg db.ListTransfersParams) ([]db.Transfer, error) {
+	ret := _m.Called(ctx, arg)
 
-	var r0 db.Account
+	var r0 []db.Transfer
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64) (db.Account, error)); ok {
-		return rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListTransfersParams) ([]db.Transfer, error)); ok {
+		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int64) db.Account); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListTransfersParams) []db.Transfer); ok {
+		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(db.Account)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.Transfer)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
-		r1 = rf(ctx, id)
+	if rf, ok := ret.Get(1).(func(context.Context, db.ListTransfersParams) error); ok {
+		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -707,52 +2727,54 @@ func (_m *Store) GetAccountForUpdateUnsafe(ctx context.Context, id int64) (db.Ac
 	return r0, r1
 }
 
-// Store_GetAccountForUpdateUnsafe_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAccountForUpdateUnsafe'
-type Store_GetAccountForUpdateUnsafe_Call struct {
+// Store_ListTransfers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListTransfers'
+type Store_ListTransfers_Call struct {
 	*mock.Call
 }
 
-// GetAccountForUpdateUnsafe is a helper method to define mock.On call
+// ListTransfers is a helper method to define mock.On call
 //  - ctx context.Context
-//  - id int64
-func (_e *Store_Expecter) GetAccountForUpdateUnsafe(ctx interface{}, id interface{}) *Store_GetAccountForUpdateUnsafe_Call {
-	return &Store_GetAccountForUpdateUnsafe_Call{Call: _e.mock.On("GetAccountForUpdateUnsafe", ctx, id)}
+//  - arg db.ListTransfersParams
+func (_e *Store_Expecter) ListTransfers(ctx interface{}, arg interface{}) *Store_ListTransfers_Call {
+	return &Store_ListTransfers_Call{Call: _e.mock.On("ListTransfers", ctx, arg)}
 }
 
-func (_c *Store_GetAccountForUpdateUnsafe_Call) Run(run func(ctx context.Context, id int64)) *Store_GetAccountForUpdateUnsafe_Call {
+func (_c *Store_ListTransfers_Call) Run(run func(ctx context.Context, arg db.ListTransfersParams)) *Store_ListTransfers_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int64))
+		run(args[0].(context.Context), args[1].(db.ListTransfersParams))
 	})
 	return _c
 }
 
-func (_c *Store_GetAccountForUpdateUnsafe_Call) Return(_a0 db.Account, _a1 error) *Store_GetAccountForUpdateUnsafe_Call {
+func (_c *Store_ListTransfers_Call) Return(_a0 []db.Transfer, _a1 error) *Store_ListTransfers_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *Store_GetAccountForUpdateUnsafe_Call) RunAndReturn(run func(context.Context, int64) (db.Account, error)) *Store_GetAccountForUpdateUnsafe_Call {
+func (_c *Store_ListTransfers_Call) RunAndReturn(run func(context.Context, db.ListTransfersParams) ([]db.Transfer, error)) *Store_ListTransfers_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetEntry provides a mock function with given fields: ctx, id
-func (_m *Store) GetEntry(ctx context.Context, id int64) (db.Entry, error) {
-	ret := _m.Called(ctx, id)
+// ListTransfersFrom provides a mock function with given fields: ctx, arg
+func (_m *Store) ListTransfersFrom(ctx context.Context, arg db.ListTransfersFromParams) ([]db.Transfer, error) {
+	ret := _m.Called(ctx, arg)
 
-	var r0 db.Entry
+	var r0 []db.Transfer
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64) (db.Entry, error)); ok {
-		return rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListTransfersFromParams) ([]db.Transfer, error)); ok {
+		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int64) db.Entry); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListTransfersFromParams) []db.Transfer); ok {
+		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(db.Entry)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.Transfer)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
-		r1 = rf(ctx, id)
+	if rf, ok := ret.Get(1).(func(context.Context, db.ListTransfersFromParams) error); ok {
+		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -760,52 +2782,54 @@ func (_m *Store) GetEntry(ctx context.Context, id int64) (db.Entry, error) {
 	return r0, r1
 }
 
-// Store_GetEntry_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetEntry'
-type Store_GetEntry_Call struct {
+// Store_ListTransfersFrom_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListTransfersFrom'
+type Store_ListTransfersFrom_Call struct {
 	*mock.Call
 }
 
-// GetEntry is a helper method to define mock.On call
+// ListTransfersFrom is a helper method to define mock.On call
 //  - ctx context.Context
-//  - id int64
-func (_e *Store_Expecter) GetEntry(ctx interface{}, id interface{}) *Store_GetEntry_Call {
-	return &Store_GetEntry_Call{Call: _e.mock.On("GetEntry", ctx, id)}
+//  - arg db.ListTransfersFromParams
+func (_e *Store_Expecter) ListTransfersFrom(ctx interface{}, arg interface{}) *Store_ListTransfersFrom_Call {
+	return &Store_ListTransfersFrom_Call{Call: _e.mock.On("ListTransfersFrom", ctx, arg)}
 }
 
-func (_c *Store_GetEntry_Call) Run(run func(ctx context.Context, id int64)) *Store_GetEntry_Call {
+func (_c *Store_ListTransfersFrom_Call) Run(run func(ctx context.Context, arg db.ListTransfersFromParams)) *Store_ListTransfersFrom_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int64))
+		run(args[0].(context.Context), args[1].(db.ListTransfersFromParams))
 	})
 	return _c
 }
 
-func (_c *Store_GetEntry_Call) Return(_a0 db.Entry, _a1 error) *Store_GetEntry_Call {
+func (_c *Store_ListTransfersFrom_Call) Return(_a0 []db.Transfer, _a1 error) *Store_ListTransfersFrom_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *Store_GetEntry_Call) RunAndReturn(run func(context.Context, int64) (db.Entry, error)) *Store_GetEntry_Call {
+func (_c *Store_ListTransfersFrom_Call) RunAndReturn(run func(context.Context, db.ListTransfersFromParams) ([]db.Transfer, error)) *Store_ListTransfersFrom_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetSession provides a mock function with given fields: ctx, id
-func (_m *Store) GetSession(ctx context.Context, id uuid.UUID) (db.Session, error) {
-	ret := _m.Called(ctx, id)
+// ListTransfersOfOwner provides a mock function with given fields: ctx, arg
+func (_m *Store) ListTransfersOfOwner(ctx context.Context, arg db.ListTransfersOfOwnerParams) ([]db.ListTransfersOfOwnerRow, error) {
+	ret := _m.Called(ctx, arg)
 
-	var r0 db.Session
+	var r0 []db.ListTransfersOfOwnerRow
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (db.Session, error)); ok {
-		return rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListTransfersOfOwnerParams) ([]db.ListTransfersOfOwnerRow, error)); ok {
+		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) db.Session); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListTransfersOfOwnerParams) []db.ListTransfersOfOwnerRow); ok {
+		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(db.Session)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.ListTransfersOfOwnerRow)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
-		r1 = rf(ctx, id)
+	if rf, ok := ret.Get(1).(func(context.Context, db.ListTransfersOfOwnerParams) error); ok {
+		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -813,159 +2837,247 @@ func (_m *Store) GetSession(ctx context.Context, id uuid.UUID) (db.Session, erro
 	return r0, r1
 }
 
-// Store_GetSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSession'
-type Store_GetSession_Call struct {
+// Store_ListTransfersOfOwner_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListTransfersOfOwner'
+type Store_ListTransfersOfOwner_Call struct {
+	*mock.Call
+}
+
+// ListTransfersOfOwner is a helper method to define mock.On call
+//  - ctx context.Context
+//  - arg db.ListTransfersOfOwnerParams
+func (_e *Store_Expecter) ListTransfersOfOwner(ctx interface{}, arg interface{}) *Store_ListTransfersOfOwner_Call {
+	return &Store_ListTransfersOfOwner_Call{Call: _e.mock.On("ListTransfersOfOwner", ctx, arg)}
+}
+
+func (_c *Store_ListTransfersOfOwner_Call) Run(run func(ctx context.Context, arg db.ListTransfersOfOwnerParams)) *Store_ListTransfersOfOwner_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.ListTransfersOfOwnerParams))
+	})
+	return _c
+}
+
+func (_c *Store_ListTransfersOfOwner_Call) Return(_a0 []db.ListTransfersOfOwnerRow, _a1 error) *Store_ListTransfersOfOwner_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Store_ListTransfersOfOwner_Call) RunAndReturn(run func(context.Context, db.ListTransfersOfOwnerParams) ([]db.ListTransfersOfOwnerRow, error)) *Store_ListTransfersOfOwner_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListTransfersTo provides a mock function with given fields: ctx, arg
+func (_m *Store) ListTransfersTo(ctx context.Context, arg db.ListTransfersToParams) ([]db.Transfer, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 []db.Transfer
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListTransfersToParams) ([]db.Transfer, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListTransfersToParams) []db.Transfer); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.Transfer)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.ListTransfersToParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Store_ListTransfersTo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListTransfersTo'
+type Store_ListTransfersTo_Call struct {
+	*mock.Call
+}
+
+// ListTransfersTo is a helper method to define mock.On call
+//  - ctx context.Context
+//  - arg db.ListTransfersToParams
+func (_e *Store_Expecter) ListTransfersTo(ctx interface{}, arg interface{}) *Store_ListTransfersTo_Call {
+	return &Store_ListTransfersTo_Call{Call: _e.mock.On("ListTransfersTo", ctx, arg)}
+}
+
+func (_c *Store_ListTransfersTo_Call) Run(run func(ctx context.Context, arg db.ListTransfersToParams)) *Store_ListTransfersTo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.ListTransfersToParams))
+	})
+	return _c
+}
+
+func (_c *Store_ListTransfersTo_Call) Return(_a0 []db.Transfer, _a1 error) *Store_ListTransfersTo_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Store_ListTransfersTo_Call) RunAndReturn(run func(context.Context, db.ListTransfersToParams) ([]db.Transfer, error)) *Store_ListTransfersTo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListUnbalancedJournals provides a mock function with given fields: ctx, accountID
+func (_m *Store) ListUnbalancedJournals(ctx context.Context, accountID sql.NullInt64) ([]db.ListUnbalancedJournalsRow, error) {
+	ret := _m.Called(ctx, accountID)
+
+	var r0 []db.ListUnbalancedJournalsRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, sql.NullInt64) ([]db.ListUnbalancedJournalsRow, error)); ok {
+		return rf(ctx, accountID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sql.NullInt64) []db.ListUnbalancedJournalsRow); ok {
+		r0 = rf(ctx, accountID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.ListUnbalancedJournalsRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, sql.NullInt64) error); ok {
+		r1 = rf(ctx, accountID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Store_ListUnbalancedJournals_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListUnbalancedJournals'
+type Store_ListUnbalancedJournals_Call struct {
 	*mock.Call
 }
 
-// GetSession is a helper method to define mock.On call
+// ListUnbalancedJournals is a helper method to define mock.On call
 //  - ctx context.Context
-//  - id uuid.UUID
-func (_e *Store_Expecter) GetSession(ctx interface{}, id interface{}) *Store_GetSession_Call {
-	return &Store_GetSession_Call{Call: _e.mock.On("GetSession", ctx, id)}
+//  - accountID sql.NullInt64
+func (_e *Store_Expecter) ListUnbalancedJournals(ctx interface{}, accountID interface{}) *Store_ListUnbalancedJournals_Call {
+	return &Store_ListUnbalancedJournals_Call{Call: _e.mock.On("ListUnbalancedJournals", ctx, accountID)}
 }
 
-func (_c *Store_GetSession_Call) Run(run func(ctx context.Context, id uuid.UUID)) *Store_GetSession_Call {
+func (_c *Store_ListUnbalancedJournals_Call) Run(run func(ctx context.Context, accountID sql.NullInt64)) *Store_ListUnbalancedJournals_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID))
+		run(args[0].(context.Context), args[1].(sql.NullInt64))
 	})
 	return _c
 }
 
-func (_c *Store_GetSession_Call) Return(_a0 db.Session, _a1 error) *Store_GetSession_Call {
+func (_c *Store_ListUnbalancedJournals_Call) Return(_a0 []db.ListUnbalancedJournalsRow, _a1 error) *Store_ListUnbalancedJournals_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *Store_GetSession_Call) RunAndReturn(run func(context.Context, uuid.UUID) (db.Session, error)) *Store_GetSession_Call {
+func (_c *Store_ListUnbalancedJournals_Call) RunAndReturn(run func(context.Context, sql.NullInt64) ([]db.ListUnbalancedJournalsRow, error)) *Store_ListUnbalancedJournals_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetTransfer provides a mock function with given fields: ctx, id
-func (_m *Store) GetTransfer(ctx context.Context, id int64) (db.Transfer, error) {
-	ret := _m.Called(ctx, id)
-
-	var r0 db.Transfer
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64) (db.Transfer, error)); ok {
-		return rf(ctx, id)
-	}
-	if rf, ok := ret.Get(0).(func(context.Context, int64) db.Transfer); ok {
-		r0 = rf(ctx, id)
-	} else {
-		r0 = ret.Get(0).(db.Transfer)
-	}
+// MarkOutboxFailed provides a mock function with given fields: ctx, arg
+func (_m *Store) MarkOutboxFailed(ctx context.Context, arg db.MarkOutboxFailedParams) error {
+	ret := _m.Called(ctx, arg)
 
-	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
-		r1 = rf(ctx, id)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.MarkOutboxFailedParams) error); ok {
+		r0 = rf(ctx, arg)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// Store_GetTransfer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTransfer'
-type Store_GetTransfer_Call struct {
+// Store_MarkOutboxFailed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkOutboxFailed'
+type Store_MarkOutboxFailed_Call struct {
 	*mock.Call
 }
 
-// GetTransfer is a helper method to define mock.On call
+// MarkOutboxFailed is a helper method to define mock.On call
 //  - ctx context.Context
-//  - id int64
-func (_e *Store_Expecter) GetTransfer(ctx interface{}, id interface{}) *Store_GetTransfer_Call {
-	return &Store_GetTransfer_Call{Call: _e.mock.On("GetTransfer", ctx, id)}
+//  - arg db.MarkOutboxFailedParams
+func (_e *Store_Expecter) MarkOutboxFailed(ctx interface{}, arg interface{}) *Store_MarkOutboxFailed_Call {
+	return &Store_MarkOutboxFailed_Call{Call: _e.mock.On("MarkOutboxFailed", ctx, arg)}
 }
 
-func (_c *Store_GetTransfer_Call) Run(run func(ctx context.Context, id int64)) *Store_GetTransfer_Call {
+func (_c *Store_MarkOutboxFailed_Call) Run(run func(ctx context.Context, arg db.MarkOutboxFailedParams)) *Store_MarkOutboxFailed_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int64))
+		run(args[0].(context.Context), args[1].(db.MarkOutboxFailedParams))
 	})
 	return _c
 }
 
-func (_c *Store_GetTransfer_Call) Return(_a0 db.Transfer, _a1 error) *Store_GetTransfer_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *Store_MarkOutboxFailed_Call) Return(_a0 error) *Store_MarkOutboxFailed_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *Store_GetTransfer_Call) RunAndReturn(run func(context.Context, int64) (db.Transfer, error)) *Store_GetTransfer_Call {
+func (_c *Store_MarkOutboxFailed_Call) RunAndReturn(run func(context.Context, db.MarkOutboxFailedParams) error) *Store_MarkOutboxFailed_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetUser provides a mock function with given fields: ctx, username
-func (_m *Store) GetUser(ctx context.Context, username string) (db.User, error) {
-	ret := _m.Called(ctx, username)
-
-	var r0 db.User
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (db.User, error)); ok {
-		return rf(ctx, username)
-	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) db.User); ok {
-		r0 = rf(ctx, username)
-	} else {
-		r0 = ret.Get(0).(db.User)
-	}
+// MarkOutboxSent provides a mock function with given fields: ctx, id
+func (_m *Store) MarkOutboxSent(ctx context.Context, id int64) error {
+	ret := _m.Called(ctx, id)
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, username)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, id)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// Store_GetUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUser'
-type Store_GetUser_Call struct {
+// Store_MarkOutboxSent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkOutboxSent'
+type Store_MarkOutboxSent_Call struct {
 	*mock.Call
 }
 
-// GetUser is a helper method to define mock.On call
+// MarkOutboxSent is a helper method to define mock.On call
 //  - ctx context.Context
-//  - username string
-func (_e *Store_Expecter) GetUser(ctx interface{}, username interface{}) *Store_GetUser_Call {
-	return &Store_GetUser_Call{Call: _e.mock.On("GetUser", ctx, username)}
+//  - id int64
+func (_e *Store_Expecter) MarkOutboxSent(ctx interface{}, id interface{}) *Store_MarkOutboxSent_Call {
+	return &Store_MarkOutboxSent_Call{Call: _e.mock.On("MarkOutboxSent", ctx, id)}
 }
 
-func (_c *Store_GetUser_Call) Run(run func(ctx context.Context, username string)) *Store_GetUser_Call {
+func (_c *Store_MarkOutboxSent_Call) Run(run func(ctx context.Context, id int64)) *Store_MarkOutboxSent_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(int64))
 	})
 	return _c
 }
 
-func (_c *Store_GetUser_Call) Return(_a0 db.User, _a1 error) *Store_GetUser_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *Store_MarkOutboxSent_Call) Return(_a0 error) *Store_MarkOutboxSent_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *Store_GetUser_Call) RunAndReturn(run func(context.Context, string) (db.User, error)) *Store_GetUser_Call {
+func (_c *Store_MarkOutboxSent_Call) RunAndReturn(run func(context.Context, int64) error) *Store_MarkOutboxSent_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListAccounts provides a mock function with given fields: ctx, arg
-func (_m *Store) ListAccounts(ctx context.Context, arg db.ListAccountsParams) ([]db.Account, error) {
+// ReconcileAccountTx provides a mock function with given fields: ctx, arg
+func (_m *Store) ReconcileAccountTx(ctx context.Context, arg db.ReconcileAccountTxParams) (db.ReconcileAccountTxResult, error) {
 	ret := _m.Called(ctx, arg)
 
-	var r0 []db.Account
+	var r0 db.ReconcileAccountTxResult
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, db.ListAccountsParams) ([]db.Account, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.ReconcileAccountTxParams) (db.ReconcileAccountTxResult, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, db.ListAccountsParams) []db.Account); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.ReconcileAccountTxParams) db.ReconcileAccountTxResult); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]db.Account)
-		}
+		r0 = ret.Get(0).(db.ReconcileAccountTxResult)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, db.ListAccountsParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, db.ReconcileAccountTxParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -974,53 +3086,51 @@ func (_m *Store) ListAccounts(ctx context.Context, arg db.ListAccountsParams) ([
 	return r0, r1
 }
 
-// Store_ListAccounts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListAccounts'
-type Store_ListAccounts_Call struct {
+// Store_ReconcileAccountTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReconcileAccountTx'
+type Store_ReconcileAccountTx_Call struct {
 	*mock.Call
 }
 
-// ListAccounts is a helper method to define mock.On call
+// ReconcileAccountTx is a helper method to define mock.On call
 //  - ctx context.Context
-//  - arg db.ListAccountsParams
-func (_e *Store_Expecter) ListAccounts(ctx interface{}, arg interface{}) *Store_ListAccounts_Call {
-	return &Store_ListAccounts_Call{Call: _e.mock.On("ListAccounts", ctx, arg)}
+//  - arg db.ReconcileAccountTxParams
+func (_e *Store_Expecter) ReconcileAccountTx(ctx interface{}, arg interface{}) *Store_ReconcileAccountTx_Call {
+	return &Store_ReconcileAccountTx_Call{Call: _e.mock.On("ReconcileAccountTx", ctx, arg)}
 }
 
-func (_c *Store_ListAccounts_Call) Run(run func(ctx context.Context, arg db.ListAccountsParams)) *Store_ListAccounts_Call {
+func (_c *Store_ReconcileAccountTx_Call) Run(run func(ctx context.Context, arg db.ReconcileAccountTxParams)) *Store_ReconcileAccountTx_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(db.ListAccountsParams))
+		run(args[0].(context.Context), args[1].(db.ReconcileAccountTxParams))
 	})
 	return _c
 }
 
-func (_c *Store_ListAccounts_Call) Return(_a0 []db.Account, _a1 error) *Store_ListAccounts_Call {
+func (_c *Store_ReconcileAccountTx_Call) Return(_a0 db.ReconcileAccountTxResult, _a1 error) *Store_ReconcileAccountTx_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *Store_ListAccounts_Call) RunAndReturn(run func(context.Context, db.ListAccountsParams) ([]db.Account, error)) *Store_ListAccounts_Call {
+func (_c *Store_ReconcileAccountTx_Call) RunAndReturn(run func(context.Context, db.ReconcileAccountTxParams) (db.ReconcileAccountTxResult, error)) *Store_ReconcileAccountTx_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListEntries provides a mock function with given fields: ctx, arg
-func (_m *Store) ListEntries(ctx context.Context, arg db.ListEntriesParams) ([]db.Entry, error) {
+// RecordScheduledTransferRunTx provides a mock function with given fields: ctx, arg
+func (_m *Store) RecordScheduledTransferRunTx(ctx context.Context, arg db.RecordScheduledTransferRunTxParams) (db.RecordScheduledTransferRunTxResult, error) {
 	ret := _m.Called(ctx, arg)
 
-	var r0 []db.Entry
+	var r0 db.RecordScheduledTransferRunTxResult
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, db.ListEntriesParams) ([]db.Entry, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.RecordScheduledTransferRunTxParams) (db.RecordScheduledTransferRunTxResult, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, db.ListEntriesParams) []db.Entry); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.RecordScheduledTransferRunTxParams) db.RecordScheduledTransferRunTxResult); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]db.Entry)
-		}
+		r0 = ret.Get(0).(db.RecordScheduledTransferRunTxResult)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, db.ListEntriesParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, db.RecordScheduledTransferRunTxParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -1029,53 +3139,51 @@ func (_m *Store) ListEntries(ctx context.Context, arg db.ListEntriesParams) ([]d
 	return r0, r1
 }
 
-// Store_ListEntries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListEntries'
-type Store_ListEntries_Call struct {
+// Store_RecordScheduledTransferRunTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordScheduledTransferRunTx'
+type Store_RecordScheduledTransferRunTx_Call struct {
 	*mock.Call
 }
 
-// ListEntries is a helper method to define mock.On call
+// RecordScheduledTransferRunTx is a helper method to define mock.On call
 //  - ctx context.Context
-//  - arg db.ListEntriesParams
-func (_e *Store_Expecter) ListEntries(ctx interface{}, arg interface{}) *Store_ListEntries_Call {
-	return &Store_ListEntries_Call{Call: _e.mock.On("ListEntries", ctx, arg)}
+//  - arg db.RecordScheduledTransferRunTxParams
+func (_e *Store_Expecter) RecordScheduledTransferRunTx(ctx interface{}, arg interface{}) *Store_RecordScheduledTransferRunTx_Call {
+	return &Store_RecordScheduledTransferRunTx_Call{Call: _e.mock.On("RecordScheduledTransferRunTx", ctx, arg)}
 }
 
-func (_c *Store_ListEntries_Call) Run(run func(ctx context.Context, arg db.ListEntriesParams)) *Store_ListEntries_Call {
+func (_c *Store_RecordScheduledTransferRunTx_Call) Run(run func(ctx context.Context, arg db.RecordScheduledTransferRunTxParams)) *Store_RecordScheduledTransferRunTx_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(db.ListEntriesParams))
+		run(args[0].(context.Context), args[1].(db.RecordScheduledTransferRunTxParams))
 	})
 	return _c
 }
 
-func (_c *Store_ListEntries_Call) Return(_a0 []db.Entry, _a1 error) *Store_ListEntries_Call {
+func (_c *Store_RecordScheduledTransferRunTx_Call) Return(_a0 db.RecordScheduledTransferRunTxResult, _a1 error) *Store_RecordScheduledTransferRunTx_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *Store_ListEntries_Call) RunAndReturn(run func(context.Context, db.ListEntriesParams) ([]db.Entry, error)) *Store_ListEntries_Call {
+func (_c *Store_RecordScheduledTransferRunTx_Call) RunAndReturn(run func(context.Context, db.RecordScheduledTransferRunTxParams) (db.RecordScheduledTransferRunTxResult, error)) *Store_RecordScheduledTransferRunTx_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListEntriesOf provides a mock function with given fields: ctx, arg
-func (_m *Store) ListEntriesOf(ctx context.Context, arg db.ListEntriesOfParams) ([]db.Entry, error) {
+// RelayOutbox provides a mock function with given fields: ctx, arg
+func (_m *Store) RelayOutbox(ctx context.Context, arg db.RelayOutboxParams) (db.RelayOutboxResult, error) {
 	ret := _m.Called(ctx, arg)
 
-	var r0 []db.Entry
+	var r0 db.RelayOutboxResult
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, db.ListEntriesOfParams) ([]db.Entry, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.RelayOutboxParams) (db.RelayOutboxResult, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, db.ListEntriesOfParams) []db.Entry); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.RelayOutboxParams) db.RelayOutboxResult); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]db.Entry)
-		}
+		r0 = ret.Get(0).(db.RelayOutboxResult)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, db.ListEntriesOfParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, db.RelayOutboxParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -1084,53 +3192,51 @@ func (_m *Store) ListEntriesOf(ctx context.Context, arg db.ListEntriesOfParams)
 	return r0, r1
 }
 
-// Store_ListEntriesOf_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListEntriesOf'
-type Store_ListEntriesOf_Call struct {
+// Store_RelayOutbox_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RelayOutbox'
+type Store_RelayOutbox_Call struct {
 	*mock.Call
 }
 
-// ListEntriesOf is a helper method to define mock.On call
+// RelayOutbox is a helper method to define mock.On call
 //  - ctx context.Context
-//  - arg db.ListEntriesOfParams
-func (_e *Store_Expecter) ListEntriesOf(ctx interface{}, arg interface{}) *Store_ListEntriesOf_Call {
-	return &Store_ListEntriesOf_Call{Call: _e.mock.On("ListEntriesOf", ctx, arg)}
+//  - arg db.RelayOutboxParams
+func (_e *Store_Expecter) RelayOutbox(ctx interface{}, arg interface{}) *Store_RelayOutbox_Call {
+	return &Store_RelayOutbox_Call{Call: _e.mock.On("RelayOutbox", ctx, arg)}
 }
 
-func (_c *Store_ListEntriesOf_Call) Run(run func(ctx context.Context, arg db.ListEntriesOfParams)) *Store_ListEntriesOf_Call {
+func (_c *Store_RelayOutbox_Call) Run(run func(ctx context.Context, arg db.RelayOutboxParams)) *Store_RelayOutbox_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(db.ListEntriesOfParams))
+		run(args[0].(context.Context), args[1].(db.RelayOutboxParams))
 	})
 	return _c
 }
 
-func (_c *Store_ListEntriesOf_Call) Return(_a0 []db.Entry, _a1 error) *Store_ListEntriesOf_Call {
+func (_c *Store_RelayOutbox_Call) Return(_a0 db.RelayOutboxResult, _a1 error) *Store_RelayOutbox_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *Store_ListEntriesOf_Call) RunAndReturn(run func(context.Context, db.ListEntriesOfParams) ([]db.Entry, error)) *Store_ListEntriesOf_Call {
+func (_c *Store_RelayOutbox_Call) RunAndReturn(run func(context.Context, db.RelayOutboxParams) (db.RelayOutboxResult, error)) *Store_RelayOutbox_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListTransfers provides a mock function with given fields: ctx, arg
-func (_m *Store) ListTransfers(ctx context.Context, arg db.ListTransfersParams) ([]db.Transfer, error) {
+// RenewSessionTx provides a mock function with given fields: ctx, arg
+func (_m *Store) RenewSessionTx(ctx context.Context, arg db.RenewSessionTxParams) (db.RenewSessionTxResult, error) {
 	ret := _m.Called(ctx, arg)
 
-	var r0 []db.Transfer
+	var r0 db.RenewSessionTxResult
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, db.ListTransfersParams) ([]db.Transfer, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.RenewSessionTxParams) (db.RenewSessionTxResult, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, db.ListTransfersParams) []db.Transfer); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.RenewSessionTxParams) db.RenewSessionTxResult); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]db.Transfer)
-		}
+		r0 = ret.Get(0).(db.RenewSessionTxResult)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, db.ListTransfersParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, db.RenewSessionTxParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -1139,53 +3245,51 @@ func (_m *Store) ListTransfers(ctx context.Context, arg db.ListTransfersParams)
 	return r0, r1
 }
 
-// Store_ListTransfers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListTransfers'
-type Store_ListTransfers_Call struct {
+// Store_RenewSessionTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RenewSessionTx'
+type Store_RenewSessionTx_Call struct {
 	*mock.Call
 }
 
-// ListTransfers is a helper method to define mock.On call
+// RenewSessionTx is a helper method to define mock.On call
 //  - ctx context.Context
-//  - arg db.ListTransfersParams
-func (_e *Store_Expecter) ListTransfers(ctx interface{}, arg interface{}) *Store_ListTransfers_Call {
-	return &Store_ListTransfers_Call{Call: _e.mock.On("ListTransfers", ctx, arg)}
+//  - arg db.RenewSessionTxParams
+func (_e *Store_Expecter) RenewSessionTx(ctx interface{}, arg interface{}) *Store_RenewSessionTx_Call {
+	return &Store_RenewSessionTx_Call{Call: _e.mock.On("RenewSessionTx", ctx, arg)}
 }
 
-func (_c *Store_ListTransfers_Call) Run(run func(ctx context.Context, arg db.ListTransfersParams)) *Store_ListTransfers_Call {
+func (_c *Store_RenewSessionTx_Call) Run(run func(ctx context.Context, arg db.RenewSessionTxParams)) *Store_RenewSessionTx_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(db.ListTransfersParams))
+		run(args[0].(context.Context), args[1].(db.RenewSessionTxParams))
 	})
 	return _c
 }
 
-func (_c *Store_ListTransfers_Call) Return(_a0 []db.Transfer, _a1 error) *Store_ListTransfers_Call {
+func (_c *Store_RenewSessionTx_Call) Return(_a0 db.RenewSessionTxResult, _a1 error) *Store_RenewSessionTx_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *Store_ListTransfers_Call) RunAndReturn(run func(context.Context, db.ListTransfersParams) ([]db.Transfer, error)) *Store_ListTransfers_Call {
+func (_c *Store_RenewSessionTx_Call) RunAndReturn(run func(context.Context, db.RenewSessionTxParams) (db.RenewSessionTxResult, error)) *Store_RenewSessionTx_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListTransfersFrom provides a mock function with given fields: ctx, arg
-func (_m *Store) ListTransfersFrom(ctx context.Context, arg db.ListTransfersFromParams) ([]db.Transfer, error) {
+// ReverseTransferTx provides a mock function with given fields: ctx, arg
+func (_m *Store) ReverseTransferTx(ctx context.Context, arg db.ReverseTransferTxParams) (db.ReverseTransferTxResult, error) {
 	ret := _m.Called(ctx, arg)
 
-	var r0 []db.Transfer
+	var r0 db.ReverseTransferTxResult
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, db.ListTransfersFromParams) ([]db.Transfer, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.ReverseTransferTxParams) (db.ReverseTransferTxResult, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, db.ListTransfersFromParams) []db.Transfer); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.ReverseTransferTxParams) db.ReverseTransferTxResult); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]db.Transfer)
-		}
+		r0 = ret.Get(0).(db.ReverseTransferTxResult)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, db.ListTransfersFromParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, db.ReverseTransferTxParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -1194,54 +3298,52 @@ func (_m *Store) ListTransfersFrom(ctx context.Context, arg db.ListTransfersFrom
 	return r0, r1
 }
 
-// Store_ListTransfersFrom_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListTransfersFrom'
-type Store_ListTransfersFrom_Call struct {
+// Store_ReverseTransferTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReverseTransferTx'
+type Store_ReverseTransferTx_Call struct {
 	*mock.Call
 }
 
-// ListTransfersFrom is a helper method to define mock.On call
+// ReverseTransferTx is a helper method to define mock.On call
 //  - ctx context.Context
-//  - arg db.ListTransfersFromParams
-func (_e *Store_Expecter) ListTransfersFrom(ctx interface{}, arg interface{}) *Store_ListTransfersFrom_Call {
-	return &Store_ListTransfersFrom_Call{Call: _e.mock.On("ListTransfersFrom", ctx, arg)}
+//  - arg db.ReverseTransferTxParams
+func (_e *Store_Expecter) ReverseTransferTx(ctx interface{}, arg interface{}) *Store_ReverseTransferTx_Call {
+	return &Store_ReverseTransferTx_Call{Call: _e.mock.On("ReverseTransferTx", ctx, arg)}
 }
 
-func (_c *Store_ListTransfersFrom_Call) Run(run func(ctx context.Context, arg db.ListTransfersFromParams)) *Store_ListTransfersFrom_Call {
+func (_c *Store_ReverseTransferTx_Call) Run(run func(ctx context.Context, arg db.ReverseTransferTxParams)) *Store_ReverseTransferTx_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(db.ListTransfersFromParams))
+		run(args[0].(context.Context), args[1].(db.ReverseTransferTxParams))
 	})
 	return _c
 }
 
-func (_c *Store_ListTransfersFrom_Call) Return(_a0 []db.Transfer, _a1 error) *Store_ListTransfersFrom_Call {
+func (_c *Store_ReverseTransferTx_Call) Return(_a0 db.ReverseTransferTxResult, _a1 error) *Store_ReverseTransferTx_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *Store_ListTransfersFrom_Call) RunAndReturn(run func(context.Context, db.ListTransfersFromParams) ([]db.Transfer, error)) *Store_ListTransfersFrom_Call {
+func (_c *Store_ReverseTransferTx_Call) RunAndReturn(run func(context.Context, db.ReverseTransferTxParams) (db.ReverseTransferTxResult, error)) *Store_ReverseTransferTx_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListTransfersTo provides a mock function with given fields: ctx, arg
-func (_m *Store) ListTransfersTo(ctx context.Context, arg db.ListTransfersToParams) ([]db.Transfer, error) {
-	ret := _m.Called(ctx, arg)
+// RotateSession provides a mock function with given fields: ctx, id
+func (_m *Store) RotateSession(ctx context.Context, id uuid.UUID) (db.Session, error) {
+	ret := _m.Called(ctx, id)
 
-	var r0 []db.Transfer
+	var r0 db.Session
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, db.ListTransfersToParams) ([]db.Transfer, error)); ok {
-		return rf(ctx, arg)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (db.Session, error)); ok {
+		return rf(ctx, id)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, db.ListTransfersToParams) []db.Transfer); ok {
-		r0 = rf(ctx, arg)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) db.Session); ok {
+		r0 = rf(ctx, id)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]db.Transfer)
-		}
+		r0 = ret.Get(0).(db.Session)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, db.ListTransfersToParams) error); ok {
-		r1 = rf(ctx, arg)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1249,31 +3351,31 @@ func (_m *Store) ListTransfersTo(ctx context.Context, arg db.ListTransfersToPara
 	return r0, r1
 }
 
-// Store_ListTransfersTo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListTransfersTo'
-type Store_ListTransfersTo_Call struct {
+// Store_RotateSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RotateSession'
+type Store_RotateSession_Call struct {
 	*mock.Call
 }
 
-// ListTransfersTo is a helper method to define mock.On call
+// RotateSession is a helper method to define mock.On call
 //  - ctx context.Context
-//  - arg db.ListTransfersToParams
-func (_e *Store_Expecter) ListTransfersTo(ctx interface{}, arg interface{}) *Store_ListTransfersTo_Call {
-	return &Store_ListTransfersTo_Call{Call: _e.mock.On("ListTransfersTo", ctx, arg)}
+//  - id uuid.UUID
+func (_e *Store_Expecter) RotateSession(ctx interface{}, id interface{}) *Store_RotateSession_Call {
+	return &Store_RotateSession_Call{Call: _e.mock.On("RotateSession", ctx, id)}
 }
 
-func (_c *Store_ListTransfersTo_Call) Run(run func(ctx context.Context, arg db.ListTransfersToParams)) *Store_ListTransfersTo_Call {
+func (_c *Store_RotateSession_Call) Run(run func(ctx context.Context, id uuid.UUID)) *Store_RotateSession_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(db.ListTransfersToParams))
+		run(args[0].(context.Context), args[1].(uuid.UUID))
 	})
 	return _c
 }
 
-func (_c *Store_ListTransfersTo_Call) Return(_a0 []db.Transfer, _a1 error) *Store_ListTransfersTo_Call {
+func (_c *Store_RotateSession_Call) Return(_a0 db.Session, _a1 error) *Store_RotateSession_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *Store_ListTransfersTo_Call) RunAndReturn(run func(context.Context, db.ListTransfersToParams) ([]db.Transfer, error)) *Store_ListTransfersTo_Call {
+func (_c *Store_RotateSession_Call) RunAndReturn(run func(context.Context, uuid.UUID) (db.Session, error)) *Store_RotateSession_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -1331,22 +3433,22 @@ func (_c *Store_TransferTx_Call) RunAndReturn(run func(context.Context, db.Trans
 	return _c
 }
 
-// UpdateAccount provides a mock function with given fields: ctx, arg
-func (_m *Store) UpdateAccount(ctx context.Context, arg db.UpdateAccountParams) (db.Account, error) {
+// UpdateAccountOverdraftLimit provides a mock function with given fields: ctx, arg
+func (_m *Store) UpdateAccountOverdraftLimit(ctx context.Context, arg db.UpdateAccountOverdraftLimitParams) (db.Account, error) {
 	ret := _m.Called(ctx, arg)
 
 	var r0 db.Account
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateAccountParams) (db.Account, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateAccountOverdraftLimitParams) (db.Account, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateAccountParams) db.Account); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateAccountOverdraftLimitParams) db.Account); ok {
 		r0 = rf(ctx, arg)
 	} else {
 		r0 = ret.Get(0).(db.Account)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, db.UpdateAccountParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, db.UpdateAccountOverdraftLimitParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -1355,51 +3457,51 @@ func (_m *Store) UpdateAccount(ctx context.Context, arg db.UpdateAccountParams)
 	return r0, r1
 }
 
-// Store_UpdateAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateAccount'
-type Store_UpdateAccount_Call struct {
+// Store_UpdateAccountOverdraftLimit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateAccountOverdraftLimit'
+type Store_UpdateAccountOverdraftLimit_Call struct {
 	*mock.Call
 }
 
-// UpdateAccount is a helper method to define mock.On call
+// UpdateAccountOverdraftLimit is a helper method to define mock.On call
 //  - ctx context.Context
-//  - arg db.UpdateAccountParams
-func (_e *Store_Expecter) UpdateAccount(ctx interface{}, arg interface{}) *Store_UpdateAccount_Call {
-	return &Store_UpdateAccount_Call{Call: _e.mock.On("UpdateAccount", ctx, arg)}
+//  - arg db.UpdateAccountOverdraftLimitParams
+func (_e *Store_Expecter) UpdateAccountOverdraftLimit(ctx interface{}, arg interface{}) *Store_UpdateAccountOverdraftLimit_Call {
+	return &Store_UpdateAccountOverdraftLimit_Call{Call: _e.mock.On("UpdateAccountOverdraftLimit", ctx, arg)}
 }
 
-func (_c *Store_UpdateAccount_Call) Run(run func(ctx context.Context, arg db.UpdateAccountParams)) *Store_UpdateAccount_Call {
+func (_c *Store_UpdateAccountOverdraftLimit_Call) Run(run func(ctx context.Context, arg db.UpdateAccountOverdraftLimitParams)) *Store_UpdateAccountOverdraftLimit_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(db.UpdateAccountParams))
+		run(args[0].(context.Context), args[1].(db.UpdateAccountOverdraftLimitParams))
 	})
 	return _c
 }
 
-func (_c *Store_UpdateAccount_Call) Return(_a0 db.Account, _a1 error) *Store_UpdateAccount_Call {
+func (_c *Store_UpdateAccountOverdraftLimit_Call) Return(_a0 db.Account, _a1 error) *Store_UpdateAccountOverdraftLimit_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *Store_UpdateAccount_Call) RunAndReturn(run func(context.Context, db.UpdateAccountParams) (db.Account, error)) *Store_UpdateAccount_Call {
+func (_c *Store_UpdateAccountOverdraftLimit_Call) RunAndReturn(run func(context.Context, db.UpdateAccountOverdraftLimitParams) (db.Account, error)) *Store_UpdateAccountOverdraftLimit_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateEntry provides a mock function with given fields: ctx, arg
-func (_m *Store) UpdateEntry(ctx context.Context, arg db.UpdateEntryParams) (db.Entry, error) {
+// UpdateAccountStatus provides a mock function with given fields: ctx, arg
+func (_m *Store) UpdateAccountStatus(ctx context.Context, arg db.UpdateAccountStatusParams) (db.Account, error) {
 	ret := _m.Called(ctx, arg)
 
-	var r0 db.Entry
+	var r0 db.Account
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateEntryParams) (db.Entry, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateAccountStatusParams) (db.Account, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateEntryParams) db.Entry); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateAccountStatusParams) db.Account); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(db.Entry)
+		r0 = ret.Get(0).(db.Account)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, db.UpdateEntryParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, db.UpdateAccountStatusParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -1408,51 +3510,51 @@ func (_m *Store) UpdateEntry(ctx context.Context, arg db.UpdateEntryParams) (db.
 	return r0, r1
 }
 
-// Store_UpdateEntry_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateEntry'
-type Store_UpdateEntry_Call struct {
+// Store_UpdateAccountStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateAccountStatus'
+type Store_UpdateAccountStatus_Call struct {
 	*mock.Call
 }
 
-// UpdateEntry is a helper method to define mock.On call
+// UpdateAccountStatus is a helper method to define mock.On call
 //  - ctx context.Context
-//  - arg db.UpdateEntryParams
-func (_e *Store_Expecter) UpdateEntry(ctx interface{}, arg interface{}) *Store_UpdateEntry_Call {
-	return &Store_UpdateEntry_Call{Call: _e.mock.On("UpdateEntry", ctx, arg)}
+//  - arg db.UpdateAccountStatusParams
+func (_e *Store_Expecter) UpdateAccountStatus(ctx interface{}, arg interface{}) *Store_UpdateAccountStatus_Call {
+	return &Store_UpdateAccountStatus_Call{Call: _e.mock.On("UpdateAccountStatus", ctx, arg)}
 }
 
-func (_c *Store_UpdateEntry_Call) Run(run func(ctx context.Context, arg db.UpdateEntryParams)) *Store_UpdateEntry_Call {
+func (_c *Store_UpdateAccountStatus_Call) Run(run func(ctx context.Context, arg db.UpdateAccountStatusParams)) *Store_UpdateAccountStatus_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(db.UpdateEntryParams))
+		run(args[0].(context.Context), args[1].(db.UpdateAccountStatusParams))
 	})
 	return _c
 }
 
-func (_c *Store_UpdateEntry_Call) Return(_a0 db.Entry, _a1 error) *Store_UpdateEntry_Call {
+func (_c *Store_UpdateAccountStatus_Call) Return(_a0 db.Account, _a1 error) *Store_UpdateAccountStatus_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *Store_UpdateEntry_Call) RunAndReturn(run func(context.Context, db.UpdateEntryParams) (db.Entry, error)) *Store_UpdateEntry_Call {
+func (_c *Store_UpdateAccountStatus_Call) RunAndReturn(run func(context.Context, db.UpdateAccountStatusParams) (db.Account, error)) *Store_UpdateAccountStatus_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateTransferAmount provides a mock function with given fields: ctx, arg
-func (_m *Store) UpdateTransferAmount(ctx context.Context, arg db.UpdateTransferAmountParams) (db.Transfer, error) {
+// UpdateAccountStatusTx provides a mock function with given fields: ctx, arg
+func (_m *Store) UpdateAccountStatusTx(ctx context.Context, arg db.UpdateAccountStatusTxParams) (db.UpdateAccountStatusTxResult, error) {
 	ret := _m.Called(ctx, arg)
 
-	var r0 db.Transfer
+	var r0 db.UpdateAccountStatusTxResult
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateTransferAmountParams) (db.Transfer, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateAccountStatusTxParams) (db.UpdateAccountStatusTxResult, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateTransferAmountParams) db.Transfer); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateAccountStatusTxParams) db.UpdateAccountStatusTxResult); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(db.Transfer)
+		r0 = ret.Get(0).(db.UpdateAccountStatusTxResult)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, db.UpdateTransferAmountParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, db.UpdateAccountStatusTxParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -1461,51 +3563,51 @@ func (_m *Store) UpdateTransferAmount(ctx context.Context, arg db.UpdateTransfer
 	return r0, r1
 }
 
-// Store_UpdateTransferAmount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTransferAmount'
-type Store_UpdateTransferAmount_Call struct {
+// Store_UpdateAccountStatusTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateAccountStatusTx'
+type Store_UpdateAccountStatusTx_Call struct {
 	*mock.Call
 }
 
-// UpdateTransferAmount is a helper method to define mock.On call
+// UpdateAccountStatusTx is a helper method to define mock.On call
 //  - ctx context.Context
-//  - arg db.UpdateTransferAmountParams
-func (_e *Store_Expecter) UpdateTransferAmount(ctx interface{}, arg interface{}) *Store_UpdateTransferAmount_Call {
-	return &Store_UpdateTransferAmount_Call{Call: _e.mock.On("UpdateTransferAmount", ctx, arg)}
+//  - arg db.UpdateAccountStatusTxParams
+func (_e *Store_Expecter) UpdateAccountStatusTx(ctx interface{}, arg interface{}) *Store_UpdateAccountStatusTx_Call {
+	return &Store_UpdateAccountStatusTx_Call{Call: _e.mock.On("UpdateAccountStatusTx", ctx, arg)}
 }
 
-func (_c *Store_UpdateTransferAmount_Call) Run(run func(ctx context.Context, arg db.UpdateTransferAmountParams)) *Store_UpdateTransferAmount_Call {
+func (_c *Store_UpdateAccountStatusTx_Call) Run(run func(ctx context.Context, arg db.UpdateAccountStatusTxParams)) *Store_UpdateAccountStatusTx_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(db.UpdateTransferAmountParams))
+		run(args[0].(context.Context), args[1].(db.UpdateAccountStatusTxParams))
 	})
 	return _c
 }
 
-func (_c *Store_UpdateTransferAmount_Call) Return(_a0 db.Transfer, _a1 error) *Store_UpdateTransferAmount_Call {
+func (_c *Store_UpdateAccountStatusTx_Call) Return(_a0 db.UpdateAccountStatusTxResult, _a1 error) *Store_UpdateAccountStatusTx_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *Store_UpdateTransferAmount_Call) RunAndReturn(run func(context.Context, db.UpdateTransferAmountParams) (db.Transfer, error)) *Store_UpdateTransferAmount_Call {
+func (_c *Store_UpdateAccountStatusTx_Call) RunAndReturn(run func(context.Context, db.UpdateAccountStatusTxParams) (db.UpdateAccountStatusTxResult, error)) *Store_UpdateAccountStatusTx_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateTransferFrom provides a mock function with given fields: ctx, arg
-func (_m *Store) UpdateTransferFrom(ctx context.Context, arg db.UpdateTransferFromParams) (db.Transfer, error) {
+// UpdateIdempotencyKeyResponse provides a mock function with given fields: ctx, arg
+func (_m *Store) UpdateIdempotencyKeyResponse(ctx context.Context, arg db.UpdateIdempotencyKeyResponseParams) (db.IdempotencyKey, error) {
 	ret := _m.Called(ctx, arg)
 
-	var r0 db.Transfer
+	var r0 db.IdempotencyKey
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateTransferFromParams) (db.Transfer, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateIdempotencyKeyResponseParams) (db.IdempotencyKey, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateTransferFromParams) db.Transfer); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateIdempotencyKeyResponseParams) db.IdempotencyKey); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(db.Transfer)
+		r0 = ret.Get(0).(db.IdempotencyKey)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, db.UpdateTransferFromParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, db.UpdateIdempotencyKeyResponseParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -1514,51 +3616,104 @@ func (_m *Store) UpdateTransferFrom(ctx context.Context, arg db.UpdateTransferFr
 	return r0, r1
 }
 
-// Store_UpdateTransferFrom_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTransferFrom'
-type Store_UpdateTransferFrom_Call struct {
+// Store_UpdateIdempotencyKeyResponse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateIdempotencyKeyResponse'
+type Store_UpdateIdempotencyKeyResponse_Call struct {
 	*mock.Call
 }
 
-// UpdateTransferFrom is a helper method to define mock.On call
+// UpdateIdempotencyKeyResponse is a helper method to define mock.On call
 //  - ctx context.Context
-//  - arg db.UpdateTransferFromParams
-func (_e *Store_Expecter) UpdateTransferFrom(ctx interface{}, arg interface{}) *Store_UpdateTransferFrom_Call {
-	return &Store_UpdateTransferFrom_Call{Call: _e.mock.On("UpdateTransferFrom", ctx, arg)}
+//  - arg db.UpdateIdempotencyKeyResponseParams
+func (_e *Store_Expecter) UpdateIdempotencyKeyResponse(ctx interface{}, arg interface{}) *Store_UpdateIdempotencyKeyResponse_Call {
+	return &Store_UpdateIdempotencyKeyResponse_Call{Call: _e.mock.On("UpdateIdempotencyKeyResponse", ctx, arg)}
 }
 
-func (_c *Store_UpdateTransferFrom_Call) Run(run func(ctx context.Context, arg db.UpdateTransferFromParams)) *Store_UpdateTransferFrom_Call {
+func (_c *Store_UpdateIdempotencyKeyResponse_Call) Run(run func(ctx context.Context, arg db.UpdateIdempotencyKeyResponseParams)) *Store_UpdateIdempotencyKeyResponse_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(db.UpdateTransferFromParams))
+		run(args[0].(context.Context), args[1].(db.UpdateIdempotencyKeyResponseParams))
 	})
 	return _c
 }
 
-func (_c *Store_UpdateTransferFrom_Call) Return(_a0 db.Transfer, _a1 error) *Store_UpdateTransferFrom_Call {
+func (_c *Store_UpdateIdempotencyKeyResponse_Call) Return(_a0 db.IdempotencyKey, _a1 error) *Store_UpdateIdempotencyKeyResponse_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *Store_UpdateTransferFrom_Call) RunAndReturn(run func(context.Context, db.UpdateTransferFromParams) (db.Transfer, error)) *Store_UpdateTransferFrom_Call {
+func (_c *Store_UpdateIdempotencyKeyResponse_Call) RunAndReturn(run func(context.Context, db.UpdateIdempotencyKeyResponseParams) (db.IdempotencyKey, error)) *Store_UpdateIdempotencyKeyResponse_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateTransferTo provides a mock function with given fields: ctx, arg
-func (_m *Store) UpdateTransferTo(ctx context.Context, arg db.UpdateTransferToParams) (db.Transfer, error) {
+// UpdateScheduledTransferNextRunAt provides a mock function with given fields: ctx, arg
+func (_m *Store) UpdateScheduledTransferNextRunAt(ctx context.Context, arg db.UpdateScheduledTransferNextRunAtParams) (db.ScheduledTransfer, error) {
 	ret := _m.Called(ctx, arg)
 
-	var r0 db.Transfer
+	var r0 db.ScheduledTransfer
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateTransferToParams) (db.Transfer, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateScheduledTransferNextRunAtParams) (db.ScheduledTransfer, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateTransferToParams) db.Transfer); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateScheduledTransferNextRunAtParams) db.ScheduledTransfer); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(db.Transfer)
+		r0 = ret.Get(0).(db.ScheduledTransfer)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.UpdateScheduledTransferNextRunAtParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Store_UpdateScheduledTransferNextRunAt_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateScheduledTransferNextRunAt'
+type Store_UpdateScheduledTransferNextRunAt_Call struct {
+	*mock.Call
+}
+
+// UpdateScheduledTransferNextRunAt is a helper method to define mock.On call
+//  - ctx context.Context
+//  - arg db.UpdateScheduledTransferNextRunAtParams
+func (_e *Store_Expecter) UpdateScheduledTransferNextRunAt(ctx interface{}, arg interface{}) *Store_UpdateScheduledTransferNextRunAt_Call {
+	return &Store_UpdateScheduledTransferNextRunAt_Call{Call: _e.mock.On("UpdateScheduledTransferNextRunAt", ctx, arg)}
+}
+
+func (_c *Store_UpdateScheduledTransferNextRunAt_Call) Run(run func(ctx context.Context, arg db.UpdateScheduledTransferNextRunAtParams)) *Store_UpdateScheduledTransferNextRunAt_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.UpdateScheduledTransferNextRunAtParams))
+	})
+	return _c
+}
+
+func (_c *Store_UpdateScheduledTransferNextRunAt_Call) Return(_a0 db.ScheduledTransfer, _a1 error) *Store_UpdateScheduledTransferNextRunAt_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Store_UpdateScheduledTransferNextRunAt_Call) RunAndReturn(run func(context.Context, db.UpdateScheduledTransferNextRunAtParams) (db.ScheduledTransfer, error)) *Store_UpdateScheduledTransferNextRunAt_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateScheduledTransferStatus provides a mock function with given fields: ctx, arg
+func (_m *Store) UpdateScheduledTransferStatus(ctx context.Context, arg db.UpdateScheduledTransferStatusParams) (db.ScheduledTransfer, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 db.ScheduledTransfer
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateScheduledTransferStatusParams) (db.ScheduledTransfer, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateScheduledTransferStatusParams) db.ScheduledTransfer); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(db.ScheduledTransfer)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, db.UpdateTransferToParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, db.UpdateScheduledTransferStatusParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -1567,31 +3722,31 @@ func (_m *Store) UpdateTransferTo(ctx context.Context, arg db.UpdateTransferToPa
 	return r0, r1
 }
 
-// Store_UpdateTransferTo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTransferTo'
-type Store_UpdateTransferTo_Call struct {
+// Store_UpdateScheduledTransferStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateScheduledTransferStatus'
+type Store_UpdateScheduledTransferStatus_Call struct {
 	*mock.Call
 }
 
-// UpdateTransferTo is a helper method to define mock.On call
+// UpdateScheduledTransferStatus is a helper method to define mock.On call
 //  - ctx context.Context
-//  - arg db.UpdateTransferToParams
-func (_e *Store_Expecter) UpdateTransferTo(ctx interface{}, arg interface{}) *Store_UpdateTransferTo_Call {
-	return &Store_UpdateTransferTo_Call{Call: _e.mock.On("UpdateTransferTo", ctx, arg)}
+//  - arg db.UpdateScheduledTransferStatusParams
+func (_e *Store_Expecter) UpdateScheduledTransferStatus(ctx interface{}, arg interface{}) *Store_UpdateScheduledTransferStatus_Call {
+	return &Store_UpdateScheduledTransferStatus_Call{Call: _e.mock.On("UpdateScheduledTransferStatus", ctx, arg)}
 }
 
-func (_c *Store_UpdateTransferTo_Call) Run(run func(ctx context.Context, arg db.UpdateTransferToParams)) *Store_UpdateTransferTo_Call {
+func (_c *Store_UpdateScheduledTransferStatus_Call) Run(run func(ctx context.Context, arg db.UpdateScheduledTransferStatusParams)) *Store_UpdateScheduledTransferStatus_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(db.UpdateTransferToParams))
+		run(args[0].(context.Context), args[1].(db.UpdateScheduledTransferStatusParams))
 	})
 	return _c
 }
 
-func (_c *Store_UpdateTransferTo_Call) Return(_a0 db.Transfer, _a1 error) *Store_UpdateTransferTo_Call {
+func (_c *Store_UpdateScheduledTransferStatus_Call) Return(_a0 db.ScheduledTransfer, _a1 error) *Store_UpdateScheduledTransferStatus_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *Store_UpdateTransferTo_Call) RunAndReturn(run func(context.Context, db.UpdateTransferToParams) (db.Transfer, error)) *Store_UpdateTransferTo_Call {
+func (_c *Store_UpdateScheduledTransferStatus_Call) RunAndReturn(run func(context.Context, db.UpdateScheduledTransferStatusParams) (db.ScheduledTransfer, error)) *Store_UpdateScheduledTransferStatus_Call {
 	_c.Call.Return(run)
 	return _c
 }
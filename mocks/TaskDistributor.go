@@ -8,8 +8,6 @@ import (
 	asynq "github.com/hibiken/asynq"
 
 	mock "github.com/stretchr/testify/mock"
-
-	worker "github.com/tgfukuda/be-master/worker"
 )
 
 // TaskDistributor is an autogenerated mock type for the TaskDistributor type
@@ -25,20 +23,61 @@ func (_m *TaskDistributor) EXPECT() *TaskDistributor_Expecter {
 	return &TaskDistributor_Expecter{mock: &_m.Mock}
 }
 
-// DistributeTaskSendVerifyEmail provides a mock function with given fields: ctx, payload, opts
-func (_m *TaskDistributor) DistributeTaskSendVerifyEmail(ctx context.Context, payload *worker.PayloadSendVerifyEmail, opts ...asynq.Option) error {
+// Close provides a mock function with given fields:
+func (_m *TaskDistributor) Close() error {
+	ret := _m.Called()
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TaskDistributor_Close_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Close'
+type TaskDistributor_Close_Call struct {
+	*mock.Call
+}
+
+// Close is a helper method to define mock.On call
+func (_e *TaskDistributor_Expecter) Close() *TaskDistributor_Close_Call {
+	return &TaskDistributor_Close_Call{Call: _e.mock.On("Close")}
+}
+
+func (_c *TaskDistributor_Close_Call) Run(run func()) *TaskDistributor_Close_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *TaskDistributor_Close_Call) Return(_a0 error) *TaskDistributor_Close_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TaskDistributor_Close_Call) RunAndReturn(run func() error) *TaskDistributor_Close_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DistributeTask provides a mock function with given fields: ctx, taskType, payload, opts
+func (_m *TaskDistributor) DistributeTask(ctx context.Context, taskType string, payload []byte, opts ...asynq.Option) error {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
 	}
 	var _ca []interface{}
-	_ca = append(_ca, ctx, payload)
+	_ca = append(_ca, ctx, taskType, payload)
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *worker.PayloadSendVerifyEmail, ...asynq.Option) error); ok {
-		r0 = rf(ctx, payload, opts...)
+	if rf, ok := ret.Get(0).(func(context.Context, string, []byte, ...asynq.Option) error); ok {
+		r0 = rf(ctx, taskType, payload, opts...)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -46,39 +85,40 @@ func (_m *TaskDistributor) DistributeTaskSendVerifyEmail(ctx context.Context, pa
 	return r0
 }
 
-// TaskDistributor_DistributeTaskSendVerifyEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DistributeTaskSendVerifyEmail'
-type TaskDistributor_DistributeTaskSendVerifyEmail_Call struct {
+// TaskDistributor_DistributeTask_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DistributeTask'
+type TaskDistributor_DistributeTask_Call struct {
 	*mock.Call
 }
 
-// DistributeTaskSendVerifyEmail is a helper method to define mock.On call
+// DistributeTask is a helper method to define mock.On call
 //  - ctx context.Context
-//  - payload *worker.PayloadSendVerifyEmail
+//  - taskType string
+//  - payload []byte
 //  - opts ...asynq.Option
-func (_e *TaskDistributor_Expecter) DistributeTaskSendVerifyEmail(ctx interface{}, payload interface{}, opts ...interface{}) *TaskDistributor_DistributeTaskSendVerifyEmail_Call {
-	return &TaskDistributor_DistributeTaskSendVerifyEmail_Call{Call: _e.mock.On("DistributeTaskSendVerifyEmail",
-		append([]interface{}{ctx, payload}, opts...)...)}
+func (_e *TaskDistributor_Expecter) DistributeTask(ctx interface{}, taskType interface{}, payload interface{}, opts ...interface{}) *TaskDistributor_DistributeTask_Call {
+	return &TaskDistributor_DistributeTask_Call{Call: _e.mock.On("DistributeTask",
+		append([]interface{}{ctx, taskType, payload}, opts...)...)}
 }
 
-func (_c *TaskDistributor_DistributeTaskSendVerifyEmail_Call) Run(run func(ctx context.Context, payload *worker.PayloadSendVerifyEmail, opts ...asynq.Option)) *TaskDistributor_DistributeTaskSendVerifyEmail_Call {
+func (_c *TaskDistributor_DistributeTask_Call) Run(run func(ctx context.Context, taskType string, payload []byte, opts ...asynq.Option)) *TaskDistributor_DistributeTask_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		variadicArgs := make([]asynq.Option, len(args)-2)
-		for i, a := range args[2:] {
+		variadicArgs := make([]asynq.Option, len(args)-3)
+		for i, a := range args[3:] {
 			if a != nil {
 				variadicArgs[i] = a.(asynq.Option)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*worker.PayloadSendVerifyEmail), variadicArgs...)
+		run(args[0].(context.Context), args[1].(string), args[2].([]byte), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *TaskDistributor_DistributeTaskSendVerifyEmail_Call) Return(_a0 error) *TaskDistributor_DistributeTaskSendVerifyEmail_Call {
+func (_c *TaskDistributor_DistributeTask_Call) Return(_a0 error) *TaskDistributor_DistributeTask_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *TaskDistributor_DistributeTaskSendVerifyEmail_Call) RunAndReturn(run func(context.Context, *worker.PayloadSendVerifyEmail, ...asynq.Option) error) *TaskDistributor_DistributeTaskSendVerifyEmail_Call {
+func (_c *TaskDistributor_DistributeTask_Call) RunAndReturn(run func(context.Context, string, []byte, ...asynq.Option) error) *TaskDistributor_DistributeTask_Call {
 	_c.Call.Return(run)
 	return _c
 }
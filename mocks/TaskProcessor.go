@@ -23,6 +23,92 @@ func (_m *TaskProcessor) EXPECT() *TaskProcessor_Expecter {
 	return &TaskProcessor_Expecter{mock: &_m.Mock}
 }
 
+// ProcessTaskReconcileLedger provides a mock function with given fields: ctx, task
+func (_m *TaskProcessor) ProcessTaskReconcileLedger(ctx context.Context, task *asynq.Task) error {
+	ret := _m.Called(ctx, task)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *asynq.Task) error); ok {
+		r0 = rf(ctx, task)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TaskProcessor_ProcessTaskReconcileLedger_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProcessTaskReconcileLedger'
+type TaskProcessor_ProcessTaskReconcileLedger_Call struct {
+	*mock.Call
+}
+
+// ProcessTaskReconcileLedger is a helper method to define mock.On call
+//  - ctx context.Context
+//  - task *asynq.Task
+func (_e *TaskProcessor_Expecter) ProcessTaskReconcileLedger(ctx interface{}, task interface{}) *TaskProcessor_ProcessTaskReconcileLedger_Call {
+	return &TaskProcessor_ProcessTaskReconcileLedger_Call{Call: _e.mock.On("ProcessTaskReconcileLedger", ctx, task)}
+}
+
+func (_c *TaskProcessor_ProcessTaskReconcileLedger_Call) Run(run func(ctx context.Context, task *asynq.Task)) *TaskProcessor_ProcessTaskReconcileLedger_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*asynq.Task))
+	})
+	return _c
+}
+
+func (_c *TaskProcessor_ProcessTaskReconcileLedger_Call) Return(_a0 error) *TaskProcessor_ProcessTaskReconcileLedger_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TaskProcessor_ProcessTaskReconcileLedger_Call) RunAndReturn(run func(context.Context, *asynq.Task) error) *TaskProcessor_ProcessTaskReconcileLedger_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ProcessTaskRunScheduledTransfers provides a mock function with given fields: ctx, task
+func (_m *TaskProcessor) ProcessTaskRunScheduledTransfers(ctx context.Context, task *asynq.Task) error {
+	ret := _m.Called(ctx, task)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *asynq.Task) error); ok {
+		r0 = rf(ctx, task)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TaskProcessor_ProcessTaskRunScheduledTransfers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProcessTaskRunScheduledTransfers'
+type TaskProcessor_ProcessTaskRunScheduledTransfers_Call struct {
+	*mock.Call
+}
+
+// ProcessTaskRunScheduledTransfers is a helper method to define mock.On call
+//  - ctx context.Context
+//  - task *asynq.Task
+func (_e *TaskProcessor_Expecter) ProcessTaskRunScheduledTransfers(ctx interface{}, task interface{}) *TaskProcessor_ProcessTaskRunScheduledTransfers_Call {
+	return &TaskProcessor_ProcessTaskRunScheduledTransfers_Call{Call: _e.mock.On("ProcessTaskRunScheduledTransfers", ctx, task)}
+}
+
+func (_c *TaskProcessor_ProcessTaskRunScheduledTransfers_Call) Run(run func(ctx context.Context, task *asynq.Task)) *TaskProcessor_ProcessTaskRunScheduledTransfers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*asynq.Task))
+	})
+	return _c
+}
+
+func (_c *TaskProcessor_ProcessTaskRunScheduledTransfers_Call) Return(_a0 error) *TaskProcessor_ProcessTaskRunScheduledTransfers_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TaskProcessor_ProcessTaskRunScheduledTransfers_Call) RunAndReturn(run func(context.Context, *asynq.Task) error) *TaskProcessor_ProcessTaskRunScheduledTransfers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ProcessTaskSendVerifyEmail provides a mock function with given fields: ctx, task
 func (_m *TaskProcessor) ProcessTaskSendVerifyEmail(ctx context.Context, task *asynq.Task) error {
 	ret := _m.Called(ctx, task)
@@ -66,6 +152,38 @@ func (_c *TaskProcessor_ProcessTaskSendVerifyEmail_Call) RunAndReturn(run func(c
 	return _c
 }
 
+// Shutdown provides a mock function with given fields:
+func (_m *TaskProcessor) Shutdown() {
+	_m.Called()
+}
+
+// TaskProcessor_Shutdown_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Shutdown'
+type TaskProcessor_Shutdown_Call struct {
+	*mock.Call
+}
+
+// Shutdown is a helper method to define mock.On call
+func (_e *TaskProcessor_Expecter) Shutdown() *TaskProcessor_Shutdown_Call {
+	return &TaskProcessor_Shutdown_Call{Call: _e.mock.On("Shutdown")}
+}
+
+func (_c *TaskProcessor_Shutdown_Call) Run(run func()) *TaskProcessor_Shutdown_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *TaskProcessor_Shutdown_Call) Return() *TaskProcessor_Shutdown_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *TaskProcessor_Shutdown_Call) RunAndReturn(run func()) *TaskProcessor_Shutdown_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Start provides a mock function with given fields:
 func (_m *TaskProcessor) Start() error {
 	ret := _m.Called()
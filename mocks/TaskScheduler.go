@@ -0,0 +1,105 @@
+// Code generated by mockery v2.36.0. DO NOT EDIT.
+
+package mocks
+
+import mock "github.com/stretchr/testify/mock"
+
+// TaskScheduler is an autogenerated mock type for the TaskScheduler type
+type TaskScheduler struct {
+	mock.Mock
+}
+
+type TaskScheduler_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *TaskScheduler) EXPECT() *TaskScheduler_Expecter {
+	return &TaskScheduler_Expecter{mock: &_m.Mock}
+}
+
+// Shutdown provides a mock function with given fields:
+func (_m *TaskScheduler) Shutdown() {
+	_m.Called()
+}
+
+// TaskScheduler_Shutdown_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Shutdown'
+type TaskScheduler_Shutdown_Call struct {
+	*mock.Call
+}
+
+// Shutdown is a helper method to define mock.On call
+func (_e *TaskScheduler_Expecter) Shutdown() *TaskScheduler_Shutdown_Call {
+	return &TaskScheduler_Shutdown_Call{Call: _e.mock.On("Shutdown")}
+}
+
+func (_c *TaskScheduler_Shutdown_Call) Run(run func()) *TaskScheduler_Shutdown_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *TaskScheduler_Shutdown_Call) Return() *TaskScheduler_Shutdown_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *TaskScheduler_Shutdown_Call) RunAndReturn(run func()) *TaskScheduler_Shutdown_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Start provides a mock function with given fields:
+func (_m *TaskScheduler) Start() error {
+	ret := _m.Called()
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TaskScheduler_Start_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Start'
+type TaskScheduler_Start_Call struct {
+	*mock.Call
+}
+
+// Start is a helper method to define mock.On call
+func (_e *TaskScheduler_Expecter) Start() *TaskScheduler_Start_Call {
+	return &TaskScheduler_Start_Call{Call: _e.mock.On("Start")}
+}
+
+func (_c *TaskScheduler_Start_Call) Run(run func()) *TaskScheduler_Start_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *TaskScheduler_Start_Call) Return(_a0 error) *TaskScheduler_Start_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TaskScheduler_Start_Call) RunAndReturn(run func() error) *TaskScheduler_Start_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewTaskScheduler creates a new instance of TaskScheduler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTaskScheduler(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TaskScheduler {
+	mock := &TaskScheduler{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,104 @@
+package token
+
+import (
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// JWK of an Ed25519 public key (RFC 8037)
+type JWK struct {
+	Kty string `json:"kty"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+}
+
+// JWKS is the document served at /.well-known/jwks.json
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
+
+func newJWK(publicKey ed25519.PublicKey) JWK {
+	return JWK{
+		Kty: "OKP",
+		Crv: "Ed25519",
+		X:   base64.RawURLEncoding.EncodeToString(publicKey),
+		Kid: KeyID(publicKey),
+		Use: "sig",
+		Alg: "EdDSA",
+	}
+}
+
+// publicKey decodes the key and checks the kid matches it
+func (jwk JWK) publicKey() (ed25519.PublicKey, error) {
+	if jwk.Kty != "OKP" || jwk.Crv != "Ed25519" {
+		return nil, fmt.Errorf("%w: unsupported key type %s/%s", ErrInvalidKey, jwk.Kty, jwk.Crv)
+	}
+
+	x, err := base64.RawURLEncoding.DecodeString(jwk.X)
+	if err != nil || len(x) != ed25519.PublicKeySize {
+		return nil, fmt.Errorf("%w: invalid x of %s", ErrInvalidKey, jwk.Kid)
+	}
+
+	key := ed25519.PublicKey(x)
+	if KeyID(key) != jwk.Kid {
+		return nil, fmt.Errorf("%w: kid %s doesn't match the key", ErrInvalidKey, jwk.Kid)
+	}
+
+	return key, nil
+}
+
+// JWKS lists every verification key in the keyring
+func (ring *KeyRing) JWKS() JWKS {
+	jwks := JWKS{Keys: []JWK{}}
+	for _, kid := range ring.KeyIDs() {
+		jwks.Keys = append(jwks.Keys, newJWK(ring.verificationKeys[kid]))
+	}
+
+	return jwks
+}
+
+// NewJWKSHandler serves the public keys of the keyring.
+// the keyring doesn't change while the server is running, so the document is rendered once.
+func NewJWKSHandler(ring *KeyRing, maxAge time.Duration) (http.Handler, error) {
+	body, err := json.Marshal(ring.JWKS())
+	if err != nil {
+		return nil, fmt.Errorf("cannot marshal jwks: %w", err)
+	}
+
+	sum := sha256.Sum256(body)
+	etag := `"` + hex.EncodeToString(sum[:8]) + `"`
+	cacheControl := fmt.Sprintf("public, max-age=%d", int(maxAge.Seconds()))
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			w.Header().Set("Allow", "GET, HEAD")
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+			return
+		}
+
+		w.Header().Set("Cache-Control", cacheControl)
+		w.Header().Set("ETag", etag)
+
+		if r.Header.Get("If-None-Match") == etag {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		if r.Method == http.MethodGet {
+			w.Write(body)
+		}
+	}
+
+	return http.HandlerFunc(handler), nil
+}
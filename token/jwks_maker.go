@@ -0,0 +1,176 @@
+package token
+
+import (
+	"crypto/ed25519"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/o1egl/paseto"
+	"golang.org/x/sync/singleflight"
+)
+
+const (
+	pasetoPublicHeader = "v2.public."
+	// unknown kids don't make the maker fetch the jwks more often than this
+	minJWKSRefreshInterval = 30 * time.Second
+	jwksRequestTimeout     = 5 * time.Second
+	// the keys are trusted this long if the issuer doesn't tell the max-age
+	defaultJWKSCacheTTL = 5 * time.Minute
+)
+
+// JWKSMaker verifies the tokens of PasetoPublicMaker and JWTEdDSAMaker with the keys fetched from a JWKS url.
+// it can't create tokens.
+type JWKSMaker struct {
+	url                string
+	client             *http.Client
+	paseto             *paseto.V2
+	minRefreshInterval time.Duration
+	// the concurrent lookups share one fetch, which runs without holding mu
+	group singleflight.Group
+
+	mu        sync.Mutex
+	keys      map[string]ed25519.PublicKey
+	fetchedAt time.Time
+	expiresAt time.Time // the keys are fetched again after this even if the kid is known
+}
+
+// NewJWKSMaker creates a maker which fetches the keys lazily, so it can start before the issuer.
+func NewJWKSMaker(url string) (Maker, error) {
+	if len(url) == 0 {
+		return nil, fmt.Errorf("jwks url must be set")
+	}
+
+	maker := &JWKSMaker{
+		url:                url,
+		client:             &http.Client{Timeout: jwksRequestTimeout},
+		paseto:             paseto.NewV2(),
+		minRefreshInterval: minJWKSRefreshInterval,
+		keys:               make(map[string]ed25519.PublicKey),
+	}
+
+	return maker, nil
+}
+
+func (maker *JWKSMaker) CreateToken(username string, role string, duration time.Duration) (string, *Payload, error) {
+	return "", nil, ErrNoSigningKey
+}
+
+func (maker *JWKSMaker) VerifyToken(token string) (*Payload, error) {
+	if strings.HasPrefix(token, pasetoPublicHeader) {
+		return verifyPasetoPublic(maker.paseto, token, maker.lookup)
+	}
+
+	return verifyJWTEdDSA(token, maker.lookup)
+}
+
+// lookup returns the key of the kid and refreshes the keys if the kid is unknown or the keys are expired
+func (maker *JWKSMaker) lookup(kid string) (ed25519.PublicKey, error) {
+	if key, ok := maker.cached(kid); ok {
+		return key, nil
+	}
+
+	// the lookups during the fetch wait for it instead of failing as throttled
+	_, err, _ := maker.group.Do("jwks", func() (interface{}, error) {
+		return nil, maker.refresh()
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	key, ok := maker.cached(kid)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownKeyID, kid)
+	}
+
+	return key, nil
+}
+
+// cached returns the key if it's known and not expired
+func (maker *JWKSMaker) cached(kid string) (ed25519.PublicKey, bool) {
+	maker.mu.Lock()
+	defer maker.mu.Unlock()
+
+	key, ok := maker.keys[kid]
+	return key, ok && time.Now().Before(maker.expiresAt)
+}
+
+// refresh fetches the keys unless they were fetched within the min refresh interval
+func (maker *JWKSMaker) refresh() error {
+	maker.mu.Lock()
+	if time.Since(maker.fetchedAt) < maker.minRefreshInterval {
+		maker.mu.Unlock()
+		return nil
+	}
+	// record the time before fetching so a failing issuer is not hammered either
+	maker.fetchedAt = time.Now()
+	maker.mu.Unlock()
+
+	keys, ttl, err := maker.fetch()
+	if err != nil {
+		return err
+	}
+	// expiring sooner than the interval would reject every token until the next fetch is allowed
+	if ttl < maker.minRefreshInterval {
+		ttl = maker.minRefreshInterval
+	}
+
+	maker.mu.Lock()
+	defer maker.mu.Unlock()
+
+	maker.keys = keys
+	maker.expiresAt = time.Now().Add(ttl)
+	return nil
+}
+
+// fetch returns the keys and how long they can be cached by the Cache-Control of the issuer
+func (maker *JWKSMaker) fetch() (map[string]ed25519.PublicKey, time.Duration, error) {
+	rsp, err := maker.client.Get(maker.url)
+	if err != nil {
+		return nil, 0, fmt.Errorf("cannot fetch jwks: %w", err)
+	}
+	defer rsp.Body.Close()
+
+	if rsp.StatusCode != http.StatusOK {
+		return nil, 0, fmt.Errorf("cannot fetch jwks: unexpected status %d", rsp.StatusCode)
+	}
+
+	var jwks JWKS
+	if err := json.NewDecoder(rsp.Body).Decode(&jwks); err != nil {
+		return nil, 0, fmt.Errorf("cannot decode jwks: %w", err)
+	}
+
+	keys := make(map[string]ed25519.PublicKey, len(jwks.Keys))
+	for _, jwk := range jwks.Keys {
+		key, err := jwk.publicKey()
+		if err != nil {
+			// the document can contain the keys for other purposes
+			continue
+		}
+		keys[jwk.Kid] = key
+	}
+
+	return keys, cacheTTL(rsp.Header.Get("Cache-Control")), nil
+}
+
+// cacheTTL reads max-age of the Cache-Control header. no-cache and no-store are regarded as max-age=0
+func cacheTTL(cacheControl string) time.Duration {
+	for _, directive := range strings.Split(cacheControl, ",") {
+		directive = strings.ToLower(strings.TrimSpace(directive))
+		switch {
+		case directive == "no-cache" || directive == "no-store":
+			return 0
+		case strings.HasPrefix(directive, "max-age="):
+			seconds, err := strconv.Atoi(strings.TrimPrefix(directive, "max-age="))
+			if err == nil && seconds >= 0 {
+				return time.Duration(seconds) * time.Second
+			}
+		}
+	}
+
+	return defaultJWKSCacheTTL
+}
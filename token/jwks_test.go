@@ -0,0 +1,255 @@
+package token
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tgfukuda/be-master/util"
+)
+
+func TestJWKSHandler(t *testing.T) {
+	oldKey := randomKey(t)
+	ring := NewKeyRing(randomKey(t), publicKey(oldKey))
+
+	handler, err := NewJWKSHandler(ring, 5*time.Minute)
+	assert.NoError(t, err)
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/.well-known/jwks.json", nil))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, "application/json", recorder.Header().Get("Content-Type"))
+	assert.Equal(t, "public, max-age=300", recorder.Header().Get("Cache-Control"))
+
+	var jwks JWKS
+	err = json.Unmarshal(recorder.Body.Bytes(), &jwks)
+	assert.NoError(t, err)
+	assert.Len(t, jwks.Keys, 2)
+	for _, jwk := range jwks.Keys {
+		key, err := jwk.publicKey()
+		assert.NoError(t, err)
+
+		got, err := ring.VerificationKey(jwk.Kid)
+		assert.NoError(t, err)
+		assert.Equal(t, got, key)
+	}
+
+	// conditional request
+	etag := recorder.Header().Get("ETag")
+	assert.NotEmpty(t, etag)
+	request := httptest.NewRequest(http.MethodGet, "/.well-known/jwks.json", nil)
+	request.Header.Set("If-None-Match", etag)
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, request)
+	assert.Equal(t, http.StatusNotModified, recorder.Code)
+	assert.Empty(t, recorder.Body.Bytes())
+
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/.well-known/jwks.json", nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, recorder.Code)
+}
+
+// jwksServer serves the jwks of the current keyring and counts the requests
+type jwksServer struct {
+	*httptest.Server
+	ring     atomic.Value
+	requests int32
+}
+
+func newJWKSServer(t *testing.T, ring *KeyRing) *jwksServer {
+	server := &jwksServer{}
+	server.ring.Store(ring)
+	server.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&server.requests, 1)
+		handler, err := NewJWKSHandler(server.ring.Load().(*KeyRing), time.Minute)
+		assert.NoError(t, err)
+		handler.ServeHTTP(w, r)
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestJWKSMaker(t *testing.T) {
+	key := randomKey(t)
+	ring := NewKeyRing(key)
+	server := newJWKSServer(t, ring)
+
+	verifier, err := NewJWKSMaker(server.URL)
+	assert.NoError(t, err)
+
+	pasetoMaker, err := NewPasetoPublicMaker(ring)
+	assert.NoError(t, err)
+	jwtMaker, err := NewJWTEdDSAMaker(ring)
+	assert.NoError(t, err)
+
+	for _, maker := range []Maker{pasetoMaker, jwtMaker} {
+		username := util.RandomOwner()
+		token, _, err := maker.CreateToken(username, util.DepositorRole, time.Minute)
+		assert.NoError(t, err)
+
+		payload, err := verifier.VerifyToken(token)
+		assert.NoError(t, err)
+		assert.Equal(t, username, payload.Username)
+	}
+	// the keys are cached
+	assert.Equal(t, int32(1), atomic.LoadInt32(&server.requests))
+
+	_, _, err = verifier.CreateToken(util.RandomOwner(), util.DepositorRole, time.Minute)
+	assert.ErrorIs(t, err, ErrNoSigningKey)
+}
+
+func TestJWKSMakerRotation(t *testing.T) {
+	oldKey := randomKey(t)
+	server := newJWKSServer(t, NewKeyRing(oldKey))
+
+	maker, err := NewJWKSMaker(server.URL)
+	assert.NoError(t, err)
+	verifier := maker.(*JWKSMaker)
+
+	oldMaker, err := NewJWTEdDSAMaker(NewKeyRing(oldKey))
+	assert.NoError(t, err)
+	token, _, err := oldMaker.CreateToken(util.RandomOwner(), util.DepositorRole, time.Minute)
+	assert.NoError(t, err)
+	_, err = verifier.VerifyToken(token)
+	assert.NoError(t, err)
+
+	// the issuer rotates the key
+	newRing := NewKeyRing(randomKey(t), publicKey(oldKey))
+	server.ring.Store(newRing)
+	newMaker, err := NewJWTEdDSAMaker(newRing)
+	assert.NoError(t, err)
+	token, _, err = newMaker.CreateToken(util.RandomOwner(), util.DepositorRole, time.Minute)
+	assert.NoError(t, err)
+
+	// unknown kid doesn't refresh the keys within the interval
+	_, err = verifier.VerifyToken(token)
+	assert.EqualError(t, err, ErrInvalidToken.Error())
+	assert.Equal(t, int32(1), atomic.LoadInt32(&server.requests))
+
+	// unknown kid refreshes the keys
+	verifier.minRefreshInterval = 0
+	_, err = verifier.VerifyToken(token)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&server.requests))
+}
+
+func TestJWKSMakerExpiry(t *testing.T) {
+	key := randomKey(t)
+	server := newJWKSServer(t, NewKeyRing(key))
+
+	maker, err := NewJWKSMaker(server.URL)
+	assert.NoError(t, err)
+	verifier := maker.(*JWKSMaker)
+	verifier.minRefreshInterval = 0
+
+	jwtMaker, err := NewJWTEdDSAMaker(NewKeyRing(key))
+	assert.NoError(t, err)
+	token, _, err := jwtMaker.CreateToken(util.RandomOwner(), util.DepositorRole, time.Minute)
+	assert.NoError(t, err)
+
+	_, err = verifier.VerifyToken(token)
+	assert.NoError(t, err)
+	// cached for the max-age of the issuer
+	assert.WithinDuration(t, time.Now().Add(time.Minute), verifier.expiresAt, time.Second)
+
+	// the issuer drops the key. it's still trusted until it expires
+	server.ring.Store(NewKeyRing(randomKey(t)))
+	_, err = verifier.VerifyToken(token)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&server.requests))
+
+	verifier.mu.Lock()
+	verifier.expiresAt = time.Now()
+	verifier.mu.Unlock()
+
+	_, err = verifier.VerifyToken(token)
+	assert.EqualError(t, err, ErrInvalidToken.Error())
+	assert.Equal(t, int32(2), atomic.LoadInt32(&server.requests))
+}
+
+// the lookups of the cached keys don't wait for the fetch, and the concurrent fetches are merged into one
+func TestJWKSMakerConcurrentFetch(t *testing.T) {
+	oldKey := randomKey(t)
+	newKey := randomKey(t)
+	var ring atomic.Value
+	ring.Store(NewKeyRing(oldKey))
+
+	var requests int32
+	var blocking int32
+	started := make(chan struct{})
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		if atomic.LoadInt32(&blocking) == 1 {
+			close(started)
+			<-release
+		}
+		handler, err := NewJWKSHandler(ring.Load().(*KeyRing), time.Minute)
+		assert.NoError(t, err)
+		handler.ServeHTTP(w, r)
+	}))
+	defer server.Close()
+
+	maker, err := NewJWKSMaker(server.URL)
+	assert.NoError(t, err)
+	verifier := maker.(*JWKSMaker)
+
+	oldMaker, err := NewJWTEdDSAMaker(NewKeyRing(oldKey))
+	assert.NoError(t, err)
+	oldToken, _, err := oldMaker.CreateToken(util.RandomOwner(), util.DepositorRole, time.Minute)
+	assert.NoError(t, err)
+	_, err = verifier.VerifyToken(oldToken)
+	assert.NoError(t, err)
+
+	newMaker, err := NewJWTEdDSAMaker(NewKeyRing(newKey, publicKey(oldKey)))
+	assert.NoError(t, err)
+	newToken, _, err := newMaker.CreateToken(util.RandomOwner(), util.DepositorRole, time.Minute)
+	assert.NoError(t, err)
+
+	ring.Store(NewKeyRing(newKey, publicKey(oldKey)))
+	atomic.StoreInt32(&blocking, 1)
+	// allow one more fetch. the lookups coming after it must not fetch again
+	verifier.mu.Lock()
+	verifier.fetchedAt = time.Time{}
+	verifier.mu.Unlock()
+
+	n := 5
+	errs := make(chan error, n)
+	for i := 0; i < n; i++ {
+		go func() {
+			_, err := verifier.VerifyToken(newToken)
+			errs <- err
+		}()
+	}
+	<-started
+
+	done := make(chan error)
+	go func() {
+		_, err := verifier.VerifyToken(oldToken)
+		done <- err
+	}()
+	select {
+	case err := <-done:
+		assert.NoError(t, err)
+	case <-time.After(time.Second):
+		t.Error("lookup of the cached key waits for the fetch")
+	}
+
+	close(release)
+	for i := 0; i < n; i++ {
+		assert.NoError(t, <-errs)
+	}
+	assert.Equal(t, int32(2), atomic.LoadInt32(&requests))
+}
+
+func TestCacheTTL(t *testing.T) {
+	assert.Equal(t, 5*time.Minute, cacheTTL("public, max-age=300"))
+	assert.Equal(t, time.Duration(0), cacheTTL("no-store"))
+	assert.Equal(t, defaultJWKSCacheTTL, cacheTTL(""))
+	assert.Equal(t, defaultJWKSCacheTTL, cacheTTL("max-age=abc"))
+}
@@ -0,0 +1,77 @@
+package token
+
+import (
+	"crypto/ed25519"
+	"errors"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+const jwtKeyIDHeader = "kid"
+
+// JWTEdDSAMaker signs tokens with EdDSA (Ed25519) so the verifiers only need the public key
+type JWTEdDSAMaker struct {
+	keyRing *KeyRing
+}
+
+func NewJWTEdDSAMaker(keyRing *KeyRing) (Maker, error) {
+	return &JWTEdDSAMaker{keyRing: keyRing}, nil
+}
+
+func (maker *JWTEdDSAMaker) CreateToken(username string, role string, duration time.Duration) (string, *Payload, error) {
+	payload, err := NewPayload(username, role, duration)
+	if err != nil {
+		return "", payload, err
+	}
+
+	kid, key, err := maker.keyRing.SigningKey()
+	if err != nil {
+		return "", payload, err
+	}
+
+	jwtToken := jwt.NewWithClaims(jwt.SigningMethodEdDSA, payload)
+	jwtToken.Header[jwtKeyIDHeader] = kid
+	token, err := jwtToken.SignedString(key)
+
+	return token, payload, err
+}
+
+func (maker *JWTEdDSAMaker) VerifyToken(token string) (*Payload, error) {
+	return verifyJWTEdDSA(token, maker.keyRing.VerificationKey)
+}
+
+// verifyJWTEdDSA verifies the token with the key looked up by the kid header
+func verifyJWTEdDSA(token string, lookup func(kid string) (ed25519.PublicKey, error)) (*Payload, error) {
+	keyFunc := func(token *jwt.Token) (interface{}, error) {
+		// only accept EdDSA to prevent the algorithm confusion
+		_, ok := token.Method.(*jwt.SigningMethodEd25519)
+		if !ok {
+			return nil, ErrInvalidToken
+		}
+
+		kid, ok := token.Header[jwtKeyIDHeader].(string)
+		if !ok {
+			return nil, ErrInvalidToken
+		}
+
+		return lookup(kid)
+	}
+
+	jwtToken, err := jwt.ParseWithClaims(token, &Payload{}, keyFunc)
+	if err != nil {
+		verr, ok := err.(*jwt.ValidationError)
+		if ok && errors.Is(verr.Inner, ErrExpiredToken) {
+			return nil, ErrExpiredToken
+		}
+
+		return nil, ErrInvalidToken
+	}
+
+	payload, ok := jwtToken.Claims.(*Payload)
+	if !ok {
+		return nil, ErrInvalidToken
+	}
+
+	return payload, nil
+}
@@ -0,0 +1,94 @@
+package token
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/tgfukuda/be-master/util"
+)
+
+func TestJWTEdDSAMaker(t *testing.T) {
+	maker, err := NewJWTEdDSAMaker(NewKeyRing(randomKey(t)))
+	assert.NoError(t, err)
+
+	username := util.RandomOwner()
+	role := util.DepositorRole
+	duration := time.Minute
+
+	issuedAt := time.Now()
+	expiredAt := time.Now().Add(duration)
+
+	token, payload, err := maker.CreateToken(username, role, duration)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, token)
+	assert.NotEmpty(t, payload)
+
+	payload, err = maker.VerifyToken(token)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, payload)
+
+	assert.Equal(t, payload.Username, username)
+	assert.Equal(t, payload.Role, role)
+	assert.WithinDuration(t, payload.IssuedAt, issuedAt, time.Second)
+	assert.WithinDuration(t, payload.ExpiredAt, expiredAt, time.Second)
+}
+
+func TestExpiredJWTEdDSAToken(t *testing.T) {
+	maker, err := NewJWTEdDSAMaker(NewKeyRing(randomKey(t)))
+	assert.NoError(t, err)
+
+	token, payload, err := maker.CreateToken(util.RandomOwner(), util.DepositorRole, -time.Minute)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, token)
+	assert.NotEmpty(t, payload)
+
+	payload, err = maker.VerifyToken(token)
+	assert.Error(t, err)
+	assert.EqualError(t, err, ErrExpiredToken.Error())
+	assert.Nil(t, payload)
+}
+
+func TestJWTEdDSAKeyRotation(t *testing.T) {
+	oldKey := randomKey(t)
+	oldMaker, err := NewJWTEdDSAMaker(NewKeyRing(oldKey))
+	assert.NoError(t, err)
+
+	token, _, err := oldMaker.CreateToken(util.RandomOwner(), util.DepositorRole, time.Minute)
+	assert.NoError(t, err)
+
+	newMaker, err := NewJWTEdDSAMaker(NewKeyRing(randomKey(t), publicKey(oldKey)))
+	assert.NoError(t, err)
+	_, err = newMaker.VerifyToken(token)
+	assert.NoError(t, err)
+
+	otherMaker, err := NewJWTEdDSAMaker(NewKeyRing(randomKey(t)))
+	assert.NoError(t, err)
+	_, err = otherMaker.VerifyToken(token)
+	assert.EqualError(t, err, ErrInvalidToken.Error())
+}
+
+// the public key must not be accepted as a HMAC secret
+func TestInvalidJWTEdDSATokenAlgHS256(t *testing.T) {
+	key := randomKey(t)
+	ring := NewKeyRing(key)
+	kid, _, err := ring.SigningKey()
+	assert.NoError(t, err)
+
+	payload, err := NewPayload(util.RandomOwner(), util.AdminRole, time.Minute)
+	assert.NoError(t, err)
+
+	jwtToken := jwt.NewWithClaims(jwt.SigningMethodHS256, payload)
+	jwtToken.Header[jwtKeyIDHeader] = kid
+	token, err := jwtToken.SignedString([]byte(publicKey(key)))
+	assert.NoError(t, err)
+
+	maker, err := NewJWTEdDSAMaker(ring)
+	assert.NoError(t, err)
+
+	payload, err = maker.VerifyToken(token)
+	assert.Error(t, err)
+	assert.EqualError(t, err, ErrInvalidToken.Error())
+	assert.Nil(t, payload)
+}
@@ -14,12 +14,13 @@ func TestJWTMaker(t *testing.T) {
 	assert.NoError(t, err)
 
 	username := util.RandomOwner()
+	role := util.BankerRole
 	duration := time.Minute
 
 	issuedAt := time.Now()
 	expiredAt := time.Now().Add(duration)
 
-	token, payload, err := maker.CreateToken(username, duration)
+	token, payload, err := maker.CreateToken(username, role, duration)
 	assert.NoError(t, err)
 	assert.NotEmpty(t, token)
 	assert.NotEmpty(t, payload)
@@ -29,6 +30,7 @@ func TestJWTMaker(t *testing.T) {
 	assert.NotEmpty(t, payload)
 
 	assert.Equal(t, payload.Username, username)
+	assert.Equal(t, payload.Role, role)
 	assert.WithinDuration(t, payload.IssuedAt, issuedAt, time.Second)
 	assert.WithinDuration(t, payload.ExpiredAt, expiredAt, time.Second)
 }
@@ -37,7 +39,7 @@ func TestExpiredJWTToken(t *testing.T) {
 	maker, err := NewJWTMaker(util.RandomString(32))
 	assert.NoError(t, err)
 
-	token, payload, err := maker.CreateToken(util.RandomOwner(), -time.Minute)
+	token, payload, err := maker.CreateToken(util.RandomOwner(), util.DepositorRole, -time.Minute)
 	assert.NoError(t, err)
 	assert.NotEmpty(t, token)
 	assert.NotEmpty(t, payload)
@@ -50,7 +52,7 @@ func TestExpiredJWTToken(t *testing.T) {
 
 // well known attack
 func TestInvalidJWTTokenAlgNone(t *testing.T) {
-	payload, err := NewPayload(util.RandomOwner(), time.Minute)
+	payload, err := NewPayload(util.RandomOwner(), util.DepositorRole, time.Minute)
 	assert.NoError(t, err)
 
 	jwtToken := jwt.NewWithClaims(jwt.SigningMethodNone, payload)
@@ -0,0 +1,114 @@
+package token
+
+import (
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+var (
+	ErrNoSigningKey = errors.New("no signing key in the keyring")
+	ErrUnknownKeyID = errors.New("unknown key id")
+	ErrInvalidKey   = errors.New("invalid ed25519 key")
+)
+
+// KeyRing holds the Ed25519 key to sign tokens and the public keys to verify them.
+// the public keys of the retired signing keys are kept until every token signed by them expires.
+type KeyRing struct {
+	signingKeyID     string
+	signingKey       ed25519.PrivateKey
+	verificationKeys map[string]ed25519.PublicKey
+}
+
+// NewKeyRing creates a keyring. the signing key can be nil for the services which only verify tokens.
+func NewKeyRing(signingKey ed25519.PrivateKey, verificationKeys ...ed25519.PublicKey) *KeyRing {
+	ring := &KeyRing{
+		verificationKeys: make(map[string]ed25519.PublicKey),
+	}
+
+	for _, key := range verificationKeys {
+		ring.verificationKeys[KeyID(key)] = key
+	}
+
+	if signingKey != nil {
+		publicKey := signingKey.Public().(ed25519.PublicKey)
+		ring.signingKey = signingKey
+		ring.signingKeyID = KeyID(publicKey)
+		ring.verificationKeys[ring.signingKeyID] = publicKey
+	}
+
+	return ring
+}
+
+// SigningKey returns the current signing key and its key id
+func (ring *KeyRing) SigningKey() (string, ed25519.PrivateKey, error) {
+	if ring.signingKey == nil {
+		return "", nil, ErrNoSigningKey
+	}
+
+	return ring.signingKeyID, ring.signingKey, nil
+}
+
+func (ring *KeyRing) VerificationKey(kid string) (ed25519.PublicKey, error) {
+	key, ok := ring.verificationKeys[kid]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownKeyID, kid)
+	}
+
+	return key, nil
+}
+
+// KeyIDs returns the ids of all the verification keys in a stable order
+func (ring *KeyRing) KeyIDs() []string {
+	kids := make([]string, 0, len(ring.verificationKeys))
+	for kid := range ring.verificationKeys {
+		kids = append(kids, kid)
+	}
+	sort.Strings(kids)
+
+	return kids
+}
+
+// KeyID is the JWK thumbprint (RFC 7638) of the public key
+func KeyID(publicKey ed25519.PublicKey) string {
+	// members of the required fields in the lexicographic order without whitespace
+	jwk := fmt.Sprintf(`{"crv":"Ed25519","kty":"OKP","x":"%s"}`, base64.RawURLEncoding.EncodeToString(publicKey))
+	sum := sha256.Sum256([]byte(jwk))
+
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// ParsePrivateKey decodes a base64 encoded Ed25519 seed (32 bytes) or private key (64 bytes)
+func ParsePrivateKey(s string) (ed25519.PrivateKey, error) {
+	b, err := base64.StdEncoding.DecodeString(strings.TrimSpace(s))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidKey, err)
+	}
+
+	switch len(b) {
+	case ed25519.SeedSize:
+		return ed25519.NewKeyFromSeed(b), nil
+	case ed25519.PrivateKeySize:
+		return ed25519.PrivateKey(b), nil
+	default:
+		return nil, fmt.Errorf("%w: private key must be %d or %d bytes", ErrInvalidKey, ed25519.SeedSize, ed25519.PrivateKeySize)
+	}
+}
+
+// ParsePublicKey decodes a base64 encoded Ed25519 public key
+func ParsePublicKey(s string) (ed25519.PublicKey, error) {
+	b, err := base64.StdEncoding.DecodeString(strings.TrimSpace(s))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidKey, err)
+	}
+
+	if len(b) != ed25519.PublicKeySize {
+		return nil, fmt.Errorf("%w: public key must be %d bytes", ErrInvalidKey, ed25519.PublicKeySize)
+	}
+
+	return ed25519.PublicKey(b), nil
+}
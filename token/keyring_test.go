@@ -0,0 +1,78 @@
+package token
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tgfukuda/be-master/util"
+)
+
+func randomKey(t *testing.T) ed25519.PrivateKey {
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	assert.NoError(t, err)
+	return key
+}
+
+func publicKey(key ed25519.PrivateKey) ed25519.PublicKey {
+	return key.Public().(ed25519.PublicKey)
+}
+
+func TestKeyRing(t *testing.T) {
+	oldKey := randomKey(t)
+	newKey := randomKey(t)
+
+	ring := NewKeyRing(newKey, publicKey(oldKey))
+
+	kid, key, err := ring.SigningKey()
+	assert.NoError(t, err)
+	assert.Equal(t, KeyID(publicKey(newKey)), kid)
+	assert.Equal(t, newKey, key)
+
+	for _, k := range []ed25519.PrivateKey{oldKey, newKey} {
+		got, err := ring.VerificationKey(KeyID(publicKey(k)))
+		assert.NoError(t, err)
+		assert.Equal(t, publicKey(k), got)
+	}
+	assert.Len(t, ring.KeyIDs(), 2)
+
+	_, err = ring.VerificationKey(KeyID(publicKey(randomKey(t))))
+	assert.ErrorIs(t, err, ErrUnknownKeyID)
+
+	// verification only
+	_, _, err = NewKeyRing(nil, publicKey(oldKey)).SigningKey()
+	assert.ErrorIs(t, err, ErrNoSigningKey)
+}
+
+func TestKeyID(t *testing.T) {
+	// RFC 8037 Appendix A.3
+	x, err := base64.RawURLEncoding.DecodeString("11qYAYKxCrfVS_7TyWQHOg7hcvPapiMlrwIaaPcHURo")
+	assert.NoError(t, err)
+	assert.Equal(t, "kPrK_qmxVWaYVA9wwBF6Iuo3vVzz7TxHCTwXBygrS4k", KeyID(ed25519.PublicKey(x)))
+}
+
+func TestNewKeyRingFromConfig(t *testing.T) {
+	oldKey := randomKey(t)
+	newKey := randomKey(t)
+
+	ring, err := NewKeyRingFromConfig(util.Config{
+		TokenSigningKey:       base64.StdEncoding.EncodeToString(newKey.Seed()),
+		TokenVerificationKeys: base64.StdEncoding.EncodeToString(publicKey(oldKey)) + ", ",
+	})
+	assert.NoError(t, err)
+
+	kid, _, err := ring.SigningKey()
+	assert.NoError(t, err)
+	assert.Equal(t, KeyID(publicKey(newKey)), kid)
+
+	_, err = ring.VerificationKey(KeyID(publicKey(oldKey)))
+	assert.NoError(t, err)
+
+	_, err = NewKeyRingFromConfig(util.Config{})
+	assert.ErrorIs(t, err, ErrNoSigningKey)
+
+	_, err = NewKeyRingFromConfig(util.Config{TokenSigningKey: "invalid"})
+	assert.ErrorIs(t, err, ErrInvalidKey)
+}
@@ -1,9 +1,85 @@
 package token
 
-import "time"
+import (
+	"crypto/ed25519"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/tgfukuda/be-master/util"
+)
 
 // token maneger
 type Maker interface {
-	CreateToken(username string, duration time.Duration) (string, *Payload, error)
+	CreateToken(username string, role string, duration time.Duration) (string, *Payload, error)
 	VerifyToken(token string) (*Payload, error)
 }
+
+// kinds of the maker selected by TOKEN_MAKER
+const (
+	PasetoMakerType       = "paseto"        // PASETO v2.local with TOKEN_SYMMETRIC_KEY
+	JWTMakerType          = "jwt"           // JWT HS256 with TOKEN_SYMMETRIC_KEY
+	PasetoPublicMakerType = "paseto_public" // PASETO v2.public with TOKEN_SIGNING_KEY
+	JWTEdDSAMakerType     = "jwt_eddsa"     // JWT EdDSA with TOKEN_SIGNING_KEY
+	JWKSMakerType         = "jwks"          // verify only with the keys fetched from TOKEN_JWKS_URL
+)
+
+// NewMaker creates the maker selected by the config. PASETO v2.local is used if nothing is selected.
+func NewMaker(config util.Config) (Maker, error) {
+	switch config.TokenMaker {
+	case "", PasetoMakerType:
+		return NewPasetoMaker(config.TokenSymmetricKey)
+	case JWTMakerType:
+		return NewJWTMaker(config.TokenSymmetricKey)
+	case PasetoPublicMakerType, JWTEdDSAMakerType:
+		keyRing, err := NewKeyRingFromConfig(config)
+		if err != nil {
+			return nil, err
+		}
+
+		if config.TokenMaker == PasetoPublicMakerType {
+			return NewPasetoPublicMaker(keyRing)
+		}
+		return NewJWTEdDSAMaker(keyRing)
+	case JWKSMakerType:
+		return NewJWKSMaker(config.TokenJWKSURL)
+	default:
+		return nil, fmt.Errorf("unsupported token maker: %s", config.TokenMaker)
+	}
+}
+
+// IsAsymmetricMaker reports whether the tokens of the maker type are signed with the keyring
+func IsAsymmetricMaker(makerType string) bool {
+	return makerType == PasetoPublicMakerType || makerType == JWTEdDSAMakerType
+}
+
+// NewKeyRingFromConfig loads TOKEN_SIGNING_KEY and the comma separated TOKEN_VERIFICATION_KEYS of the retired keys
+func NewKeyRingFromConfig(config util.Config) (*KeyRing, error) {
+	var signingKey ed25519.PrivateKey
+	if len(config.TokenSigningKey) > 0 {
+		var err error
+		signingKey, err = ParsePrivateKey(config.TokenSigningKey)
+		if err != nil {
+			return nil, fmt.Errorf("invalid signing key: %w", err)
+		}
+	}
+
+	var verificationKeys []ed25519.PublicKey
+	for _, s := range strings.Split(config.TokenVerificationKeys, ",") {
+		if len(strings.TrimSpace(s)) == 0 {
+			continue
+		}
+
+		key, err := ParsePublicKey(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid verification key: %w", err)
+		}
+		verificationKeys = append(verificationKeys, key)
+	}
+
+	if signingKey == nil && len(verificationKeys) == 0 {
+		return nil, fmt.Errorf("%w: either signing key or verification keys must be set", ErrNoSigningKey)
+	}
+
+	return NewKeyRing(signingKey, verificationKeys...), nil
+}
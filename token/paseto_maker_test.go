@@ -13,12 +13,13 @@ func TestPasetoMaker(t *testing.T) {
 	assert.NoError(t, err)
 
 	username := util.RandomOwner()
+	role := util.BankerRole
 	duration := time.Minute
 
 	issuedAt := time.Now()
 	expiredAt := time.Now().Add(duration)
 
-	token, payload, err := maker.CreateToken(username, duration)
+	token, payload, err := maker.CreateToken(username, role, duration)
 	assert.NoError(t, err)
 	assert.NotEmpty(t, token)
 	assert.NotEmpty(t, payload)
@@ -28,6 +29,7 @@ func TestPasetoMaker(t *testing.T) {
 	assert.NotEmpty(t, payload)
 
 	assert.Equal(t, payload.Username, username)
+	assert.Equal(t, payload.Role, role)
 	assert.WithinDuration(t, payload.IssuedAt, issuedAt, time.Second)
 	assert.WithinDuration(t, payload.ExpiredAt, expiredAt, time.Second)
 }
@@ -36,7 +38,7 @@ func TestExpiredPasetoToken(t *testing.T) {
 	maker, err := NewPasetoMaker(util.RandomString(32))
 	assert.NoError(t, err)
 
-	token, payload, err := maker.CreateToken(util.RandomOwner(), -time.Minute)
+	token, payload, err := maker.CreateToken(util.RandomOwner(), util.DepositorRole, -time.Minute)
 	assert.NoError(t, err)
 	assert.NotEmpty(t, token)
 	assert.NotEmpty(t, payload)
@@ -0,0 +1,74 @@
+package token
+
+import (
+	"crypto/ed25519"
+	"time"
+
+	"github.com/o1egl/paseto"
+)
+
+// footer of the public token. it's not encrypted but covered by the signature.
+type pasetoFooter struct {
+	KeyID string `json:"kid"`
+}
+
+// PasetoPublicMaker signs tokens with PASETO v2.public so the verifiers only need the public key
+type PasetoPublicMaker struct {
+	paseto  *paseto.V2
+	keyRing *KeyRing
+}
+
+func NewPasetoPublicMaker(keyRing *KeyRing) (Maker, error) {
+	maker := &PasetoPublicMaker{
+		paseto:  paseto.NewV2(),
+		keyRing: keyRing,
+	}
+
+	return maker, nil
+}
+
+func (maker *PasetoPublicMaker) CreateToken(username string, role string, duration time.Duration) (string, *Payload, error) {
+	payload, err := NewPayload(username, role, duration)
+	if err != nil {
+		return "", payload, err
+	}
+
+	kid, key, err := maker.keyRing.SigningKey()
+	if err != nil {
+		return "", payload, err
+	}
+
+	token, err := maker.paseto.Sign(key, payload, pasetoFooter{KeyID: kid})
+
+	return token, payload, err
+}
+
+func (maker *PasetoPublicMaker) VerifyToken(token string) (*Payload, error) {
+	return verifyPasetoPublic(maker.paseto, token, maker.keyRing.VerificationKey)
+}
+
+// verifyPasetoPublic verifies the token with the key looked up by the kid in the footer
+func verifyPasetoPublic(v2 *paseto.V2, token string, lookup func(kid string) (ed25519.PublicKey, error)) (*Payload, error) {
+	var footer pasetoFooter
+	if err := paseto.ParseFooter(token, &footer); err != nil {
+		return nil, ErrInvalidToken
+	}
+
+	key, err := lookup(footer.KeyID)
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+
+	payload := &Payload{}
+	err = v2.Verify(token, key, payload, nil)
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+
+	err = payload.Valid()
+	if err != nil {
+		return nil, err
+	}
+
+	return payload, nil
+}
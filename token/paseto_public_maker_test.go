@@ -0,0 +1,79 @@
+package token
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tgfukuda/be-master/util"
+)
+
+func TestPasetoPublicMaker(t *testing.T) {
+	maker, err := NewPasetoPublicMaker(NewKeyRing(randomKey(t)))
+	assert.NoError(t, err)
+
+	username := util.RandomOwner()
+	role := util.DepositorRole
+	duration := time.Minute
+
+	issuedAt := time.Now()
+	expiredAt := time.Now().Add(duration)
+
+	token, payload, err := maker.CreateToken(username, role, duration)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, token)
+	assert.NotEmpty(t, payload)
+
+	payload, err = maker.VerifyToken(token)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, payload)
+
+	assert.Equal(t, payload.Username, username)
+	assert.Equal(t, payload.Role, role)
+	assert.WithinDuration(t, payload.IssuedAt, issuedAt, time.Second)
+	assert.WithinDuration(t, payload.ExpiredAt, expiredAt, time.Second)
+}
+
+func TestExpiredPasetoPublicToken(t *testing.T) {
+	maker, err := NewPasetoPublicMaker(NewKeyRing(randomKey(t)))
+	assert.NoError(t, err)
+
+	token, payload, err := maker.CreateToken(util.RandomOwner(), util.DepositorRole, -time.Minute)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, token)
+	assert.NotEmpty(t, payload)
+
+	payload, err = maker.VerifyToken(token)
+	assert.Error(t, err)
+	assert.EqualError(t, err, ErrExpiredToken.Error())
+	assert.Nil(t, payload)
+}
+
+func TestPasetoPublicKeyRotation(t *testing.T) {
+	oldKey := randomKey(t)
+	oldMaker, err := NewPasetoPublicMaker(NewKeyRing(oldKey))
+	assert.NoError(t, err)
+
+	token, _, err := oldMaker.CreateToken(util.RandomOwner(), util.DepositorRole, time.Minute)
+	assert.NoError(t, err)
+
+	// the old key is kept for verification after the rotation
+	newMaker, err := NewPasetoPublicMaker(NewKeyRing(randomKey(t), publicKey(oldKey)))
+	assert.NoError(t, err)
+	_, err = newMaker.VerifyToken(token)
+	assert.NoError(t, err)
+
+	// the old key is dropped
+	otherMaker, err := NewPasetoPublicMaker(NewKeyRing(randomKey(t)))
+	assert.NoError(t, err)
+	_, err = otherMaker.VerifyToken(token)
+	assert.EqualError(t, err, ErrInvalidToken.Error())
+
+	// verifier without the signing key
+	verifier, err := NewPasetoPublicMaker(NewKeyRing(nil, publicKey(oldKey)))
+	assert.NoError(t, err)
+	_, err = verifier.VerifyToken(token)
+	assert.NoError(t, err)
+	_, _, err = verifier.CreateToken(util.RandomOwner(), util.DepositorRole, time.Minute)
+	assert.ErrorIs(t, err, ErrNoSigningKey)
+}
@@ -2,25 +2,28 @@ package token
 
 import (
 	"errors"
+	"fmt"
 	"time"
 
 	"github.com/google/uuid"
 )
 
 var (
-	ErrExpiredToken = errors.New("token has expired")
-	ErrInvalidToken = errors.New("token is invalid")
+	ErrExpiredToken     = errors.New("token has expired")
+	ErrInvalidToken     = errors.New("token is invalid")
+	ErrPermissionDenied = errors.New("permission denied")
 )
 
 // payload data of the token
 type Payload struct {
 	ID        uuid.UUID `json:"id"`
 	Username  string    `json:"username"`
+	Role      string    `json:"role"`
 	IssuedAt  time.Time `json:"issued_at"`
 	ExpiredAt time.Time `json:"expired_at"`
 }
 
-func NewPayload(username string, duration time.Duration) (*Payload, error) {
+func NewPayload(username string, role string, duration time.Duration) (*Payload, error) {
 	tokenId, err := uuid.NewRandom()
 	if err != nil {
 		return nil, err
@@ -30,6 +33,7 @@ func NewPayload(username string, duration time.Duration) (*Payload, error) {
 	payload := &Payload{
 		ID:        tokenId,
 		Username:  username,
+		Role:      role,
 		IssuedAt:  now,
 		ExpiredAt: now.Add(duration),
 	}
@@ -44,3 +48,14 @@ func (payload *Payload) Valid() error {
 
 	return nil
 }
+
+// CheckRole returns ErrPermissionDenied unless the role of the payload is one of the accessible roles
+func (payload *Payload) CheckRole(accessibleRoles []string) error {
+	for _, role := range accessibleRoles {
+		if payload.Role == role {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%w: role %q is not allowed", ErrPermissionDenied, payload.Role)
+}
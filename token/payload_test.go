@@ -0,0 +1,19 @@
+package token
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tgfukuda/be-master/util"
+)
+
+func TestCheckRole(t *testing.T) {
+	payload, err := NewPayload(util.RandomOwner(), util.BankerRole, time.Minute)
+	assert.NoError(t, err)
+
+	assert.NoError(t, payload.CheckRole(util.AllRoles))
+	assert.NoError(t, payload.CheckRole(util.StaffRoles))
+	assert.ErrorIs(t, payload.CheckRole([]string{util.AdminRole}), ErrPermissionDenied)
+	assert.ErrorIs(t, payload.CheckRole(nil), ErrPermissionDenied)
+}
@@ -0,0 +1,52 @@
+package tracing
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// the trace context goes through a message as the task payload
+func TestInjectExtract(t *testing.T) {
+	provider, exporter := NewInMemoryTracerProvider()
+	Setup(provider)
+
+	ctx, parent := Tracer().Start(context.Background(), "parent")
+	carrier := Inject(ctx)
+	parent.End()
+	assert.NotEmpty(t, carrier["traceparent"])
+
+	message, err := json.Marshal(carrier)
+	assert.NoError(t, err)
+
+	var received map[string]string
+	err = json.Unmarshal(message, &received)
+	assert.NoError(t, err)
+
+	_, child := Tracer().Start(Extract(context.Background(), received), "child")
+	End(child, nil)
+
+	spans := exporter.GetSpans()
+	assert.Len(t, spans, 2)
+	assert.Equal(t, "parent", spans[0].Name)
+	assert.Equal(t, "child", spans[1].Name)
+	assert.Equal(t, spans[0].SpanContext.TraceID(), spans[1].SpanContext.TraceID())
+	assert.Equal(t, spans[0].SpanContext.SpanID(), spans[1].Parent.SpanID())
+	assert.True(t, spans[1].Parent.IsRemote())
+}
+
+func TestExtractWithoutTraceContext(t *testing.T) {
+	provider, exporter := NewInMemoryTracerProvider()
+	Setup(provider)
+
+	_, span := Tracer().Start(Extract(context.Background(), nil), "root")
+	End(span, assert.AnError)
+
+	spans := exporter.GetSpans()
+	assert.Len(t, spans, 1)
+	assert.False(t, spans[0].Parent.IsValid())
+	assert.Equal(t, assert.AnError.Error(), spans[0].Status.Description)
+	assert.Len(t, spans[0].Events, 1) // the recorded error
+}
@@ -1,25 +1,36 @@
 package util
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/spf13/viper"
 )
 
 type Config struct {
-	Environment          string        `mapstructure:"ENVIRONMENT"`
-	DBDriver             string        `mapstructure:"DB_DRIVER"`
-	DBSource             string        `mapstructure:"DB_SOURCE"`
-	MigrationURL         string        `mapstructure:"MIGRATION_URL"`
-	HTTPServerAddress    string        `mapstructure:"HTTP_SERVER_ADDRESS"`
-	GRPCServerAddress    string        `mapstructure:"GRPC_SERVER_ADDRESS"`
-	RedisServerAddress   string        `mapstructure:"REDIS_SERVER_ADDRESS"`
-	TokenSymmetricKey    string        `mapstructure:"TOKEN_SYMMETRIC_KEY"`
-	AccessTokenDuration  time.Duration `mapstructure:"ACCESS_TOKEN_DURATION"`
-	RefreshTokenDuration time.Duration `mapstructure:"REFRESH_TOKEN_DURATION"`
-	EmailSenderName      string        `mapstructure:"EMAIL_SENDER_NAME"`
-	EmailSenderAddress   string        `mapstructure:"EMAIL_SENDER_ADDRESS"`
-	EmailSenderPassword  string        `mapstructure:"EMAIL_SENDER_PASSWORD"`
+	Environment                string        `mapstructure:"ENVIRONMENT"`
+	DBDriver                   string        `mapstructure:"DB_DRIVER"`
+	DBSource                   string        `mapstructure:"DB_SOURCE"`
+	MigrationURL               string        `mapstructure:"MIGRATION_URL"`
+	HTTPServerAddress          string        `mapstructure:"HTTP_SERVER_ADDRESS"`
+	GRPCServerAddress          string        `mapstructure:"GRPC_SERVER_ADDRESS"`
+	RedisServerAddress         string        `mapstructure:"REDIS_SERVER_ADDRESS"`
+	TokenMaker                 string        `mapstructure:"TOKEN_MAKER"`
+	TokenSymmetricKey          string        `mapstructure:"TOKEN_SYMMETRIC_KEY"`
+	TokenSigningKey            string        `mapstructure:"TOKEN_SIGNING_KEY"`
+	TokenVerificationKeys      string        `mapstructure:"TOKEN_VERIFICATION_KEYS"`
+	TokenJWKSURL               string        `mapstructure:"TOKEN_JWKS_URL"`
+	AccessTokenDuration        time.Duration `mapstructure:"ACCESS_TOKEN_DURATION"`
+	RefreshTokenDuration       time.Duration `mapstructure:"REFRESH_TOKEN_DURATION"`
+	EmailSenderName            string        `mapstructure:"EMAIL_SENDER_NAME"`
+	EmailSenderAddress         string        `mapstructure:"EMAIL_SENDER_ADDRESS"`
+	EmailSenderPassword        string        `mapstructure:"EMAIL_SENDER_PASSWORD"`
+	ExchangeRateFile           string        `mapstructure:"EXCHANGE_RATE_FILE"`
+	OTLPEndpoint               string        `mapstructure:"OTEL_EXPORTER_OTLP_ENDPOINT"`
+	ReconcileLedgerSchedule    string        `mapstructure:"RECONCILE_LEDGER_SCHEDULE"`
+	ScheduledTransfersSchedule string        `mapstructure:"SCHEDULED_TRANSFERS_SCHEDULE"`
+	DefaultPageSize            int32         `mapstructure:"DEFAULT_PAGE_SIZE"` // page size of the lists if unset
+	MaxPageSize                int32         `mapstructure:"MAX_PAGE_SIZE"`     // larger page sizes are capped to this
 }
 
 func LoadConfig(path string) (config Config, err error) {
@@ -29,11 +40,24 @@ func LoadConfig(path string) (config Config, err error) {
 
 	viper.AutomaticEnv()
 
+	// the env built from the secrets may not have them
+	viper.SetDefault("DEFAULT_PAGE_SIZE", DefaultPageSize)
+	viper.SetDefault("MAX_PAGE_SIZE", MaxPageSize)
+	viper.SetDefault("RECONCILE_LEDGER_SCHEDULE", "@hourly")
+	viper.SetDefault("SCHEDULED_TRANSFERS_SCHEDULE", "@every 1m")
+
 	err = viper.ReadInConfig()
 	if err != nil {
 		return
 	}
 
 	err = viper.Unmarshal(&config)
+	if err != nil {
+		return
+	}
+
+	if config.DefaultPageSize <= 0 || config.DefaultPageSize > config.MaxPageSize {
+		err = fmt.Errorf("DEFAULT_PAGE_SIZE must be between 1 and MAX_PAGE_SIZE: %d, %d", config.DefaultPageSize, config.MaxPageSize)
+	}
 	return
 }
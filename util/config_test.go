@@ -0,0 +1,70 @@
+package util
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadConfig(t *testing.T) {
+	testCases := []struct {
+		name          string
+		env           string
+		checkResponse func(t *testing.T, config Config, err error)
+	}{
+		{
+			name: "Set",
+			env:  "DEFAULT_PAGE_SIZE=20\nMAX_PAGE_SIZE=100\n",
+			checkResponse: func(t *testing.T, config Config, err error) {
+				require.NoError(t, err)
+				assert.EqualValues(t, 20, config.DefaultPageSize)
+				assert.EqualValues(t, 100, config.MaxPageSize)
+			},
+		},
+		{
+			// e.g. the env built from the secrets
+			name: "Unset",
+			env:  "ENVIRONMENT=production\n",
+			checkResponse: func(t *testing.T, config Config, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, DefaultPageSize, config.DefaultPageSize)
+				assert.Equal(t, MaxPageSize, config.MaxPageSize)
+				assert.Equal(t, "@hourly", config.ReconcileLedgerSchedule)
+				assert.Equal(t, "@every 1m", config.ScheduledTransfersSchedule)
+			},
+		},
+		{
+			name: "Zero",
+			env:  "DEFAULT_PAGE_SIZE=0\n",
+			checkResponse: func(t *testing.T, config Config, err error) {
+				assert.Error(t, err)
+			},
+		},
+		{
+			name: "LargerThanMax",
+			env:  "DEFAULT_PAGE_SIZE=100\nMAX_PAGE_SIZE=50\n",
+			checkResponse: func(t *testing.T, config Config, err error) {
+				assert.Error(t, err)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// LoadConfig uses the global viper. the paths of the other cases must not be searched
+			viper.Reset()
+			t.Cleanup(viper.Reset)
+
+			dir := t.TempDir()
+			err := os.WriteFile(filepath.Join(dir, "app.env"), []byte(tc.env), 0o600)
+			require.NoError(t, err)
+
+			config, err := LoadConfig(dir)
+			tc.checkResponse(t, config, err)
+		})
+	}
+}
@@ -0,0 +1,103 @@
+package util
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+var ErrInvalidPageToken = errors.New("invalid page token")
+
+// the page sizes of the lists if the config doesn't set them
+const (
+	DefaultPageSize int32 = 10
+	MaxPageSize     int32 = 50
+)
+
+// PageCursor is the last row of a page ordered by (created_at, id). the next page starts after it.
+// the zero value is the first page.
+type PageCursor struct {
+	CreatedAt time.Time
+	ID        int64
+}
+
+type pageToken struct {
+	CreatedAt time.Time `json:"c"`
+	ID        int64     `json:"i"`
+	Query     string    `json:"q"`
+}
+
+// EncodePageToken makes an opaque token of the cursor.
+// the token is bound to the query, e.g. the owner of the listed rows, so it can't be used with other parameters.
+func EncodePageToken(cursor PageCursor, query string) string {
+	data, _ := json.Marshal(pageToken{
+		CreatedAt: cursor.CreatedAt,
+		ID:        cursor.ID,
+		Query:     pageTokenQuery(query),
+	})
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// DecodePageToken returns the cursor of the token made by EncodePageToken with the same query. empty token is the first page.
+func DecodePageToken(token string, query string) (PageCursor, error) {
+	if token == "" {
+		return PageCursor{}, nil
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return PageCursor{}, ErrInvalidPageToken
+	}
+
+	var decoded pageToken
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return PageCursor{}, ErrInvalidPageToken
+	}
+
+	if decoded.Query != pageTokenQuery(query) {
+		return PageCursor{}, ErrInvalidPageToken
+	}
+
+	return PageCursor{CreatedAt: decoded.CreatedAt, ID: decoded.ID}, nil
+}
+
+func pageTokenQuery(query string) string {
+	sum := sha256.Sum256([]byte(query))
+	return hex.EncodeToString(sum[:8])
+}
+
+// PageSize follows AIP-158. the default size if unset, and the max size if larger.
+// DefaultPageSize and MaxPageSize are used for the unset sizes of the config, so the page is never empty
+func PageSize(requested int32, defaultSize int32, maxSize int32) int32 {
+	if defaultSize <= 0 {
+		defaultSize = DefaultPageSize
+	}
+	if maxSize <= 0 {
+		maxSize = MaxPageSize
+	}
+	if defaultSize > maxSize {
+		defaultSize = maxSize
+	}
+
+	if requested <= 0 {
+		requested = defaultSize
+	}
+	if requested > maxSize {
+		requested = maxSize
+	}
+	return requested
+}
+
+// NextPage trims the rows fetched with the limit of pageSize+1 to the page.
+// the token of the next page is made of the last row, and empty on the last page
+func NextPage[T any](rows []T, pageSize int32, cursor func(row T) PageCursor, query string) ([]T, string) {
+	if pageSize <= 0 || len(rows) <= int(pageSize) {
+		return rows, ""
+	}
+
+	rows = rows[:pageSize]
+	return rows, EncodePageToken(cursor(rows[len(rows)-1]), query)
+}
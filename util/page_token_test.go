@@ -0,0 +1,72 @@
+package util
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPageToken(t *testing.T) {
+	cursor := PageCursor{
+		CreatedAt: time.Date(2023, 6, 1, 12, 0, 0, 123456000, time.UTC),
+		ID:        RandomInt(1, 1000),
+	}
+
+	token := EncodePageToken(cursor, "accounts:alice")
+	assert.NotEmpty(t, token)
+
+	decoded, err := DecodePageToken(token, "accounts:alice")
+	assert.NoError(t, err)
+	assert.True(t, cursor.CreatedAt.Equal(decoded.CreatedAt))
+	assert.Equal(t, cursor.ID, decoded.ID)
+
+	// the first page
+	decoded, err = DecodePageToken("", "accounts:alice")
+	assert.NoError(t, err)
+	assert.Zero(t, decoded)
+
+	// issued for another query
+	_, err = DecodePageToken(token, "accounts:bob")
+	assert.ErrorIs(t, err, ErrInvalidPageToken)
+
+	_, err = DecodePageToken("not a token", "accounts:alice")
+	assert.ErrorIs(t, err, ErrInvalidPageToken)
+}
+
+func TestPageSize(t *testing.T) {
+	assert.EqualValues(t, 10, PageSize(0, 10, 50))
+	assert.EqualValues(t, 20, PageSize(20, 10, 50))
+	assert.EqualValues(t, 50, PageSize(100, 10, 50))
+
+	// the config without the page sizes
+	assert.EqualValues(t, DefaultPageSize, PageSize(0, 0, 0))
+	assert.EqualValues(t, MaxPageSize, PageSize(1000, 0, 0))
+	assert.EqualValues(t, 5, PageSize(0, 0, 5))
+}
+
+func TestNextPage(t *testing.T) {
+	cursor := func(id int64) PageCursor {
+		return PageCursor{ID: id}
+	}
+
+	// the extra row tells there is the next page
+	rows, token := NextPage([]int64{5, 4, 3}, 2, cursor, "ids")
+	assert.Equal(t, []int64{5, 4}, rows)
+	decoded, err := DecodePageToken(token, "ids")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(4), decoded.ID)
+
+	rows, token = NextPage([]int64{5, 4}, 2, cursor, "ids")
+	assert.Equal(t, []int64{5, 4}, rows)
+	assert.Empty(t, token)
+
+	rows, token = NextPage([]int64{}, 2, cursor, "ids")
+	assert.Empty(t, rows)
+	assert.Empty(t, token)
+
+	// no page to cut the rows
+	rows, token = NextPage([]int64{5}, 0, cursor, "ids")
+	assert.Equal(t, []int64{5}, rows)
+	assert.Empty(t, token)
+}
@@ -0,0 +1,22 @@
+package util
+
+const (
+	DepositorRole = "depositor"
+	BankerRole    = "banker"
+	AdminRole     = "admin"
+)
+
+var (
+	// every role can use the bank as a customer
+	AllRoles = []string{DepositorRole, BankerRole, AdminRole}
+	// staff can see and update the data of other users
+	StaffRoles = []string{BankerRole, AdminRole}
+)
+
+func IsSupportedRole(role string) bool {
+	switch role {
+	case DepositorRole, BankerRole, AdminRole:
+		return true
+	}
+	return false
+}
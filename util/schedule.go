@@ -0,0 +1,35 @@
+package util
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/robfig/cron/v3"
+)
+
+// MinScheduleInterval is the shortest interval between the runs of a schedule. the due ones are picked up every minute
+const MinScheduleInterval = time.Minute
+
+// ParseSchedule parses a cron spec with 5 fields, e.g. "0 9 1 * *", or a descriptor such as "@monthly" and "@every 24h".
+// the spec is evaluated in UTC unless it's prefixed with CRON_TZ.
+func ParseSchedule(spec string) (cron.Schedule, error) {
+	schedule, err := cron.ParseStandard(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	if every, ok := schedule.(cron.ConstantDelaySchedule); ok && every.Delay < MinScheduleInterval {
+		return nil, fmt.Errorf("interval must be at least %s", MinScheduleInterval)
+	}
+
+	return utcSchedule{schedule}, nil
+}
+
+// utcSchedule evaluates the spec without CRON_TZ in UTC rather than in the location of the given time
+type utcSchedule struct {
+	cron.Schedule
+}
+
+func (schedule utcSchedule) Next(t time.Time) time.Time {
+	return schedule.Schedule.Next(t.UTC())
+}
@@ -0,0 +1,27 @@
+package util
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseSchedule(t *testing.T) {
+	tokyo := time.FixedZone("JST", 9*60*60)
+	now := time.Date(2023, 6, 1, 12, 0, 0, 0, tokyo) // 3:00 in UTC
+
+	schedule, err := ParseSchedule("0 9 1 * *")
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2023, 6, 1, 9, 0, 0, 0, time.UTC), schedule.Next(now))
+
+	schedule, err = ParseSchedule("@every 24h")
+	assert.NoError(t, err)
+	assert.True(t, now.Add(24*time.Hour).Equal(schedule.Next(now)))
+
+	_, err = ParseSchedule("@every 1s")
+	assert.Error(t, err)
+
+	_, err = ParseSchedule("every day")
+	assert.Error(t, err)
+}
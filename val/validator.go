@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"net/mail"
 	"regexp"
+
+	"github.com/tgfukuda/be-master/util"
 )
 
 var (
@@ -72,3 +74,79 @@ func ValidateEmailId(value int64) error {
 func ValidateSecretCode(value string) error {
 	return ValidateString(value, 32, 128)
 }
+
+func ValidateCurrency(value string) error {
+	if !util.IsSupportedCurrency(value) {
+		return fmt.Errorf("is not a supported currency")
+	}
+
+	return nil
+}
+
+func ValidateAccountId(value int64) error {
+	if value <= 0 {
+		return fmt.Errorf("must be a positive integer")
+	}
+
+	return nil
+}
+
+func ValidateTransferId(value int64) error {
+	if value <= 0 {
+		return fmt.Errorf("must be a positive integer")
+	}
+
+	return nil
+}
+
+func ValidateAccountStatusReason(value string) error {
+	return ValidateString(value, 1, 200)
+}
+
+func ValidateScheduledTransferId(value int64) error {
+	if value <= 0 {
+		return fmt.Errorf("must be a positive integer")
+	}
+
+	return nil
+}
+
+func ValidateSchedule(value string) error {
+	if _, err := util.ParseSchedule(value); err != nil {
+		return fmt.Errorf("is not a valid schedule: %w", err)
+	}
+
+	return nil
+}
+
+// ValidateListPageSize accepts zero for the default size. the larger ones than the max are capped, not rejected
+func ValidateListPageSize(value int32) error {
+	if value < 0 {
+		return fmt.Errorf("must not be negative")
+	}
+
+	return nil
+}
+
+func ValidateAmount(value int64) error {
+	if value <= 0 {
+		return fmt.Errorf("must be a positive integer")
+	}
+
+	return nil
+}
+
+func ValidateIdempotencyKey(value string) error {
+	return ValidateString(value, 1, 255)
+}
+
+func ValidateToken(value string) error {
+	return ValidateString(value, 1, 4096)
+}
+
+func ValidateRole(value string) error {
+	if !util.IsSupportedRole(value) {
+		return fmt.Errorf("unsupported role")
+	}
+	return nil
+}
@@ -2,16 +2,25 @@ package worker
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+	"github.com/tgfukuda/be-master/tracing"
+	"go.opentelemetry.io/otel/trace"
 )
 
+// TaskDistributor enqueues the tasks relayed from the outbox.
+// the handlers don't call it directly but write the tasks to the outbox in their transaction.
 type TaskDistributor interface {
-	DistributeTaskSendVerifyEmail(
+	DistributeTask(
 		ctx context.Context,
-		payload *PayloadSendVerifyEmail,
+		taskType string,
+		payload []byte,
 		opts ...asynq.Option,
 	) error
+	// Close releases the connection to redis. call it after the relay has stopped.
+	Close() error
 }
 
 type RedisTaskDistributor struct {
@@ -24,3 +33,34 @@ func NewRedisTaskDistributor(redisOpt asynq.RedisClientOpt) TaskDistributor {
 		client: client,
 	}
 }
+
+func (distributor *RedisTaskDistributor) DistributeTask(
+	ctx context.Context,
+	taskType string,
+	payload []byte,
+	opts ...asynq.Option,
+) (err error) {
+	ctx, span := tracing.Tracer().Start(ctx, "enqueue "+taskType, trace.WithSpanKind(trace.SpanKindProducer))
+	defer func() {
+		tracing.End(span, err)
+	}()
+
+	task := asynq.NewTask(taskType, payload, opts...)
+	info, err := distributor.client.EnqueueContext(ctx, task)
+	if err != nil {
+		return fmt.Errorf("failed to enqueue task: %w", err)
+	}
+
+	log.Info().
+		Str("type", task.Type()).
+		Bytes("payload", task.Payload()).
+		Str("queue", info.Queue).
+		Int("max_retry", info.MaxRetry).
+		Msg("enqueued task")
+
+	return nil
+}
+
+func (distributor *RedisTaskDistributor) Close() error {
+	return distributor.client.Close()
+}
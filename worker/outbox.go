@@ -0,0 +1,137 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+	db "github.com/tgfukuda/be-master/db/sqlc"
+)
+
+const (
+	defaultMaxRetry = 25 // same as asynq
+
+	outboxBatchSize      = 100
+	outboxLease          = time.Minute // long enough to publish a batch
+	outboxRelayInterval  = time.Second
+	outboxMaxBackoff     = 5 * time.Minute
+	outboxInitialBackoff = time.Second
+)
+
+// newOutboxTask turns a task into the outbox row. only the options which can be stored in the outbox are accepted.
+func newOutboxTask(taskType string, payload interface{}, opts ...asynq.Option) (db.CreateOutboxParams, error) {
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		return db.CreateOutboxParams{}, fmt.Errorf("failed to marshal task payload: %w", err)
+	}
+
+	arg := db.CreateOutboxParams{
+		TaskType: taskType,
+		Payload:  jsonPayload,
+		Queue:    QueueDefault,
+		MaxRetry: defaultMaxRetry,
+	}
+
+	for _, opt := range opts {
+		switch opt.Type() {
+		case asynq.QueueOpt:
+			arg.Queue = opt.Value().(string)
+		case asynq.MaxRetryOpt:
+			arg.MaxRetry = int32(opt.Value().(int))
+		case asynq.ProcessInOpt:
+			arg.ProcessInSeconds = int32(opt.Value().(time.Duration) / time.Second)
+		default:
+			return db.CreateOutboxParams{}, fmt.Errorf("unsupported task option for outbox: %s", opt)
+		}
+	}
+
+	return arg, nil
+}
+
+// outboxTaskOptions restores the options of the task. the outbox id is used as the task id so a task
+// relayed again after the lease of a crashed relay isn't enqueued twice while the first one is pending.
+func outboxTaskOptions(task db.Outbox) []asynq.Option {
+	return []asynq.Option{
+		asynq.TaskID(fmt.Sprintf("outbox:%d", task.ID)),
+		asynq.Queue(task.Queue),
+		asynq.MaxRetry(int(task.MaxRetry)),
+		asynq.ProcessIn(time.Duration(task.ProcessInSeconds) * time.Second),
+	}
+}
+
+// outboxBackoff doubles the delay on each failure up to outboxMaxBackoff
+func outboxBackoff(attempts int32) time.Duration {
+	backoff := outboxInitialBackoff
+	for i := int32(1); i < attempts && backoff < outboxMaxBackoff; i++ {
+		backoff *= 2
+	}
+
+	if backoff > outboxMaxBackoff {
+		return outboxMaxBackoff
+	}
+	return backoff
+}
+
+// OutboxRelay enqueues the tasks written to the outbox
+type OutboxRelay struct {
+	store       db.Store
+	distributor TaskDistributor
+	interval    time.Duration
+}
+
+func NewOutboxRelay(store db.Store, distributor TaskDistributor) *OutboxRelay {
+	return &OutboxRelay{
+		store:       store,
+		distributor: distributor,
+		interval:    outboxRelayInterval,
+	}
+}
+
+// Run relays the tasks until ctx is done. the errors are only logged to keep relaying after the recovery.
+func (relay *OutboxRelay) Run(ctx context.Context) {
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+		}
+
+		result, err := relay.Relay(ctx)
+		if err != nil && ctx.Err() == nil {
+			log.Error().Err(err).Msg("failed to relay outbox")
+		}
+
+		// there can be more pending tasks if the batch was full
+		next := relay.interval
+		if err == nil && len(result.Sent)+len(result.Failed) == outboxBatchSize {
+			next = 0
+		}
+		timer.Reset(next)
+	}
+}
+
+// Relay enqueues a batch of the pending tasks
+func (relay *OutboxRelay) Relay(ctx context.Context) (db.RelayOutboxResult, error) {
+	return relay.store.RelayOutbox(ctx, db.RelayOutboxParams{
+		Limit:   outboxBatchSize,
+		Lease:   outboxLease,
+		Backoff: outboxBackoff,
+		Publish: func(task db.Outbox) error {
+			err := relay.distributor.DistributeTask(ctx, task.TaskType, task.Payload, outboxTaskOptions(task)...)
+			if errors.Is(err, asynq.ErrTaskIDConflict) {
+				return nil // enqueued by the previous relay
+			}
+			if err != nil {
+				log.Error().Err(err).Int64("outbox_id", task.ID).Str("type", task.TaskType).Msg("failed to relay task")
+			}
+			return err
+		},
+	})
+}
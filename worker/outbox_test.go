@@ -0,0 +1,102 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	db "github.com/tgfukuda/be-master/db/sqlc"
+	"github.com/tgfukuda/be-master/mocks"
+)
+
+func TestNewOutboxTask(t *testing.T) {
+	arg, err := newOutboxTask(TaskSendVerifyEmail, &PayloadSendVerifyEmail{Username: "alice"})
+	assert.NoError(t, err)
+	assert.Equal(t, TaskSendVerifyEmail, arg.TaskType)
+	assert.JSONEq(t, `{"username":"alice"}`, string(arg.Payload))
+	assert.Equal(t, QueueDefault, arg.Queue)
+	assert.EqualValues(t, defaultMaxRetry, arg.MaxRetry)
+	assert.Zero(t, arg.ProcessInSeconds)
+
+	arg, err = newOutboxTask(
+		TaskSendVerifyEmail,
+		&PayloadSendVerifyEmail{Username: "alice"},
+		asynq.Queue(QueueCritical),
+		asynq.MaxRetry(10),
+		asynq.ProcessIn(10*time.Second),
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, QueueCritical, arg.Queue)
+	assert.EqualValues(t, 10, arg.MaxRetry)
+	assert.EqualValues(t, 10, arg.ProcessInSeconds)
+
+	_, err = newOutboxTask(TaskSendVerifyEmail, &PayloadSendVerifyEmail{}, asynq.Deadline(time.Now()))
+	assert.Error(t, err)
+}
+
+func TestOutboxBackoff(t *testing.T) {
+	assert.Equal(t, time.Second, outboxBackoff(1))
+	assert.Equal(t, 2*time.Second, outboxBackoff(2))
+	assert.Equal(t, 8*time.Second, outboxBackoff(4))
+	assert.Equal(t, outboxMaxBackoff, outboxBackoff(100))
+}
+
+// taskOptionArgs lists the options expected for the task as the variadic args of the mock
+func taskOptionArgs(task db.Outbox) []interface{} {
+	var args []interface{}
+	for _, opt := range outboxTaskOptions(task) {
+		args = append(args, opt)
+	}
+	return args
+}
+
+func TestOutboxRelay(t *testing.T) {
+	tasks := []db.Outbox{
+		{ID: 1, TaskType: TaskSendVerifyEmail, Payload: []byte(`{}`), Queue: QueueCritical, MaxRetry: 10},
+		{ID: 2, TaskType: TaskSendVerifyEmail, Payload: []byte(`{}`), Queue: QueueDefault, MaxRetry: 10},
+		{ID: 3, TaskType: TaskSendVerifyEmail, Payload: []byte(`{}`), Queue: QueueDefault, MaxRetry: 10},
+	}
+
+	store := mocks.NewStore(t)
+	store.EXPECT().
+		RelayOutbox(mock.Anything, mock.MatchedBy(func(arg db.RelayOutboxParams) bool {
+			return arg.Limit == outboxBatchSize && arg.Lease == outboxLease
+		})).
+		RunAndReturn(func(ctx context.Context, arg db.RelayOutboxParams) (db.RelayOutboxResult, error) {
+			var result db.RelayOutboxResult
+			for _, task := range tasks {
+				if err := arg.Publish(task); err != nil {
+					result.Failed = append(result.Failed, task)
+					continue
+				}
+				result.Sent = append(result.Sent, task)
+			}
+			return result, nil
+		}).
+		Times(1)
+
+	distributor := mocks.NewTaskDistributor(t)
+	distributor.EXPECT().
+		DistributeTask(mock.Anything, TaskSendVerifyEmail, []byte(`{}`), taskOptionArgs(tasks[0])...).
+		Return(nil).
+		Times(1)
+	// already enqueued by the previous relay
+	distributor.EXPECT().
+		DistributeTask(mock.Anything, TaskSendVerifyEmail, []byte(`{}`), taskOptionArgs(tasks[1])...).
+		Return(asynq.ErrTaskIDConflict).
+		Times(1)
+	distributor.EXPECT().
+		DistributeTask(mock.Anything, TaskSendVerifyEmail, []byte(`{}`), taskOptionArgs(tasks[2])...).
+		Return(errors.New("redis is down")).
+		Times(1)
+
+	relay := NewOutboxRelay(store, distributor)
+	result, err := relay.Relay(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, tasks[:2], result.Sent)
+	assert.Equal(t, tasks[2:], result.Failed)
+}
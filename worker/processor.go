@@ -2,12 +2,14 @@ package worker
 
 import (
 	"context"
+	"time"
 
 	"github.com/hibiken/asynq"
 	"github.com/redis/go-redis/v9"
 	"github.com/rs/zerolog/log"
 	db "github.com/tgfukuda/be-master/db/sqlc"
 	"github.com/tgfukuda/be-master/mail"
+	"github.com/tgfukuda/be-master/metrics"
 )
 
 const (
@@ -17,10 +19,19 @@ const (
 
 type TaskProcessor interface {
 	Start() error
+	Shutdown()
 	ProcessTaskSendVerifyEmail(
 		ctx context.Context,
 		task *asynq.Task,
 	) error
+	ProcessTaskReconcileLedger(
+		ctx context.Context,
+		task *asynq.Task,
+	) error
+	ProcessTaskRunScheduledTransfers(
+		ctx context.Context,
+		task *asynq.Task,
+	) error
 }
 
 type RedisTaskProcessor struct {
@@ -55,8 +66,32 @@ func NewRedisTaskProcessor(redisOpt asynq.RedisClientOpt, store db.Store, mailer
 
 func (processor *RedisTaskProcessor) Start() error {
 	mux := asynq.NewServeMux()
+	mux.Use(taskMetrics)
 
 	mux.HandleFunc(TaskSendVerifyEmail, processor.ProcessTaskSendVerifyEmail)
+	mux.HandleFunc(TaskReconcileLedger, processor.ProcessTaskReconcileLedger)
+	mux.HandleFunc(TaskRunScheduledTransfers, processor.ProcessTaskRunScheduledTransfers)
 
 	return processor.server.Start(mux)
 }
+
+// Shutdown stops pulling new tasks and waits for the running ones until the shutdown timeout of asynq
+func (processor *RedisTaskProcessor) Shutdown() {
+	processor.server.Shutdown()
+}
+
+// taskMetrics counts the processed tasks by type and outcome. a failed task is counted on each retry.
+func taskMetrics(handler asynq.Handler) asynq.Handler {
+	return asynq.HandlerFunc(func(ctx context.Context, task *asynq.Task) error {
+		startTime := time.Now()
+		err := handler.ProcessTask(ctx, task)
+
+		outcome := metrics.TaskSucceeded
+		if err != nil {
+			outcome = metrics.TaskFailed
+		}
+		metrics.ObserveTask(task.Type(), outcome, time.Since(startTime))
+
+		return err
+	})
+}
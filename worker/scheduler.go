@@ -0,0 +1,71 @@
+package worker
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+)
+
+// TaskScheduler enqueues the periodic tasks processed by the TaskProcessor
+type TaskScheduler interface {
+	Start() error
+	Shutdown()
+}
+
+type RedisTaskScheduler struct {
+	scheduler *asynq.Scheduler
+}
+
+// NewRedisTaskScheduler registers the periodic tasks with the cron specs, e.g. "@hourly" or "0 3 * * *".
+// every instance runs a scheduler, so a periodic task is unique while it's pending not to be processed twice.
+// they are enqueued directly, not through the outbox. no database change depends on them and the next tick covers a missed one.
+func NewRedisTaskScheduler(
+	redisOpt asynq.RedisClientOpt,
+	reconcileLedgerSchedule string,
+	scheduledTransfersSchedule string,
+) (TaskScheduler, error) {
+	scheduler := asynq.NewScheduler(redisOpt, &asynq.SchedulerOpts{
+		Logger: NewLogger(),
+		EnqueueErrorHandler: func(task *asynq.Task, opts []asynq.Option, err error) {
+			log.Error().Err(err).Str("type", task.Type()).Msg("failed to enqueue periodic task")
+		},
+	})
+
+	_, err := scheduler.Register(
+		reconcileLedgerSchedule,
+		asynq.NewTask(TaskReconcileLedger, nil),
+		asynq.Queue(QueueDefault),
+		asynq.MaxRetry(3),
+		asynq.Unique(10*time.Minute),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("invalid schedule of %s: %w", TaskReconcileLedger, err)
+	}
+
+	// the runs are idempotent, so the one left by a crashed worker can be retried safely
+	_, err = scheduler.Register(
+		scheduledTransfersSchedule,
+		asynq.NewTask(TaskRunScheduledTransfers, nil),
+		asynq.Queue(QueueCritical),
+		asynq.MaxRetry(3),
+		asynq.Unique(time.Minute),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("invalid schedule of %s: %w", TaskRunScheduledTransfers, err)
+	}
+
+	return &RedisTaskScheduler{
+		scheduler: scheduler,
+	}, nil
+}
+
+// Start runs the scheduler in the background
+func (scheduler *RedisTaskScheduler) Start() error {
+	return scheduler.scheduler.Start()
+}
+
+func (scheduler *RedisTaskScheduler) Shutdown() {
+	scheduler.scheduler.Shutdown()
+}
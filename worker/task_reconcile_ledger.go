@@ -0,0 +1,86 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+	db "github.com/tgfukuda/be-master/db/sqlc"
+	"github.com/tgfukuda/be-master/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const TaskReconcileLedger = "task:reconcile_ledger"
+
+// number of the accounts listed at once
+const reconcileAccountsBatchSize = 100
+
+// ProcessTaskReconcileLedger compares the balance of every account with the sum of its entries and reports the drift.
+// an account which fails to reconcile doesn't stop the others. the task fails after all of them to be retried.
+func (processor *RedisTaskProcessor) ProcessTaskReconcileLedger(
+	ctx context.Context,
+	task *asynq.Task,
+) (err error) {
+	ctx, span := tracing.Tracer().Start(ctx, TaskReconcileLedger, trace.WithSpanKind(trace.SpanKindConsumer))
+	defer func() {
+		tracing.End(span, err)
+	}()
+
+	var reconciled, drifted, failed int
+	var lastErr error
+
+	for afterID := int64(0); ; {
+		accountIDs, err := processor.store.ListAccountIDs(ctx, db.ListAccountIDsParams{
+			AfterID:    afterID,
+			LimitCount: reconcileAccountsBatchSize,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to list accounts: %w", err)
+		}
+
+		for _, accountID := range accountIDs {
+			result, err := processor.store.ReconcileAccountTx(ctx, db.ReconcileAccountTxParams{AccountID: accountID})
+			if err != nil {
+				log.Error().Err(err).Int64("account_id", accountID).Msg("failed to reconcile account")
+				failed++
+				lastErr = err
+				continue
+			}
+
+			reconciled++
+			if result.Report != nil {
+				log.Warn().
+					Int64("account_id", accountID).
+					Int64("balance", result.Report.Balance).
+					Int64("entries_total", result.Report.EntriesTotal).
+					Msg("balance drifted from entries")
+				drifted++
+			}
+		}
+
+		if len(accountIDs) < reconcileAccountsBatchSize {
+			break
+		}
+		afterID = accountIDs[len(accountIDs)-1]
+	}
+
+	span.SetAttributes(
+		attribute.Int("reconcile.accounts", reconciled),
+		attribute.Int("reconcile.drifted", drifted),
+		attribute.Int("reconcile.failed", failed),
+	)
+
+	log.Info().
+		Str("type", task.Type()).
+		Int("accounts", reconciled).
+		Int("drifted", drifted).
+		Int("failed", failed).
+		Msg("processed task")
+
+	if failed > 0 {
+		return fmt.Errorf("failed to reconcile %d accounts: %w", failed, lastErr)
+	}
+	return nil
+}
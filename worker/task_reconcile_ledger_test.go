@@ -0,0 +1,65 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hibiken/asynq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	db "github.com/tgfukuda/be-master/db/sqlc"
+	"github.com/tgfukuda/be-master/mocks"
+)
+
+func TestProcessTaskReconcileLedger(t *testing.T) {
+	store := mocks.NewStore(t)
+	processor := &RedisTaskProcessor{store: store}
+
+	// a full page and the last one
+	firstPage := make([]int64, reconcileAccountsBatchSize)
+	for i := range firstPage {
+		firstPage[i] = int64(i + 1)
+	}
+	lastPage := []int64{reconcileAccountsBatchSize + 1, reconcileAccountsBatchSize + 2}
+
+	store.EXPECT().
+		ListAccountIDs(mock.Anything, db.ListAccountIDsParams{AfterID: 0, LimitCount: reconcileAccountsBatchSize}).
+		Times(1).
+		Return(firstPage, nil)
+	store.EXPECT().
+		ListAccountIDs(mock.Anything, db.ListAccountIDsParams{AfterID: reconcileAccountsBatchSize, LimitCount: reconcileAccountsBatchSize}).
+		Times(1).
+		Return(lastPage, nil)
+
+	failedID := lastPage[1]
+	store.EXPECT().
+		ReconcileAccountTx(mock.Anything, mock.MatchedBy(func(arg db.ReconcileAccountTxParams) bool {
+			return arg.AccountID != failedID
+		})).
+		RunAndReturn(func(ctx context.Context, arg db.ReconcileAccountTxParams) (db.ReconcileAccountTxResult, error) {
+			return db.ReconcileAccountTxResult{Account: db.Account{ID: arg.AccountID}}, nil
+		}).
+		Times(reconcileAccountsBatchSize + 1)
+	// an account failing to reconcile doesn't stop the others
+	store.EXPECT().
+		ReconcileAccountTx(mock.Anything, db.ReconcileAccountTxParams{AccountID: failedID}).
+		Times(1).
+		Return(db.ReconcileAccountTxResult{}, errors.New("connection reset"))
+
+	err := processor.ProcessTaskReconcileLedger(context.Background(), asynq.NewTask(TaskReconcileLedger, nil))
+	assert.Error(t, err)
+}
+
+func TestNewRedisTaskScheduler(t *testing.T) {
+	redisOpt := asynq.RedisClientOpt{Addr: "localhost:6379"}
+
+	_, err := NewRedisTaskScheduler(redisOpt, "@hourly", "@every 1m")
+	assert.NoError(t, err)
+
+	_, err = NewRedisTaskScheduler(redisOpt, "every hour", "@every 1m")
+	assert.Error(t, err)
+
+	_, err = NewRedisTaskScheduler(redisOpt, "@hourly", "every minute")
+	assert.Error(t, err)
+}
@@ -0,0 +1,178 @@
+package worker
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+	db "github.com/tgfukuda/be-master/db/sqlc"
+	"github.com/tgfukuda/be-master/tracing"
+	"github.com/tgfukuda/be-master/util"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const TaskRunScheduledTransfers = "task:run_scheduled_transfers"
+
+// number of the due scheduled transfers listed at once
+const scheduledTransfersBatchSize = 100
+
+// ProcessTaskRunScheduledTransfers pays the due scheduled transfers and records the outcome of each run.
+// a scheduled transfer which fails to run doesn't stop the others. it's picked up again on the next tick.
+func (processor *RedisTaskProcessor) ProcessTaskRunScheduledTransfers(
+	ctx context.Context,
+	task *asynq.Task,
+) (err error) {
+	ctx, span := tracing.Tracer().Start(ctx, TaskRunScheduledTransfers, trace.WithSpanKind(trace.SpanKindConsumer))
+	defer func() {
+		tracing.End(span, err)
+	}()
+
+	now := time.Now()
+
+	var ran, failed int
+	var lastErr error
+
+	for afterID := int64(0); ; {
+		ids, err := processor.store.ListDueScheduledTransferIDs(ctx, db.ListDueScheduledTransferIDsParams{
+			Now:        now,
+			AfterID:    afterID,
+			LimitCount: scheduledTransfersBatchSize,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to list due scheduled transfers: %w", err)
+		}
+
+		for _, id := range ids {
+			if err := processor.runScheduledTransfer(ctx, id, now); err != nil {
+				log.Error().Err(err).Int64("scheduled_transfer_id", id).Msg("failed to run scheduled transfer")
+				failed++
+				lastErr = err
+				continue
+			}
+			ran++
+		}
+
+		if len(ids) < scheduledTransfersBatchSize {
+			break
+		}
+		afterID = ids[len(ids)-1]
+	}
+
+	span.SetAttributes(
+		attribute.Int("scheduled_transfer.ran", ran),
+		attribute.Int("scheduled_transfer.failed", failed),
+	)
+
+	log.Info().
+		Str("type", task.Type()).
+		Int("ran", ran).
+		Int("failed", failed).
+		Msg("processed task")
+
+	if failed > 0 {
+		return fmt.Errorf("failed to run %d scheduled transfers: %w", failed, lastErr)
+	}
+	return nil
+}
+
+// runScheduledTransfer pays the due run of the scheduled transfer and records its outcome.
+// the transfer is keyed by the run, so a retry after a crash replays it instead of paying twice.
+func (processor *RedisTaskProcessor) runScheduledTransfer(ctx context.Context, id int64, now time.Time) error {
+	scheduled, err := processor.store.GetScheduledTransfer(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	// paused, cancelled or run by another task in the meantime
+	if scheduled.Status != db.ScheduledTransferActive || scheduled.NextRunAt.After(now) {
+		return nil
+	}
+
+	schedule, err := util.ParseSchedule(scheduled.Schedule)
+	if err != nil {
+		return fmt.Errorf("invalid schedule %q: %w", scheduled.Schedule, err)
+	}
+
+	arg := db.RecordScheduledTransferRunTxParams{
+		ScheduledTransferID: scheduled.ID,
+		ScheduledAt:         scheduled.NextRunAt,
+		Status:              db.ScheduledTransferRunSucceeded,
+		// the runs missed while the workers were down are paid once, not caught up
+		NextRunAt: schedule.Next(now),
+	}
+
+	transfer, err := processor.payScheduledTransfer(ctx, scheduled)
+	switch {
+	case err == nil:
+		arg.TransferID = sql.NullInt64{Int64: transfer.ID, Valid: true}
+	case errors.Is(err, db.ErrInsufficientFunds):
+		arg.Status = db.ScheduledTransferRunInsufficientFunds
+		arg.Error = err.Error()
+	case errors.Is(err, errScheduledTransferCurrencyMismatch):
+		arg.Status = db.ScheduledTransferRunCurrencyMismatch
+		arg.Error = err.Error()
+	case errors.Is(err, sql.ErrNoRows), errors.Is(err, errScheduledTransferNotOwned), errors.Is(err, db.ErrIdempotencyKeyReused),
+		errors.Is(err, db.ErrAccountFrozen), errors.Is(err, db.ErrAccountClosed):
+		arg.Status = db.ScheduledTransferRunFailed
+		arg.Error = err.Error()
+	default:
+		// may be temporary. the run is retried as it is
+		return err
+	}
+
+	result, err := processor.store.RecordScheduledTransferRunTx(ctx, arg)
+	if err != nil {
+		return fmt.Errorf("failed to record run: %w", err)
+	}
+
+	if result.Run != nil {
+		log.Info().
+			Int64("scheduled_transfer_id", scheduled.ID).
+			Str("status", result.Run.Status).
+			Time("next_run_at", result.ScheduledTransfer.NextRunAt).
+			Msg("ran scheduled transfer")
+	}
+
+	return nil
+}
+
+var (
+	errScheduledTransferCurrencyMismatch = errors.New("account currency mismatch")
+	errScheduledTransferNotOwned         = errors.New("from account doesn't belong to the owner")
+)
+
+func (processor *RedisTaskProcessor) payScheduledTransfer(ctx context.Context, scheduled db.ScheduledTransfer) (db.Transfer, error) {
+	for _, accountID := range []int64{scheduled.FromAccountID, scheduled.ToAccountID} {
+		account, err := processor.store.GetAccount(ctx, accountID)
+		if err != nil {
+			return db.Transfer{}, fmt.Errorf("failed to get account [%d]: %w", accountID, err)
+		}
+
+		if account.Currency != scheduled.Currency {
+			return db.Transfer{}, fmt.Errorf("%w: account [%d] is in %s but %s is scheduled",
+				errScheduledTransferCurrencyMismatch, accountID, account.Currency, scheduled.Currency)
+		}
+
+		if accountID == scheduled.FromAccountID && account.Owner != scheduled.Owner {
+			return db.Transfer{}, fmt.Errorf("%w: account [%d]", errScheduledTransferNotOwned, accountID)
+		}
+	}
+
+	result, err := processor.store.TransferTx(ctx, db.TransferTxParams{
+		FromAccountID:  scheduled.FromAccountID,
+		ToAccountID:    scheduled.ToAccountID,
+		Amount:         scheduled.Amount,
+		IdempotencyKey: scheduledTransferIdempotencyKey(scheduled),
+	})
+	return result.Transfer, err
+}
+
+// scheduledTransferIdempotencyKey identifies the current run of the scheduled transfer
+func scheduledTransferIdempotencyKey(scheduled db.ScheduledTransfer) string {
+	return fmt.Sprintf("scheduled_transfer:%d:%d", scheduled.ID, scheduled.NextRunAt.UnixMicro())
+}
@@ -0,0 +1,214 @@
+package worker
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	db "github.com/tgfukuda/be-master/db/sqlc"
+	"github.com/tgfukuda/be-master/mocks"
+	"github.com/tgfukuda/be-master/util"
+)
+
+func randomScheduledTransfer(nextRunAt time.Time) (db.ScheduledTransfer, db.Account, db.Account) {
+	owner := util.RandomOwner()
+	from := db.Account{ID: 1, Owner: owner, Currency: util.USD}
+	to := db.Account{ID: 2, Owner: util.RandomOwner(), Currency: util.USD}
+	scheduled := db.ScheduledTransfer{
+		ID:            util.RandomInt(1, 1000),
+		Owner:         owner,
+		FromAccountID: from.ID,
+		ToAccountID:   to.ID,
+		Amount:        10,
+		Currency:      util.USD,
+		Schedule:      "0 9 1 * *",
+		Status:        db.ScheduledTransferActive,
+		NextRunAt:     nextRunAt,
+	}
+	return scheduled, from, to
+}
+
+func TestRunScheduledTransfer(t *testing.T) {
+	now := time.Date(2023, 6, 1, 9, 0, 30, 0, time.UTC)
+	nextRunAt := time.Date(2023, 7, 1, 9, 0, 0, 0, time.UTC)
+	scheduled, from, to := randomScheduledTransfer(time.Date(2023, 6, 1, 9, 0, 0, 0, time.UTC))
+	transferArg := db.TransferTxParams{
+		FromAccountID:  from.ID,
+		ToAccountID:    to.ID,
+		Amount:         scheduled.Amount,
+		IdempotencyKey: scheduledTransferIdempotencyKey(scheduled),
+	}
+
+	matchRun := func(status string, transferID int64) interface{} {
+		return mock.MatchedBy(func(arg db.RecordScheduledTransferRunTxParams) bool {
+			return arg.ScheduledTransferID == scheduled.ID &&
+				arg.ScheduledAt.Equal(scheduled.NextRunAt) &&
+				arg.NextRunAt.Equal(nextRunAt) &&
+				arg.Status == status &&
+				arg.TransferID.Int64 == transferID
+		})
+	}
+
+	testCases := []struct {
+		name       string
+		buildStubs func(store *mocks.Store)
+		checkErr   func(t *testing.T, err error)
+	}{
+		{
+			name: "Succeeded",
+			buildStubs: func(store *mocks.Store) {
+				store.EXPECT().GetScheduledTransfer(mock.Anything, scheduled.ID).Times(1).Return(scheduled, nil)
+				store.EXPECT().GetAccount(mock.Anything, from.ID).Times(1).Return(from, nil)
+				store.EXPECT().GetAccount(mock.Anything, to.ID).Times(1).Return(to, nil)
+				store.EXPECT().
+					TransferTx(mock.Anything, transferArg).
+					Times(1).
+					Return(db.TransferTxResult{Transfer: db.Transfer{ID: 100}}, nil)
+				store.EXPECT().
+					RecordScheduledTransferRunTx(mock.Anything, matchRun(db.ScheduledTransferRunSucceeded, 100)).
+					Times(1).
+					Return(db.RecordScheduledTransferRunTxResult{}, nil)
+			},
+			checkErr: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name: "InsufficientFunds",
+			buildStubs: func(store *mocks.Store) {
+				store.EXPECT().GetScheduledTransfer(mock.Anything, scheduled.ID).Times(1).Return(scheduled, nil)
+				store.EXPECT().GetAccount(mock.Anything, from.ID).Times(1).Return(from, nil)
+				store.EXPECT().GetAccount(mock.Anything, to.ID).Times(1).Return(to, nil)
+				store.EXPECT().
+					TransferTx(mock.Anything, transferArg).
+					Times(1).
+					Return(db.TransferTxResult{}, db.ErrInsufficientFunds)
+				store.EXPECT().
+					RecordScheduledTransferRunTx(mock.Anything, matchRun(db.ScheduledTransferRunInsufficientFunds, 0)).
+					Times(1).
+					Return(db.RecordScheduledTransferRunTxResult{}, nil)
+			},
+			checkErr: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name: "AccountFrozen",
+			buildStubs: func(store *mocks.Store) {
+				store.EXPECT().GetScheduledTransfer(mock.Anything, scheduled.ID).Times(1).Return(scheduled, nil)
+				store.EXPECT().GetAccount(mock.Anything, from.ID).Times(1).Return(from, nil)
+				store.EXPECT().GetAccount(mock.Anything, to.ID).Times(1).Return(to, nil)
+				store.EXPECT().
+					TransferTx(mock.Anything, transferArg).
+					Times(1).
+					Return(db.TransferTxResult{}, &db.AccountStatusError{AccountID: to.ID, Status: db.AccountFrozen})
+				// the run is given up, not retried until the account is unfrozen
+				store.EXPECT().
+					RecordScheduledTransferRunTx(mock.Anything, matchRun(db.ScheduledTransferRunFailed, 0)).
+					Times(1).
+					Return(db.RecordScheduledTransferRunTxResult{}, nil)
+			},
+			checkErr: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name: "CurrencyMismatch",
+			buildStubs: func(store *mocks.Store) {
+				eur := to
+				eur.Currency = util.EUR
+
+				store.EXPECT().GetScheduledTransfer(mock.Anything, scheduled.ID).Times(1).Return(scheduled, nil)
+				store.EXPECT().GetAccount(mock.Anything, from.ID).Times(1).Return(from, nil)
+				store.EXPECT().GetAccount(mock.Anything, to.ID).Times(1).Return(eur, nil)
+				store.EXPECT().
+					RecordScheduledTransferRunTx(mock.Anything, matchRun(db.ScheduledTransferRunCurrencyMismatch, 0)).
+					Times(1).
+					Return(db.RecordScheduledTransferRunTxResult{}, nil)
+			},
+			checkErr: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name: "Paused",
+			buildStubs: func(store *mocks.Store) {
+				paused := scheduled
+				paused.Status = db.ScheduledTransferPaused
+
+				store.EXPECT().GetScheduledTransfer(mock.Anything, scheduled.ID).Times(1).Return(paused, nil)
+			},
+			checkErr: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name: "AlreadyRun",
+			buildStubs: func(store *mocks.Store) {
+				ran := scheduled
+				ran.NextRunAt = nextRunAt
+
+				store.EXPECT().GetScheduledTransfer(mock.Anything, scheduled.ID).Times(1).Return(ran, nil)
+			},
+			checkErr: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			// nothing is recorded, so the same run is retried with the same idempotency key
+			name: "TemporaryError",
+			buildStubs: func(store *mocks.Store) {
+				store.EXPECT().GetScheduledTransfer(mock.Anything, scheduled.ID).Times(1).Return(scheduled, nil)
+				store.EXPECT().GetAccount(mock.Anything, from.ID).Times(1).Return(from, nil)
+				store.EXPECT().GetAccount(mock.Anything, to.ID).Times(1).Return(to, nil)
+				store.EXPECT().
+					TransferTx(mock.Anything, transferArg).
+					Times(1).
+					Return(db.TransferTxResult{}, sql.ErrConnDone)
+			},
+			checkErr: func(t *testing.T, err error) {
+				assert.ErrorIs(t, err, sql.ErrConnDone)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := mocks.NewStore(t)
+			tc.buildStubs(store)
+
+			processor := &RedisTaskProcessor{store: store}
+			err := processor.runScheduledTransfer(context.Background(), scheduled.ID, now)
+			tc.checkErr(t, err)
+		})
+	}
+}
+
+func TestProcessTaskRunScheduledTransfers(t *testing.T) {
+	store := mocks.NewStore(t)
+	processor := &RedisTaskProcessor{store: store}
+
+	scheduled, _, _ := randomScheduledTransfer(time.Now().Add(-time.Minute))
+	scheduled.Status = db.ScheduledTransferCancelled
+
+	store.EXPECT().
+		ListDueScheduledTransferIDs(mock.Anything, mock.MatchedBy(func(arg db.ListDueScheduledTransferIDsParams) bool {
+			return arg.AfterID == 0 && arg.LimitCount == scheduledTransfersBatchSize
+		})).
+		Times(1).
+		Return([]int64{scheduled.ID, scheduled.ID + 1}, nil)
+	store.EXPECT().GetScheduledTransfer(mock.Anything, scheduled.ID).Times(1).Return(scheduled, nil)
+	// a scheduled transfer failing to run doesn't stop the others
+	store.EXPECT().
+		GetScheduledTransfer(mock.Anything, scheduled.ID+1).
+		Times(1).
+		Return(db.ScheduledTransfer{}, errors.New("connection reset"))
+
+	err := processor.ProcessTaskRunScheduledTransfers(context.Background(), asynq.NewTask(TaskRunScheduledTransfers, nil))
+	assert.Error(t, err)
+}
@@ -8,50 +8,48 @@ import (
 	"github.com/hibiken/asynq"
 	"github.com/rs/zerolog/log"
 	db "github.com/tgfukuda/be-master/db/sqlc"
+	"github.com/tgfukuda/be-master/tracing"
 	"github.com/tgfukuda/be-master/util"
+	"go.opentelemetry.io/otel/trace"
 )
 
 const TaskSendVerifyEmail = "task:send_verify_email"
 
 type PayloadSendVerifyEmail struct {
-	Username string `json:"username"`
+	Username     string            `json:"username"`
+	TraceContext map[string]string `json:"trace_context,omitempty"` // set on the creation to continue the trace in the processor
 }
 
-func (distributor *RedisTaskDistributor) DistributeTaskSendVerifyEmail(
+// NewTaskSendVerifyEmail builds the outbox row of the task. the trace of ctx continues in the processor.
+func NewTaskSendVerifyEmail(
 	ctx context.Context,
 	payload *PayloadSendVerifyEmail,
 	opts ...asynq.Option,
-) error {
-	jsonPayload, err := json.Marshal(payload)
-	if err != nil {
-		return fmt.Errorf("failed to marshal task payload: %w", err)
-	}
-
-	task := asynq.NewTask(TaskSendVerifyEmail, jsonPayload, opts...)
-	info, err := distributor.client.EnqueueContext(ctx, task)
-	if err != nil {
-		return fmt.Errorf("failed to enqueue task: %w", err)
-	}
-
-	log.Info().
-		Str("type", task.Type()).
-		Bytes("payload", task.Payload()).
-		Str("queue", info.Queue).
-		Int("max_retry", info.MaxRetry).
-		Msg("enqueued task")
+) (db.CreateOutboxParams, error) {
+	payload.TraceContext = tracing.Inject(ctx)
 
-	return nil
+	return newOutboxTask(TaskSendVerifyEmail, payload, opts...)
 }
 
 func (processor *RedisTaskProcessor) ProcessTaskSendVerifyEmail(
 	ctx context.Context,
 	task *asynq.Task,
-) error {
+) (err error) {
 	var payload PayloadSendVerifyEmail
 	if err := json.Unmarshal(task.Payload(), &payload); err != nil {
 		return fmt.Errorf("failed to unmarshal payload: %w", asynq.SkipRetry)
 	}
 
+	// continue the trace of the call which distributed the task
+	ctx, span := tracing.Tracer().Start(
+		tracing.Extract(ctx, payload.TraceContext),
+		TaskSendVerifyEmail,
+		trace.WithSpanKind(trace.SpanKindConsumer),
+	)
+	defer func() {
+		tracing.End(span, err)
+	}()
+
 	user, err := processor.store.GetUser(ctx, payload.Username)
 	if err != nil {
 		// if err == sql.ErrNoRows {
@@ -89,6 +87,7 @@ func (processor *RedisTaskProcessor) ProcessTaskSendVerifyEmail(
 		Str("type", task.Type()).
 		Bytes("payload", task.Payload()).
 		Str("email", user.Email).
+		Str("trace_id", span.SpanContext().TraceID().String()).
 		Msg("processed task")
 
 	return nil
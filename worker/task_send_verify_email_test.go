@@ -0,0 +1,59 @@
+package worker
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hibiken/asynq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	db "github.com/tgfukuda/be-master/db/sqlc"
+	"github.com/tgfukuda/be-master/mocks"
+	"github.com/tgfukuda/be-master/tracing"
+	"github.com/tgfukuda/be-master/util"
+)
+
+// the processor continues the trace of the call which distributed the task
+func TestProcessTaskSendVerifyEmailTrace(t *testing.T) {
+	provider, exporter := tracing.NewInMemoryTracerProvider()
+	tracing.Setup(provider)
+
+	user := db.User{
+		Username: util.RandomOwner(),
+		Email:    util.RandomEmail(),
+	}
+
+	ctx, parent := tracing.Tracer().Start(context.Background(), "CreateUser")
+	arg, err := NewTaskSendVerifyEmail(ctx, &PayloadSendVerifyEmail{Username: user.Username})
+	parent.End()
+	assert.NoError(t, err)
+
+	store := mocks.NewStore(t)
+	mailer := mocks.NewEmailSender(t)
+	processor := &RedisTaskProcessor{store: store, mailer: mailer}
+
+	store.EXPECT().
+		GetUser(mock.Anything, user.Username).
+		Times(1).
+		Return(user, nil)
+	store.EXPECT().
+		CreateVerifyEmail(mock.Anything, mock.Anything).
+		Times(1).
+		Return(db.VerifyEmail{ID: 1, Username: user.Username, Email: user.Email}, nil)
+	mailer.EXPECT().
+		SendEmail(mock.Anything, mock.Anything, []string{user.Email}, mock.Anything, mock.Anything, mock.Anything).
+		Times(1).
+		Return(nil)
+
+	// the processor runs without the context of the distributor
+	err = processor.ProcessTaskSendVerifyEmail(context.Background(), asynq.NewTask(arg.TaskType, arg.Payload))
+	assert.NoError(t, err)
+
+	spans := exporter.GetSpans()
+	assert.Len(t, spans, 2)
+	assert.Equal(t, "CreateUser", spans[0].Name)
+	assert.Equal(t, TaskSendVerifyEmail, spans[1].Name)
+	assert.Equal(t, spans[0].SpanContext.TraceID(), spans[1].SpanContext.TraceID())
+	assert.Equal(t, spans[0].SpanContext.SpanID(), spans[1].Parent.SpanID())
+	assert.True(t, spans[1].Parent.IsRemote())
+}